@@ -0,0 +1,1517 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package getstream
+
+import (
+	"context"
+)
+
+var (
+	_ CommonAPI     = (*Client)(nil)
+	_ CommonAPI     = (*Stream)(nil)
+	_ ChatAPI       = (*ChatClient)(nil)
+	_ VideoAPI      = (*VideoClient)(nil)
+	_ FeedsAPI      = (*FeedsClient)(nil)
+	_ ModerationAPI = (*ModerationClient)(nil)
+)
+
+// CommonAPI is the set of API calls of *Client shared by all products; *Stream
+// satisfies it too. Depend on it rather than on the concrete client to
+// substitute mockgetstream.CommonAPI in tests.
+type CommonAPI interface {
+	// This Method returns the application settings
+	GetApp(ctx context.Context, request *GetAppRequest, opts ...RequestOption) (*StreamResponse[GetApplicationResponse], error)
+
+	// This Method updates one or more application settings
+	UpdateApp(ctx context.Context, request *UpdateAppRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Returns all available block lists
+	ListBlockLists(ctx context.Context, request *ListBlockListsRequest, opts ...RequestOption) (*StreamResponse[ListBlockListResponse], error)
+
+	// Creates a new application blocklist, once created the blocklist can be used by any channel type
+	CreateBlockList(ctx context.Context, request *CreateBlockListRequest, opts ...RequestOption) (*StreamResponse[CreateBlockListResponse], error)
+
+	// Enqueues an asynchronous bulk import of items into an existing blocklist.
+	// Returns a task ID that can be polled via GET /tasks/{id} to observe progress.
+	// AddItems is idempotent: items already present are skipped without error.
+	// For lists exceeding the HTTP request-body cap, issue repeated import calls each
+	// carrying a bounded slice of items — the task result accumulates correctly.
+	ImportBlockList(ctx context.Context, id string, request *ImportBlockListRequest, opts ...RequestOption) (*StreamResponse[ImportBlockListResponse], error)
+
+	// Deletes previously created application blocklist
+	DeleteBlockList(ctx context.Context, name string, request *DeleteBlockListRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Returns block list by given name
+	GetBlockList(ctx context.Context, name string, request *GetBlockListRequest, opts ...RequestOption) (*StreamResponse[GetBlockListResponse], error)
+
+	// Updates contents of the block list
+	UpdateBlockList(ctx context.Context, name string, request *UpdateBlockListRequest, opts ...RequestOption) (*StreamResponse[UpdateBlockListResponse], error)
+
+	// Sends a test message via push, this is a test endpoint to verify your push settings
+	CheckPush(ctx context.Context, request *CheckPushRequest, opts ...RequestOption) (*StreamResponse[CheckPushResponse], error)
+
+	// Validates Amazon SNS configuration
+	CheckSNS(ctx context.Context, request *CheckSNSRequest, opts ...RequestOption) (*StreamResponse[CheckSNSResponse], error)
+
+	// Validates Amazon SQS credentials
+	CheckSQS(ctx context.Context, request *CheckSQSRequest, opts ...RequestOption) (*StreamResponse[CheckSQSResponse], error)
+
+	// Deletes one device
+	DeleteDevice(ctx context.Context, request *DeleteDeviceRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Returns all available devices
+	ListDevices(ctx context.Context, request *ListDevicesRequest, opts ...RequestOption) (*StreamResponse[ListDevicesResponse], error)
+
+	// Adds a new device to a user, if the same device already exists the call will have no effect
+	CreateDevice(ctx context.Context, request *CreateDeviceRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Exports user profile, reactions and messages for list of given users
+	ExportUsers(ctx context.Context, request *ExportUsersRequest, opts ...RequestOption) (*StreamResponse[ExportUsersResponse], error)
+
+	// Lists external storage
+	ListExternalStorage(ctx context.Context, request *ListExternalStorageRequest, opts ...RequestOption) (*StreamResponse[ListExternalStorageResponse], error)
+
+	// Creates new external storage
+	CreateExternalStorage(ctx context.Context, request *CreateExternalStorageRequest, opts ...RequestOption) (*StreamResponse[CreateExternalStorageResponse], error)
+
+	// Deletes external storage
+	DeleteExternalStorage(ctx context.Context, name string, request *DeleteExternalStorageRequest, opts ...RequestOption) (*StreamResponse[DeleteExternalStorageResponse], error)
+
+	UpdateExternalStorage(ctx context.Context, name string, request *UpdateExternalStorageRequest, opts ...RequestOption) (*StreamResponse[UpdateExternalStorageResponse], error)
+
+	CheckExternalStorage(ctx context.Context, name string, request *CheckExternalStorageRequest, opts ...RequestOption) (*StreamResponse[CheckExternalStorageResponse], error)
+
+	CreateGuest(ctx context.Context, request *CreateGuestRequest, opts ...RequestOption) (*StreamResponse[CreateGuestResponse], error)
+
+	// Creates a new import URL
+	CreateImportURL(ctx context.Context, request *CreateImportURLRequest, opts ...RequestOption) (*StreamResponse[CreateImportURLResponse], error)
+
+	// Gets an import
+	ListImports(ctx context.Context, request *ListImportsRequest, opts ...RequestOption) (*StreamResponse[ListImportsResponse], error)
+
+	// Creates a new import
+	CreateImport(ctx context.Context, request *CreateImportRequest, opts ...RequestOption) (*StreamResponse[CreateImportResponse], error)
+
+	// Lists all import v2 tasks for the app
+	ListImportV2Tasks(ctx context.Context, request *ListImportV2TasksRequest, opts ...RequestOption) (*StreamResponse[ListImportV2TasksResponse], error)
+
+	// Creates a new import v2 task
+	CreateImportV2Task(ctx context.Context, request *CreateImportV2TaskRequest, opts ...RequestOption) (*StreamResponse[CreateImportV2TaskResponse], error)
+
+	// Removes the external storage configuration for the app. Idempotent: succeeds even if no configuration exists.
+	DeleteImporterExternalStorage(ctx context.Context, request *DeleteImporterExternalStorageRequest, opts ...RequestOption) (*StreamResponse[DeleteExternalStorageResponse], error)
+
+	// Returns the current external storage configuration for the app. Returns 404 if no configuration exists.
+	GetImporterExternalStorage(ctx context.Context, request *GetImporterExternalStorageRequest, opts ...RequestOption) (*StreamResponse[GetExternalStorageResponse], error)
+
+	// Creates or updates the external storage configuration for the app. Supports AWS S3 (via cross-account IAM role assumption) and GCS (via service-account JSON credentials).
+	UpsertImporterExternalStorage(ctx context.Context, request *UpsertImporterExternalStorageRequest, opts ...RequestOption) (*StreamResponse[UpsertExternalStorageResponse], error)
+
+	// Validates the configured external storage. For AWS S3, performs a live STS AssumeRole and S3 ListObjectsV2 check. For GCS, performs a live bucket listing check using the configured service-account credentials.
+	ValidateImporterExternalStorage(ctx context.Context, request *ValidateImporterExternalStorageRequest, opts ...RequestOption) (*StreamResponse[ValidateExternalStorageResponse], error)
+
+	// Deletes an import v2 task. Can only delete tasks in queued state.
+	DeleteImportV2Task(ctx context.Context, id string, request *DeleteImportV2TaskRequest, opts ...RequestOption) (*StreamResponse[DeleteImportV2TaskResponse], error)
+
+	// Gets a single import v2 task by ID
+	GetImportV2Task(ctx context.Context, id string, request *GetImportV2TaskRequest, opts ...RequestOption) (*StreamResponse[GetImportV2TaskResponse], error)
+
+	// Requests a controlled stop of an import v2 task. Allowed only for tasks in queued or processing state; a processing import stops cleanly on its next progress tick.
+	CancelImportV2Task(ctx context.Context, id string, request *CancelImportV2TaskRequest, opts ...RequestOption) (*StreamResponse[CancelImportV2TaskResponse], error)
+
+	// Gets an import
+	GetImport(ctx context.Context, id string, request *GetImportRequest, opts ...RequestOption) (*StreamResponse[GetImportResponse], error)
+
+	// Get an OpenGraph attachment for a link
+	GetOG(ctx context.Context, request *GetOGRequest, opts ...RequestOption) (*StreamResponse[GetOGResponse], error)
+
+	// Lists all available permissions
+	ListPermissions(ctx context.Context, request *ListPermissionsRequest, opts ...RequestOption) (*StreamResponse[ListPermissionsResponse], error)
+
+	// Gets custom permission
+	GetPermission(ctx context.Context, id string, request *GetPermissionRequest, opts ...RequestOption) (*StreamResponse[GetCustomPermissionResponse], error)
+
+	// Creates a new poll
+	CreatePoll(ctx context.Context, request *CreatePollRequest, opts ...RequestOption) (*StreamResponse[PollResponse], error)
+
+	// Updates a poll
+	//
+	// Sends events:
+	// - feeds.poll.closed
+	// - feeds.poll.updated
+	// - poll.closed
+	// - poll.updated
+	UpdatePoll(ctx context.Context, request *UpdatePollRequest, opts ...RequestOption) (*StreamResponse[PollResponse], error)
+
+	// Queries polls
+	QueryPolls(ctx context.Context, request *QueryPollsRequest, opts ...RequestOption) (*StreamResponse[QueryPollsResponse], error)
+
+	// Deletes a poll
+	//
+	// Sends events:
+	// - feeds.poll.deleted
+	// - poll.deleted
+	DeletePoll(ctx context.Context, pollID string, request *DeletePollRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Retrieves a poll
+	GetPoll(ctx context.Context, pollID string, request *GetPollRequest, opts ...RequestOption) (*StreamResponse[PollResponse], error)
+
+	// Updates a poll partially
+	//
+	// Sends events:
+	// - feeds.poll.closed
+	// - feeds.poll.updated
+	// - poll.closed
+	// - poll.updated
+	UpdatePollPartial(ctx context.Context, pollID string, request *UpdatePollPartialRequest, opts ...RequestOption) (*StreamResponse[PollResponse], error)
+
+	// Creates a poll option
+	//
+	// Sends events:
+	// - feeds.poll.updated
+	// - poll.updated
+	CreatePollOption(ctx context.Context, pollID string, request *CreatePollOptionRequest, opts ...RequestOption) (*StreamResponse[PollOptionResponse], error)
+
+	// Updates a poll option
+	//
+	// Sends events:
+	// - feeds.poll.updated
+	// - poll.updated
+	UpdatePollOption(ctx context.Context, pollID string, request *UpdatePollOptionRequest, opts ...RequestOption) (*StreamResponse[PollOptionResponse], error)
+
+	// Deletes a poll option
+	//
+	// Sends events:
+	// - feeds.poll.updated
+	// - poll.updated
+	DeletePollOption(ctx context.Context, pollID string, optionID string, request *DeletePollOptionRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Retrieves a poll option
+	GetPollOption(ctx context.Context, pollID string, optionID string, request *GetPollOptionRequest, opts ...RequestOption) (*StreamResponse[PollOptionResponse], error)
+
+	// Queries votes
+	QueryPollVotes(ctx context.Context, pollID string, request *QueryPollVotesRequest, opts ...RequestOption) (*StreamResponse[PollVotesResponse], error)
+
+	// Upserts the push preferences for a user and or channel member. Set to all, mentions or none
+	UpdatePushNotificationPreferences(ctx context.Context, request *UpdatePushNotificationPreferencesRequest, opts ...RequestOption) (*StreamResponse[UpsertPushPreferencesResponse], error)
+
+	// List details of all push providers.
+	ListPushProviders(ctx context.Context, request *ListPushProvidersRequest, opts ...RequestOption) (*StreamResponse[ListPushProvidersResponse], error)
+
+	// Upsert a push provider for v2 with multi bundle/package support
+	UpsertPushProvider(ctx context.Context, request *UpsertPushProviderRequest, opts ...RequestOption) (*StreamResponse[UpsertPushProviderResponse], error)
+
+	// Delete a push provider from v2 with multi bundle/package support. v1 isn't supported in this endpoint
+	DeletePushProvider(ctx context.Context, _type string, name string, request *DeletePushProviderRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Retrieve push notification templates for Chat.
+	GetPushTemplates(ctx context.Context, request *GetPushTemplatesRequest, opts ...RequestOption) (*StreamResponse[GetPushTemplatesResponse], error)
+
+	// Create or update a push notification template for a specific event type and push provider
+	UpsertPushTemplate(ctx context.Context, request *UpsertPushTemplateRequest, opts ...RequestOption) (*StreamResponse[UpsertPushTemplateResponse], error)
+
+	// Get rate limits usage and quotas
+	GetRateLimits(ctx context.Context, request *GetRateLimitsRequest, opts ...RequestOption) (*StreamResponse[GetRateLimitsResponse], error)
+
+	// Lists all available roles
+	ListRoles(ctx context.Context, request *ListRolesRequest, opts ...RequestOption) (*StreamResponse[ListRolesResponse], error)
+
+	// Creates custom role
+	CreateRole(ctx context.Context, request *CreateRoleRequest, opts ...RequestOption) (*StreamResponse[CreateRoleResponse], error)
+
+	// Searches mentionable roles (user-assignable + channel-assignable, built-in and custom) by name prefix for autocomplete
+	SearchRoles(ctx context.Context, request *SearchRolesRequest, opts ...RequestOption) (*StreamResponse[SearchRolesResponse], error)
+
+	// Deletes custom role
+	DeleteRole(ctx context.Context, name string, request *DeleteRoleRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Gets status of a task
+	GetTask(ctx context.Context, id string, request *GetTaskRequest, opts ...RequestOption) (*StreamResponse[GetTaskResponse], error)
+
+	// Deletes previously uploaded file
+	DeleteFile(ctx context.Context, request *DeleteFileRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Uploads file
+	UploadFile(ctx context.Context, request *UploadFileRequest, opts ...RequestOption) (*StreamResponse[FileUploadResponse], error)
+
+	// Deletes previously uploaded image
+	DeleteImage(ctx context.Context, request *DeleteImageRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Uploads image
+	UploadImage(ctx context.Context, request *UploadImageRequest, opts ...RequestOption) (*StreamResponse[ImageUploadResponse], error)
+
+	// Lists user groups with cursor-based pagination
+	ListUserGroups(ctx context.Context, request *ListUserGroupsRequest, opts ...RequestOption) (*StreamResponse[ListUserGroupsResponse], error)
+
+	// Creates a new user group, optionally with initial members
+	CreateUserGroup(ctx context.Context, request *CreateUserGroupRequest, opts ...RequestOption) (*StreamResponse[CreateUserGroupResponse], error)
+
+	// Searches user groups by name prefix for autocomplete
+	SearchUserGroups(ctx context.Context, request *SearchUserGroupsRequest, opts ...RequestOption) (*StreamResponse[SearchUserGroupsResponse], error)
+
+	// Deletes a user group and all its members
+	DeleteUserGroup(ctx context.Context, id string, request *DeleteUserGroupRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Gets a user group by ID, including its members
+	GetUserGroup(ctx context.Context, id string, request *GetUserGroupRequest, opts ...RequestOption) (*StreamResponse[GetUserGroupResponse], error)
+
+	// Updates a user group's name and/or description. team_id is immutable.
+	UpdateUserGroup(ctx context.Context, id string, request *UpdateUserGroupRequest, opts ...RequestOption) (*StreamResponse[UpdateUserGroupResponse], error)
+
+	// Adds members to a user group. All user IDs must exist. The operation is all-or-nothing.
+	AddUserGroupMembers(ctx context.Context, id string, request *AddUserGroupMembersRequest, opts ...RequestOption) (*StreamResponse[AddUserGroupMembersResponse], error)
+
+	// Removes members from a user group. Users already not in the group are silently ignored.
+	RemoveUserGroupMembers(ctx context.Context, id string, request *RemoveUserGroupMembersRequest, opts ...RequestOption) (*StreamResponse[RemoveUserGroupMembersResponse], error)
+
+	// Find and filter users
+	QueryUsers(ctx context.Context, request *QueryUsersRequest, opts ...RequestOption) (*StreamResponse[QueryUsersResponse], error)
+
+	// Updates certain fields of the user
+	//
+	// Sends events:
+	// - user.presence.changed
+	// - user.updated
+	UpdateUsersPartial(ctx context.Context, request *UpdateUsersPartialRequest, opts ...RequestOption) (*StreamResponse[UpdateUsersResponse], error)
+
+	// Update or create users in bulk
+	//
+	// Sends events:
+	// - user.updated
+	UpdateUsers(ctx context.Context, request *UpdateUsersRequest, opts ...RequestOption) (*StreamResponse[UpdateUsersResponse], error)
+
+	// Get list of blocked Users
+	GetBlockedUsers(ctx context.Context, request *GetBlockedUsersRequest, opts ...RequestOption) (*StreamResponse[GetBlockedUsersResponse], error)
+
+	// Block users
+	BlockUsers(ctx context.Context, request *BlockUsersRequest, opts ...RequestOption) (*StreamResponse[BlockUsersResponse], error)
+
+	// Deactivate users in batches
+	//
+	// Sends events:
+	// - user.deactivated
+	DeactivateUsers(ctx context.Context, request *DeactivateUsersRequest, opts ...RequestOption) (*StreamResponse[DeactivateUsersResponse], error)
+
+	// Deletes users and optionally all their belongings asynchronously.
+	//
+	// Sends events:
+	// - channel.deleted
+	// - user.deleted
+	DeleteUsers(ctx context.Context, request *DeleteUsersRequest, opts ...RequestOption) (*StreamResponse[DeleteUsersResponse], error)
+
+	// Retrieves all active live locations for a user
+	GetUserLiveLocations(ctx context.Context, request *GetUserLiveLocationsRequest, opts ...RequestOption) (*StreamResponse[SharedLocationsResponse], error)
+
+	// Updates an existing live location with new coordinates or expiration time
+	UpdateLiveLocation(ctx context.Context, request *UpdateLiveLocationRequest, opts ...RequestOption) (*StreamResponse[SharedLocationResponse], error)
+
+	// Reactivate users in batches
+	//
+	// Sends events:
+	// - user.reactivated
+	ReactivateUsers(ctx context.Context, request *ReactivateUsersRequest, opts ...RequestOption) (*StreamResponse[ReactivateUsersResponse], error)
+
+	// Restore soft deleted users
+	RestoreUsers(ctx context.Context, request *RestoreUsersRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Unblock users
+	UnblockUsers(ctx context.Context, request *UnblockUsersRequest, opts ...RequestOption) (*StreamResponse[UnblockUsersResponse], error)
+
+	// Deactivates user with possibility to activate it back
+	//
+	// Sends events:
+	// - user.deactivated
+	DeactivateUser(ctx context.Context, userID string, request *DeactivateUserRequest, opts ...RequestOption) (*StreamResponse[DeactivateUserResponse], error)
+
+	// Exports the user's profile, reactions and messages. Raises an error if a user has more than 10k messages or reactions
+	ExportUser(ctx context.Context, userID string, request *ExportUserRequest, opts ...RequestOption) (*StreamResponse[ExportUserResponse], error)
+
+	// Activates user who's been deactivated previously
+	//
+	// Sends events:
+	// - user.reactivated
+	ReactivateUser(ctx context.Context, userID string, request *ReactivateUserRequest, opts ...RequestOption) (*StreamResponse[ReactivateUserResponse], error)
+}
+
+// ChatAPI is the set of API calls of *ChatClient. Depend on it rather than on
+// the concrete client to substitute mockgetstream.ChatAPI in tests.
+type ChatAPI interface {
+	// Creates a campaign
+	CreateCampaign(ctx context.Context, request *CreateCampaignRequest, opts ...RequestOption) (*StreamResponse[CreateCampaignResponse], error)
+
+	// Query campaigns with filter query
+	QueryCampaigns(ctx context.Context, request *QueryCampaignsRequest, opts ...RequestOption) (*StreamResponse[QueryCampaignsResponse], error)
+
+	// Delete campaign
+	DeleteCampaign(ctx context.Context, id string, request *DeleteCampaignRequest, opts ...RequestOption) (*StreamResponse[DeleteCampaignResponse], error)
+
+	// Get campaign by ID.
+	GetCampaign(ctx context.Context, id string, request *GetCampaignRequest, opts ...RequestOption) (*StreamResponse[GetCampaignResponse], error)
+
+	// Updates a campaign
+	UpdateCampaign(ctx context.Context, id string, request *UpdateCampaignRequest, opts ...RequestOption) (*StreamResponse[CampaignResponse], error)
+
+	// Starts or schedules a campaign
+	StartCampaign(ctx context.Context, id string, request *StartCampaignRequest, opts ...RequestOption) (*StreamResponse[StartCampaignResponse], error)
+
+	// Stops a campaign
+	StopCampaign(ctx context.Context, id string, request *StopCampaignRequest, opts ...RequestOption) (*StreamResponse[CampaignResponse], error)
+
+	// Query channels with filter query
+	QueryChannels(ctx context.Context, request *QueryChannelsRequest, opts ...RequestOption) (*StreamResponse[QueryChannelsResponse], error)
+
+	// Update channels in batch
+	//
+	// Sends events:
+	// - channel.frozen
+	// - channel.hidden
+	// - channel.unfrozen
+	// - channel.updated
+	// - channel.visible
+	// - member.added
+	// - member.removed
+	// - member.updated
+	ChannelBatchUpdate(ctx context.Context, request *ChannelBatchUpdateRequest, opts ...RequestOption) (*StreamResponse[ChannelBatchUpdateResponse], error)
+
+	// Allows to delete several channels at once asynchronously
+	//
+	// Sends events:
+	// - channel.deleted
+	DeleteChannels(ctx context.Context, request *DeleteChannelsRequest, opts ...RequestOption) (*StreamResponse[DeleteChannelsResponse], error)
+
+	// Mark the status of a channel message delivered.
+	MarkDelivered(ctx context.Context, request *MarkDeliveredRequest, opts ...RequestOption) (*StreamResponse[MarkDeliveredResponse], error)
+
+	// Query channels grouped into predefined buckets. Only available for enterprise apps.
+	GroupedQueryChannels(ctx context.Context, request *GroupedQueryChannelsRequest, opts ...RequestOption) (*StreamResponse[GroupedQueryChannelsResponse], error)
+
+	// Marks channels as read up to the specific message. If no channels is given, mark all channel as read
+	//
+	// Sends events:
+	// - message.read
+	MarkChannelsRead(ctx context.Context, request *MarkChannelsReadRequest, opts ...RequestOption) (*StreamResponse[MarkReadResponse], error)
+
+	// This Method creates a channel or returns an existing one with matching attributes
+	//
+	// Sends events:
+	// - channel.created
+	// - member.added
+	// - member.removed
+	// - member.updated
+	// - user.watching.start
+	GetOrCreateDistinctChannel(ctx context.Context, _type string, request *GetOrCreateDistinctChannelRequest, opts ...RequestOption) (*StreamResponse[ChannelStateResponse], error)
+
+	// Deletes channel
+	//
+	// Sends events:
+	// - channel.deleted
+	DeleteChannel(ctx context.Context, _type string, id string, request *DeleteChannelRequest, opts ...RequestOption) (*StreamResponse[DeleteChannelResponse], error)
+
+	// Returns a channel by its CID without creating it. Responds with 404 when the channel does not exist, so it doubles as an existence check. Pass state=true to also load messages, read state and watchers, and the messages_id_* parameters to page those messages by message ID.
+	GetChannel(ctx context.Context, _type string, id string, request *GetChannelRequest, opts ...RequestOption) (*StreamResponse[ChannelStateResponse], error)
+
+	// Updates certain fields of the channel
+	//
+	// Sends events:
+	// - channel.updated
+	UpdateChannelPartial(ctx context.Context, _type string, id string, request *UpdateChannelPartialRequest, opts ...RequestOption) (*StreamResponse[UpdateChannelPartialResponse], error)
+
+	// Change channel data
+	//
+	// Sends events:
+	// - channel.updated
+	// - member.added
+	// - member.removed
+	// - member.updated
+	// - message.new
+	UpdateChannel(ctx context.Context, _type string, id string, request *UpdateChannelRequest, opts ...RequestOption) (*StreamResponse[UpdateChannelResponse], error)
+
+	// Deletes a draft
+	//
+	// Sends events:
+	// - draft.deleted
+	DeleteDraft(ctx context.Context, _type string, id string, request *DeleteDraftRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Get a draft
+	GetDraft(ctx context.Context, _type string, id string, request *GetDraftRequest, opts ...RequestOption) (*StreamResponse[GetDraftResponse], error)
+
+	// Sends event to the channel
+	SendEvent(ctx context.Context, _type string, id string, request *SendEventRequest, opts ...RequestOption) (*StreamResponse[EventResponse], error)
+
+	// Deletes previously uploaded file
+	DeleteChannelFile(ctx context.Context, _type string, id string, request *DeleteChannelFileRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Uploads file
+	UploadChannelFile(ctx context.Context, _type string, id string, request *UploadChannelFileRequest, opts ...RequestOption) (*StreamResponse[UploadChannelFileResponse], error)
+
+	// Marks channel as hidden for current user
+	//
+	// Sends events:
+	// - channel.hidden
+	HideChannel(ctx context.Context, _type string, id string, request *HideChannelRequest, opts ...RequestOption) (*StreamResponse[HideChannelResponse], error)
+
+	// Deletes previously uploaded image
+	DeleteChannelImage(ctx context.Context, _type string, id string, request *DeleteChannelImageRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Uploads image
+	UploadChannelImage(ctx context.Context, _type string, id string, request *UploadChannelImageRequest, opts ...RequestOption) (*StreamResponse[UploadChannelResponse], error)
+
+	UpdateMemberPartial(ctx context.Context, _type string, id string, request *UpdateMemberPartialRequest, opts ...RequestOption) (*StreamResponse[UpdateMemberPartialResponse], error)
+
+	// Sends new message to the specified channel
+	//
+	// Sends events:
+	// - channel.visible
+	// - message.new
+	// - message.updated
+	SendMessage(ctx context.Context, _type string, id string, request *SendMessageRequest, opts ...RequestOption) (*StreamResponse[SendMessageResponse], error)
+
+	// Returns list messages found by IDs
+	GetManyMessages(ctx context.Context, _type string, id string, request *GetManyMessagesRequest, opts ...RequestOption) (*StreamResponse[GetManyMessagesResponse], error)
+
+	// This Method creates a channel or returns an existing one with matching attributes
+	//
+	// Sends events:
+	// - channel.created
+	// - member.added
+	// - member.removed
+	// - member.updated
+	// - user.watching.start
+	GetOrCreateChannel(ctx context.Context, _type string, id string, request *GetOrCreateChannelRequest, opts ...RequestOption) (*StreamResponse[ChannelStateResponse], error)
+
+	// Marks channel as read up to the specific message
+	//
+	// Sends events:
+	// - message.read
+	MarkRead(ctx context.Context, _type string, id string, request *MarkReadRequest, opts ...RequestOption) (*StreamResponse[MarkReadResponse], error)
+
+	// Shows previously hidden channel
+	//
+	// Sends events:
+	// - channel.visible
+	ShowChannel(ctx context.Context, _type string, id string, request *ShowChannelRequest, opts ...RequestOption) (*StreamResponse[ShowChannelResponse], error)
+
+	// Truncates messages from a channel. Can be applied to the entire channel or scoped to specific members.
+	//
+	// Sends events:
+	// - channel.truncated
+	TruncateChannel(ctx context.Context, _type string, id string, request *TruncateChannelRequest, opts ...RequestOption) (*StreamResponse[TruncateChannelResponse], error)
+
+	// Marks channel as unread from a specific message
+	MarkUnread(ctx context.Context, _type string, id string, request *MarkUnreadRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Lists all available channel types
+	ListChannelTypes(ctx context.Context, request *ListChannelTypesRequest, opts ...RequestOption) (*StreamResponse[ListChannelTypesResponse], error)
+
+	// Creates new channel type
+	CreateChannelType(ctx context.Context, request *CreateChannelTypeRequest, opts ...RequestOption) (*StreamResponse[CreateChannelTypeResponse], error)
+
+	// Deletes channel type
+	DeleteChannelType(ctx context.Context, name string, request *DeleteChannelTypeRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Gets channel type
+	GetChannelType(ctx context.Context, name string, request *GetChannelTypeRequest, opts ...RequestOption) (*StreamResponse[GetChannelTypeResponse], error)
+
+	// Updates channel type
+	UpdateChannelType(ctx context.Context, name string, request *UpdateChannelTypeRequest, opts ...RequestOption) (*StreamResponse[UpdateChannelTypeResponse], error)
+
+	// Returns all custom commands
+	ListCommands(ctx context.Context, request *ListCommandsRequest, opts ...RequestOption) (*StreamResponse[ListCommandsResponse], error)
+
+	// Creates custom chat command
+	CreateCommand(ctx context.Context, request *CreateCommandRequest, opts ...RequestOption) (*StreamResponse[CreateCommandResponse], error)
+
+	// Deletes custom chat command
+	DeleteCommand(ctx context.Context, name string, request *DeleteCommandRequest, opts ...RequestOption) (*StreamResponse[DeleteCommandResponse], error)
+
+	// Returns custom command by its name
+	GetCommand(ctx context.Context, name string, request *GetCommandRequest, opts ...RequestOption) (*StreamResponse[GetCommandResponse], error)
+
+	// Updates custom chat command
+	UpdateCommand(ctx context.Context, name string, request *UpdateCommandRequest, opts ...RequestOption) (*StreamResponse[UpdateCommandResponse], error)
+
+	// Queries draft messages for a user
+	QueryDrafts(ctx context.Context, request *QueryDraftsRequest, opts ...RequestOption) (*StreamResponse[QueryDraftsResponse], error)
+
+	// Exports channel data to a JSON or CSV file (CSV requires version=v2)
+	ExportChannels(ctx context.Context, request *ExportChannelsRequest, opts ...RequestOption) (*StreamResponse[ExportChannelsResponse], error)
+
+	// Find and filter channel members
+	QueryMembers(ctx context.Context, request *QueryMembersRequest, opts ...RequestOption) (*StreamResponse[MembersResponse], error)
+
+	// Queries history for one message
+	QueryMessageHistory(ctx context.Context, request *QueryMessageHistoryRequest, opts ...RequestOption) (*StreamResponse[QueryMessageHistoryResponse], error)
+
+	// Deletes message
+	//
+	// Sends events:
+	// - message.deleted
+	DeleteMessage(ctx context.Context, id string, request *DeleteMessageRequest, opts ...RequestOption) (*StreamResponse[DeleteMessageResponse], error)
+
+	// Returns message by ID
+	GetMessage(ctx context.Context, id string, request *GetMessageRequest, opts ...RequestOption) (*StreamResponse[GetMessageResponse], error)
+
+	// Updates message with new data
+	//
+	// Sends events:
+	// - message.updated
+	UpdateMessage(ctx context.Context, id string, request *UpdateMessageRequest, opts ...RequestOption) (*StreamResponse[UpdateMessageResponse], error)
+
+	// Updates certain fields of the message
+	//
+	// Sends events:
+	// - message.updated
+	UpdateMessagePartial(ctx context.Context, id string, request *UpdateMessagePartialRequest, opts ...RequestOption) (*StreamResponse[UpdateMessagePartialResponse], error)
+
+	// Executes message command action with given parameters
+	//
+	// Sends events:
+	// - message.new
+	RunMessageAction(ctx context.Context, id string, request *RunMessageActionRequest, opts ...RequestOption) (*StreamResponse[MessageActionResponse], error)
+
+	// Commits a pending message, which will make it visible in the channel
+	//
+	// Sends events:
+	// - message.new
+	// - message.updated
+	CommitMessage(ctx context.Context, id string, request *CommitMessageRequest, opts ...RequestOption) (*StreamResponse[MessageActionResponse], error)
+
+	// Updates message fields without storing in database, only sends update event
+	//
+	// Sends events:
+	// - message.updated
+	EphemeralMessageUpdate(ctx context.Context, id string, request *EphemeralMessageUpdateRequest, opts ...RequestOption) (*StreamResponse[UpdateMessagePartialResponse], error)
+
+	// Sends reaction to specified message
+	//
+	// Sends events:
+	// - reaction.new
+	// - reaction.updated
+	SendReaction(ctx context.Context, id string, request *SendReactionRequest, opts ...RequestOption) (*StreamResponse[SendReactionResponse], error)
+
+	// Removes user reaction from the message
+	//
+	// Sends events:
+	// - reaction.deleted
+	DeleteReaction(ctx context.Context, id string, _type string, request *DeleteReactionRequest, opts ...RequestOption) (*StreamResponse[DeleteReactionResponse], error)
+
+	// Returns list of reactions of specific message
+	GetReactions(ctx context.Context, id string, request *GetReactionsRequest, opts ...RequestOption) (*StreamResponse[GetReactionsResponse], error)
+
+	// Get reactions on a message
+	QueryReactions(ctx context.Context, id string, request *QueryReactionsRequest, opts ...RequestOption) (*StreamResponse[QueryReactionsResponse], error)
+
+	// Translates message to a given language using automated translation software
+	//
+	// Sends events:
+	// - message.updated
+	TranslateMessage(ctx context.Context, id string, request *TranslateMessageRequest, opts ...RequestOption) (*StreamResponse[MessageActionResponse], error)
+
+	// Undelete a message that was previously soft-deleted
+	//
+	// Sends events:
+	// - message.undeleted
+	UndeleteMessage(ctx context.Context, id string, request *UndeleteMessageRequest, opts ...RequestOption) (*StreamResponse[UndeleteMessageResponse], error)
+
+	// Cast a vote on a poll
+	//
+	// Sends events:
+	// - feeds.poll.vote_casted
+	// - feeds.poll.vote_changed
+	// - feeds.poll.vote_removed
+	// - poll.vote_casted
+	// - poll.vote_changed
+	// - poll.vote_removed
+	CastPollVote(ctx context.Context, messageID string, pollID string, request *CastPollVoteRequest, opts ...RequestOption) (*StreamResponse[PollVoteResponse], error)
+
+	// Delete a vote from a poll
+	//
+	// Sends events:
+	// - feeds.poll.vote_removed
+	// - poll.vote_removed
+	DeletePollVote(ctx context.Context, messageID string, pollID string, voteID string, request *DeletePollVoteRequest, opts ...RequestOption) (*StreamResponse[PollVoteResponse], error)
+
+	// Deletes a user's created reminder
+	//
+	// Sends events:
+	// - reminder.deleted
+	DeleteReminder(ctx context.Context, messageID string, request *DeleteReminderRequest, opts ...RequestOption) (*StreamResponse[DeleteReminderResponse], error)
+
+	// Updates an existing reminder
+	//
+	// Sends events:
+	// - reminder.updated
+	UpdateReminder(ctx context.Context, messageID string, request *UpdateReminderRequest, opts ...RequestOption) (*StreamResponse[UpdateReminderResponse], error)
+
+	// Creates a new reminder
+	//
+	// Sends events:
+	// - reminder.created
+	CreateReminder(ctx context.Context, messageID string, request *CreateReminderRequest, opts ...RequestOption) (*StreamResponse[ReminderResponseData], error)
+
+	// Returns replies (thread) of the message
+	GetReplies(ctx context.Context, parentID string, request *GetRepliesRequest, opts ...RequestOption) (*StreamResponse[GetRepliesResponse], error)
+
+	// Find and filter message flags
+	QueryMessageFlags(ctx context.Context, request *QueryMessageFlagsRequest, opts ...RequestOption) (*StreamResponse[QueryMessageFlagsResponse], error)
+
+	// Mutes channel for user
+	//
+	// Sends events:
+	// - channel.muted
+	MuteChannel(ctx context.Context, request *MuteChannelRequest, opts ...RequestOption) (*StreamResponse[MuteChannelResponse], error)
+
+	// Unmutes channel for user
+	//
+	// Sends events:
+	// - channel.unmuted
+	UnmuteChannel(ctx context.Context, request *UnmuteChannelRequest, opts ...RequestOption) (*StreamResponse[UnmuteResponse], error)
+
+	// Find and filter channel scoped or global user bans
+	QueryBannedUsers(ctx context.Context, request *QueryBannedUsersRequest, opts ...RequestOption) (*StreamResponse[QueryBannedUsersResponse], error)
+
+	// Find and filter future channel bans created by the authenticated user
+	QueryFutureChannelBans(ctx context.Context, request *QueryFutureChannelBansRequest, opts ...RequestOption) (*StreamResponse[QueryFutureChannelBansResponse], error)
+
+	// Queries reminders
+	QueryReminders(ctx context.Context, request *QueryRemindersRequest, opts ...RequestOption) (*StreamResponse[QueryRemindersResponse], error)
+
+	// Returns all retention policies configured for the app. Server-side only.
+	GetRetentionPolicy(ctx context.Context, request *GetRetentionPolicyRequest, opts ...RequestOption) (*StreamResponse[GetRetentionPolicyResponse], error)
+
+	// Creates or updates a retention policy for the app. Server-side only.
+	SetRetentionPolicy(ctx context.Context, request *SetRetentionPolicyRequest, opts ...RequestOption) (*StreamResponse[SetRetentionPolicyResponse], error)
+
+	// Removes a retention policy for the app. Server-side only.
+	DeleteRetentionPolicy(ctx context.Context, request *DeleteRetentionPolicyRequest, opts ...RequestOption) (*StreamResponse[DeleteRetentionPolicyResponse], error)
+
+	// Returns filtered and sorted retention cleanup run history for the app. Supports filter_conditions on 'policy' (possible values: 'old-messages', 'inactive-channels') and 'date' fields. Server-side only.
+	GetRetentionPolicyRuns(ctx context.Context, request *GetRetentionPolicyRunsRequest, opts ...RequestOption) (*StreamResponse[GetRetentionPolicyRunsResponse], error)
+
+	// Search messages across channels
+	Search(ctx context.Context, request *SearchRequest, opts ...RequestOption) (*StreamResponse[SearchResponse], error)
+
+	// Create a segment
+	CreateSegment(ctx context.Context, request *CreateSegmentRequest, opts ...RequestOption) (*StreamResponse[CreateSegmentResponse], error)
+
+	// Query segments
+	QuerySegments(ctx context.Context, request *QuerySegmentsRequest, opts ...RequestOption) (*StreamResponse[QuerySegmentsResponse], error)
+
+	// Delete a segment
+	DeleteSegment(ctx context.Context, id string, request *DeleteSegmentRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Get segment
+	GetSegment(ctx context.Context, id string, request *GetSegmentRequest, opts ...RequestOption) (*StreamResponse[GetSegmentResponse], error)
+
+	// Update an existing segment
+	UpdateSegment(ctx context.Context, id string, request *UpdateSegmentRequest, opts ...RequestOption) (*StreamResponse[UpdateSegmentResponse], error)
+
+	// Add targets to a segment
+	AddSegmentTargets(ctx context.Context, id string, request *AddSegmentTargetsRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Delete targets from a segment
+	DeleteSegmentTargets(ctx context.Context, id string, request *DeleteSegmentTargetsRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Check whether a target exists in a segment. Returns 200 if the target exists, 404 otherwise
+	SegmentTargetExists(ctx context.Context, id string, targetID string, request *SegmentTargetExistsRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Query segment targets
+	QuerySegmentTargets(ctx context.Context, id string, request *QuerySegmentTargetsRequest, opts ...RequestOption) (*StreamResponse[QuerySegmentTargetsResponse], error)
+
+	// Retrieve team-level usage statistics from the warehouse database.
+	// Returns all 16 metrics grouped by team with cursor-based pagination.
+	//
+	// **Date Range Options (mutually exclusive):**
+	// - Use 'month' parameter (YYYY-MM format) for monthly aggregated values
+	// - Use 'start_date'/'end_date' parameters (YYYY-MM-DD format) for daily breakdown
+	// - If neither provided, defaults to current month (monthly mode)
+	//
+	// This endpoint is server-side only.
+	QueryTeamUsageStats(ctx context.Context, request *QueryTeamUsageStatsRequest, opts ...RequestOption) (*StreamResponse[QueryTeamUsageStatsResponse], error)
+
+	// Returns the list of threads for specific user
+	QueryThreads(ctx context.Context, request *QueryThreadsRequest, opts ...RequestOption) (*StreamResponse[QueryThreadsResponse], error)
+
+	// Return a specific thread
+	GetThread(ctx context.Context, messageID string, request *GetThreadRequest, opts ...RequestOption) (*StreamResponse[GetThreadResponse], error)
+
+	// Updates certain fields of the thread
+	//
+	// Sends events:
+	// - thread.updated
+	UpdateThreadPartial(ctx context.Context, messageID string, request *UpdateThreadPartialRequest, opts ...RequestOption) (*StreamResponse[UpdateThreadPartialResponse], error)
+
+	// Fetch unread counts for a single user
+	UnreadCounts(ctx context.Context, request *UnreadCountsRequest, opts ...RequestOption) (*StreamResponse[WrappedUnreadCountsResponse], error)
+
+	// Fetch unread counts in batch for multiple users in one call
+	UnreadCountsBatch(ctx context.Context, request *UnreadCountsBatchRequest, opts ...RequestOption) (*StreamResponse[UnreadCountsBatchResponse], error)
+
+	// Sends a custom event to a user
+	//
+	// Sends events:
+	// - *
+	SendUserCustomEvent(ctx context.Context, userID string, request *SendUserCustomEventRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+}
+
+// VideoAPI is the set of API calls of *VideoClient. Depend on it rather than on
+// the concrete client to substitute mockgetstream.VideoAPI in tests.
+type VideoAPI interface {
+	// Get the current status of all active calls including metrics and summary information
+	GetActiveCallsStatus(ctx context.Context, request *GetActiveCallsStatusRequest, opts ...RequestOption) (*StreamResponse[GetActiveCallsStatusResponse], error)
+
+	QueryUserFeedback(ctx context.Context, request *QueryUserFeedbackRequest, opts ...RequestOption) (*StreamResponse[QueryUserFeedbackResponse], error)
+
+	// Query call members with filter query
+	QueryCallMembers(ctx context.Context, request *QueryCallMembersRequest, opts ...RequestOption) (*StreamResponse[QueryCallMembersResponse], error)
+
+	QueryCallStats(ctx context.Context, request *QueryCallStatsRequest, opts ...RequestOption) (*StreamResponse[QueryCallStatsResponse], error)
+
+	GetCall(ctx context.Context, _type string, id string, request *GetCallRequest, opts ...RequestOption) (*StreamResponse[GetCallResponse], error)
+
+	// Sends events:
+	// - call.updated
+	UpdateCall(ctx context.Context, _type string, id string, request *UpdateCallRequest, opts ...RequestOption) (*StreamResponse[UpdateCallResponse], error)
+
+	// Gets or creates a new call
+	//
+	// Sends events:
+	// - call.created
+	// - call.notification
+	// - call.ring
+	GetOrCreateCall(ctx context.Context, _type string, id string, request *GetOrCreateCallRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateCallResponse], error)
+
+	// Block a user, preventing them from joining the call until they are unblocked.
+	//
+	// Sends events:
+	// - call.blocked_user
+	BlockUser(ctx context.Context, _type string, id string, request *BlockUserRequest, opts ...RequestOption) (*StreamResponse[BlockUserResponse], error)
+
+	// Sends a closed caption event to the call
+	//
+	// Sends events:
+	// - call.closed_caption
+	SendClosedCaption(ctx context.Context, _type string, id string, request *SendClosedCaptionRequest, opts ...RequestOption) (*StreamResponse[SendClosedCaptionResponse], error)
+
+	// Sends events:
+	// - call.deleted
+	DeleteCall(ctx context.Context, _type string, id string, request *DeleteCallRequest, opts ...RequestOption) (*StreamResponse[DeleteCallResponse], error)
+
+	// Sends custom event to the call
+	//
+	// Sends events:
+	// - custom
+	SendCallEvent(ctx context.Context, _type string, id string, request *SendCallEventRequest, opts ...RequestOption) (*StreamResponse[SendCallEventResponse], error)
+
+	// Sends events:
+	// - call.user_feedback_submitted
+	CollectUserFeedback(ctx context.Context, _type string, id string, request *CollectUserFeedbackRequest, opts ...RequestOption) (*StreamResponse[CollectUserFeedbackResponse], error)
+
+	// Sends events:
+	// - call.live_started
+	GoLive(ctx context.Context, _type string, id string, request *GoLiveRequest, opts ...RequestOption) (*StreamResponse[GoLiveResponse], error)
+
+	// Kicks a user from the call. Optionally block the user from rejoining by setting block=true.
+	//
+	// Sends events:
+	// - call.blocked_user
+	// - call.kicked_user
+	KickUser(ctx context.Context, _type string, id string, request *KickUserRequest, opts ...RequestOption) (*StreamResponse[KickUserResponse], error)
+
+	// Sends events:
+	// - call.ended
+	EndCall(ctx context.Context, _type string, id string, request *EndCallRequest, opts ...RequestOption) (*StreamResponse[EndCallResponse], error)
+
+	// Sends events:
+	// - call.member_added
+	// - call.member_removed
+	// - call.member_updated
+	UpdateCallMembers(ctx context.Context, _type string, id string, request *UpdateCallMembersRequest, opts ...RequestOption) (*StreamResponse[UpdateCallMembersResponse], error)
+
+	// Mutes users in a call
+	MuteUsers(ctx context.Context, _type string, id string, request *MuteUsersRequest, opts ...RequestOption) (*StreamResponse[MuteUsersResponse], error)
+
+	// Returns a list of participants connected to the call
+	QueryCallParticipants(ctx context.Context, id string, _type string, request *QueryCallParticipantsRequest, opts ...RequestOption) (*StreamResponse[QueryCallParticipantsResponse], error)
+
+	// Pins a track for all users in the call.
+	VideoPin(ctx context.Context, _type string, id string, request *VideoPinRequest, opts ...RequestOption) (*StreamResponse[PinResponse], error)
+
+	// Lists recordings
+	ListRecordings(ctx context.Context, _type string, id string, request *ListRecordingsRequest, opts ...RequestOption) (*StreamResponse[ListRecordingsResponse], error)
+
+	// Starts recording
+	//
+	// Sends events:
+	// - call.recording_started
+	StartRecording(ctx context.Context, _type string, id string, recordingType string, request *StartRecordingRequest, opts ...RequestOption) (*StreamResponse[StartRecordingResponse], error)
+
+	// Stops recording
+	//
+	// Sends events:
+	// - call.recording_stopped
+	StopRecording(ctx context.Context, _type string, id string, recordingType string, request *StopRecordingRequest, opts ...RequestOption) (*StreamResponse[StopRecordingResponse], error)
+
+	GetCallReport(ctx context.Context, _type string, id string, request *GetCallReportRequest, opts ...RequestOption) (*StreamResponse[GetCallReportResponse], error)
+
+	// Sends a ring notification to the provided users who are not already in the call. All users should be members of the call
+	//
+	// Sends events:
+	// - call.ring
+	RingCall(ctx context.Context, _type string, id string, request *RingCallRequest, opts ...RequestOption) (*StreamResponse[RingCallResponse], error)
+
+	// Starts RTMP broadcasts for the provided RTMP destinations
+	StartRTMPBroadcasts(ctx context.Context, _type string, id string, request *StartRTMPBroadcastsRequest, opts ...RequestOption) (*StreamResponse[StartRTMPBroadcastsResponse], error)
+
+	// Stop all RTMP broadcasts for the provided call
+	StopAllRTMPBroadcasts(ctx context.Context, _type string, id string, request *StopAllRTMPBroadcastsRequest, opts ...RequestOption) (*StreamResponse[StopAllRTMPBroadcastsResponse], error)
+
+	// Stop RTMP broadcasts for the provided RTMP destinations
+	StopRTMPBroadcast(ctx context.Context, _type string, id string, name string, request *StopRTMPBroadcastRequest, opts ...RequestOption) (*StreamResponse[StopRTMPBroadcastsResponse], error)
+
+	GetCallParticipantSessionMetrics(ctx context.Context, _type string, id string, session string, user string, userSession string, request *GetCallParticipantSessionMetricsRequest, opts ...RequestOption) (*StreamResponse[GetCallParticipantSessionMetricsResponse], error)
+
+	QueryCallParticipantSessions(ctx context.Context, _type string, id string, session string, request *QueryCallParticipantSessionsRequest, opts ...RequestOption) (*StreamResponse[QueryCallParticipantSessionsResponse], error)
+
+	// Starts HLS broadcasting
+	StartHLSBroadcasting(ctx context.Context, _type string, id string, request *StartHLSBroadcastingRequest, opts ...RequestOption) (*StreamResponse[StartHLSBroadcastingResponse], error)
+
+	// Starts closed captions
+	StartClosedCaptions(ctx context.Context, _type string, id string, request *StartClosedCaptionsRequest, opts ...RequestOption) (*StreamResponse[StartClosedCaptionsResponse], error)
+
+	// Starts frame by frame recording
+	//
+	// Sends events:
+	// - call.frame_recording_started
+	StartFrameRecording(ctx context.Context, _type string, id string, request *StartFrameRecordingRequest, opts ...RequestOption) (*StreamResponse[StartFrameRecordingResponse], error)
+
+	// Starts transcription
+	StartTranscription(ctx context.Context, _type string, id string, request *StartTranscriptionRequest, opts ...RequestOption) (*StreamResponse[StartTranscriptionResponse], error)
+
+	// Stops HLS broadcasting
+	StopHLSBroadcasting(ctx context.Context, _type string, id string, request *StopHLSBroadcastingRequest, opts ...RequestOption) (*StreamResponse[StopHLSBroadcastingResponse], error)
+
+	// Stops closed captions
+	//
+	// Sends events:
+	// - call.transcription_stopped
+	StopClosedCaptions(ctx context.Context, _type string, id string, request *StopClosedCaptionsRequest, opts ...RequestOption) (*StreamResponse[StopClosedCaptionsResponse], error)
+
+	// Stops frame recording
+	//
+	// Sends events:
+	// - call.frame_recording_stopped
+	StopFrameRecording(ctx context.Context, _type string, id string, request *StopFrameRecordingRequest, opts ...RequestOption) (*StreamResponse[StopFrameRecordingResponse], error)
+
+	// Sends events:
+	// - call.updated
+	StopLive(ctx context.Context, _type string, id string, request *StopLiveRequest, opts ...RequestOption) (*StreamResponse[StopLiveResponse], error)
+
+	// Stops transcription
+	//
+	// Sends events:
+	// - call.transcription_stopped
+	StopTranscription(ctx context.Context, _type string, id string, request *StopTranscriptionRequest, opts ...RequestOption) (*StreamResponse[StopTranscriptionResponse], error)
+
+	// Lists transcriptions
+	ListTranscriptions(ctx context.Context, _type string, id string, request *ListTranscriptionsRequest, opts ...RequestOption) (*StreamResponse[ListTranscriptionsResponse], error)
+
+	// Removes the block for a user on a call. The user will be able to join the call again.
+	//
+	// Sends events:
+	// - call.unblocked_user
+	UnblockUser(ctx context.Context, _type string, id string, request *UnblockUserRequest, opts ...RequestOption) (*StreamResponse[UnblockUserResponse], error)
+
+	// Unpins a track for all users in the call.
+	VideoUnpin(ctx context.Context, _type string, id string, request *VideoUnpinRequest, opts ...RequestOption) (*StreamResponse[UnpinResponse], error)
+
+	// Updates user permissions
+	//
+	// Sends events:
+	// - call.permissions_updated
+	UpdateUserPermissions(ctx context.Context, _type string, id string, request *UpdateUserPermissionsRequest, opts ...RequestOption) (*StreamResponse[UpdateUserPermissionsResponse], error)
+
+	// Deletes recording
+	DeleteRecording(ctx context.Context, _type string, id string, session string, filename string, request *DeleteRecordingRequest, opts ...RequestOption) (*StreamResponse[DeleteRecordingResponse], error)
+
+	// Deletes transcription
+	DeleteTranscription(ctx context.Context, _type string, id string, session string, filename string, request *DeleteTranscriptionRequest, opts ...RequestOption) (*StreamResponse[DeleteTranscriptionResponse], error)
+
+	// Reports a batch of client-side telemetry events. Events are processed independently; one invalid event does not block the rest of the batch, but the request fails if any event is invalid.
+	ReportClientCallEvent(ctx context.Context, request *ReportClientCallEventRequest, opts ...RequestOption) (*StreamResponse[ReportClientEventResponse], error)
+
+	QueryCallSessionStats(ctx context.Context, request *QueryCallSessionStatsRequest, opts ...RequestOption) (*StreamResponse[QueryCallSessionStatsResponse], error)
+
+	GetCallStatsMap(ctx context.Context, callType string, callID string, session string, request *GetCallStatsMapRequest, opts ...RequestOption) (*StreamResponse[QueryCallStatsMapResponse], error)
+
+	GetCallSessionParticipantStatsDetails(ctx context.Context, callType string, callID string, session string, user string, userSession string, request *GetCallSessionParticipantStatsDetailsRequest, opts ...RequestOption) (*StreamResponse[GetCallSessionParticipantStatsDetailsResponse], error)
+
+	QueryCallSessionParticipantStats(ctx context.Context, callType string, callID string, session string, request *QueryCallSessionParticipantStatsRequest, opts ...RequestOption) (*StreamResponse[QueryCallSessionParticipantStatsResponse], error)
+
+	GetCallSessionParticipantStatsTimeline(ctx context.Context, callType string, callID string, session string, user string, userSession string, request *GetCallSessionParticipantStatsTimelineRequest, opts ...RequestOption) (*StreamResponse[QueryCallSessionParticipantStatsTimelineResponse], error)
+
+	// Query calls with filter query
+	QueryCalls(ctx context.Context, request *QueryCallsRequest, opts ...RequestOption) (*StreamResponse[QueryCallsResponse], error)
+
+	ListCallTypes(ctx context.Context, request *ListCallTypesRequest, opts ...RequestOption) (*StreamResponse[ListCallTypeResponse], error)
+
+	CreateCallType(ctx context.Context, request *CreateCallTypeRequest, opts ...RequestOption) (*StreamResponse[CreateCallTypeResponse], error)
+
+	DeleteCallType(ctx context.Context, name string, request *DeleteCallTypeRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	GetCallType(ctx context.Context, name string, request *GetCallTypeRequest, opts ...RequestOption) (*StreamResponse[GetCallTypeResponse], error)
+
+	UpdateCallType(ctx context.Context, name string, request *UpdateCallTypeRequest, opts ...RequestOption) (*StreamResponse[UpdateCallTypeResponse], error)
+
+	// Returns the list of all edges available for video calls.
+	GetEdges(ctx context.Context, request *GetEdgesRequest, opts ...RequestOption) (*StreamResponse[GetEdgesResponse], error)
+
+	// Determine authentication requirements for an inbound SIP call before sending a digest challenge
+	ResolveSipAuth(ctx context.Context, request *ResolveSipAuthRequest, opts ...RequestOption) (*StreamResponse[ResolveSipAuthResponse], error)
+
+	// List all SIP Inbound Routing Rules for the application
+	ListSIPInboundRoutingRule(ctx context.Context, request *ListSIPInboundRoutingRuleRequest, opts ...RequestOption) (*StreamResponse[ListSIPInboundRoutingRuleResponse], error)
+
+	// Create a new SIP Inbound Routing Rule with either direct routing or PIN routing configuration
+	CreateSIPInboundRoutingRule(ctx context.Context, request *CreateSIPInboundRoutingRuleRequest, opts ...RequestOption) (*StreamResponse[SIPInboundRoutingRuleResponse], error)
+
+	// Delete a SIP Inbound Routing Rule for the application
+	DeleteSIPInboundRoutingRule(ctx context.Context, id string, request *DeleteSIPInboundRoutingRuleRequest, opts ...RequestOption) (*StreamResponse[DeleteSIPInboundRoutingRuleResponse], error)
+
+	// Update an existing SIP Inbound Routing Rule with new configuration
+	UpdateSIPInboundRoutingRule(ctx context.Context, id string, request *UpdateSIPInboundRoutingRuleRequest, opts ...RequestOption) (*StreamResponse[UpdateSIPInboundRoutingRuleResponse], error)
+
+	// List all SIP trunks for the application
+	ListSIPTrunks(ctx context.Context, request *ListSIPTrunksRequest, opts ...RequestOption) (*StreamResponse[ListSIPTrunksResponse], error)
+
+	// Create a new SIP trunk for the application
+	CreateSIPTrunk(ctx context.Context, request *CreateSIPTrunkRequest, opts ...RequestOption) (*StreamResponse[CreateSIPTrunkResponse], error)
+
+	// Delete a SIP trunk for the application
+	DeleteSIPTrunk(ctx context.Context, id string, request *DeleteSIPTrunkRequest, opts ...RequestOption) (*StreamResponse[DeleteSIPTrunkResponse], error)
+
+	// Update a SIP trunk for the application
+	UpdateSIPTrunk(ctx context.Context, id string, request *UpdateSIPTrunkRequest, opts ...RequestOption) (*StreamResponse[UpdateSIPTrunkResponse], error)
+
+	// Resolve SIP inbound routing based on trunk number, caller number, and challenge authentication
+	ResolveSipInbound(ctx context.Context, request *ResolveSipInboundRequest, opts ...RequestOption) (*StreamResponse[ResolveSipInboundResponse], error)
+
+	QueryAggregateCallStats(ctx context.Context, request *QueryAggregateCallStatsRequest, opts ...RequestOption) (*StreamResponse[QueryAggregateCallStatsResponse], error)
+
+	// Returns the app's per-broadcast daily digest bundle for one UTC day, with an explicit readiness status (ready, pending, failed, future_date, expired). Payload keys are only present when status is ready.
+	GetDailyDigest(ctx context.Context, request *GetDailyDigestRequest, opts ...RequestOption) (*StreamResponse[GetDailyDigestResponse], error)
+}
+
+// FeedsAPI is the set of API calls of *FeedsClient. Depend on it rather than on
+// the concrete client to substitute mockgetstream.FeedsAPI in tests.
+type FeedsAPI interface {
+	// Create a new activity or update an existing one
+	AddActivity(ctx context.Context, request *AddActivityRequest, opts ...RequestOption) (*StreamResponse[AddActivityResponse], error)
+
+	// Create new activities or update existing ones in a batch operation
+	UpsertActivities(ctx context.Context, request *UpsertActivitiesRequest, opts ...RequestOption) (*StreamResponse[UpsertActivitiesResponse], error)
+
+	// Updates certain fields of multiple activities in a batch. Use 'set' to update specific fields and 'unset' to remove fields. Activities that fail due to not found, permission denied, or no changes detected are silently skipped and not included in the response. However, validation errors (e.g., updating reserved fields, invalid field values, exceeding size limits) will fail the entire batch request.
+	//
+	// Sends events:
+	// - feeds.activity.updated
+	UpdateActivitiesPartialBatch(ctx context.Context, request *UpdateActivitiesPartialBatchRequest, opts ...RequestOption) (*StreamResponse[UpdateActivitiesPartialBatchResponse], error)
+
+	// Delete one or more activities by their IDs
+	DeleteActivities(ctx context.Context, request *DeleteActivitiesRequest, opts ...RequestOption) (*StreamResponse[DeleteActivitiesResponse], error)
+
+	// Track metric events (views, clicks, impressions) for activities. Supports batching up to 100 events per request. Each event is independently rate-limited per user per activity per metric. Server-side calls must include user_id.
+	TrackActivityMetrics(ctx context.Context, request *TrackActivityMetricsRequest, opts ...RequestOption) (*StreamResponse[TrackActivityMetricsResponse], error)
+
+	// Query activities based on filters with pagination and sorting options
+	QueryActivities(ctx context.Context, request *QueryActivitiesRequest, opts ...RequestOption) (*StreamResponse[QueryActivitiesResponse], error)
+
+	// Returns a single user's reactions across a set of activity IDs, without activity payloads
+	BatchQueryActivityReactions(ctx context.Context, request *BatchQueryActivityReactionsRequest, opts ...RequestOption) (*StreamResponse[BatchQueryActivityReactionsResponse], error)
+
+	// Deletes a bookmark from an activity
+	DeleteBookmark(ctx context.Context, activityID string, request *DeleteBookmarkRequest, opts ...RequestOption) (*StreamResponse[DeleteBookmarkResponse], error)
+
+	// Updates a bookmark for an activity
+	UpdateBookmark(ctx context.Context, activityID string, request *UpdateBookmarkRequest, opts ...RequestOption) (*StreamResponse[UpdateBookmarkResponse], error)
+
+	// Adds a bookmark to an activity
+	AddBookmark(ctx context.Context, activityID string, request *AddBookmarkRequest, opts ...RequestOption) (*StreamResponse[AddBookmarkResponse], error)
+
+	// Submit feedback for an activity including options to show less, hide, report, or mute the user
+	ActivityFeedback(ctx context.Context, activityID string, request *ActivityFeedbackRequest, opts ...RequestOption) (*StreamResponse[ActivityFeedbackResponse], error)
+
+	// Cast a vote on a poll
+	//
+	// Sends events:
+	// - feeds.poll.vote_casted
+	// - feeds.poll.vote_changed
+	// - feeds.poll.vote_removed
+	// - poll.vote_casted
+	// - poll.vote_changed
+	// - poll.vote_removed
+	CastPollVote(ctx context.Context, activityID string, pollID string, request *CastPollVoteRequest, opts ...RequestOption) (*StreamResponse[PollVoteResponse], error)
+
+	// Delete a vote from a poll
+	//
+	// Sends events:
+	// - feeds.poll.vote_removed
+	// - poll.vote_removed
+	DeletePollVote(ctx context.Context, activityID string, pollID string, voteID string, request *DeletePollVoteRequest, opts ...RequestOption) (*StreamResponse[PollVoteResponse], error)
+
+	// Adds a reaction to an activity
+	AddActivityReaction(ctx context.Context, activityID string, request *AddActivityReactionRequest, opts ...RequestOption) (*StreamResponse[AddReactionResponse], error)
+
+	// Query activity reactions
+	QueryActivityReactions(ctx context.Context, activityID string, request *QueryActivityReactionsRequest, opts ...RequestOption) (*StreamResponse[QueryActivityReactionsResponse], error)
+
+	// Removes a reaction from an activity
+	DeleteActivityReaction(ctx context.Context, activityID string, _type string, request *DeleteActivityReactionRequest, opts ...RequestOption) (*StreamResponse[DeleteActivityReactionResponse], error)
+
+	// List the shares recorded for an activity, newest-first
+	QueryActivityShares(ctx context.Context, activityID string, request *QueryActivitySharesRequest, opts ...RequestOption) (*StreamResponse[QueryActivitySharesResponse], error)
+
+	// Delete a single activity by its ID
+	DeleteActivity(ctx context.Context, id string, request *DeleteActivityRequest, opts ...RequestOption) (*StreamResponse[DeleteActivityResponse], error)
+
+	// Returns activity by ID
+	GetActivity(ctx context.Context, id string, request *GetActivityRequest, opts ...RequestOption) (*StreamResponse[GetActivityResponse], error)
+
+	// Updates certain fields of the activity. Use 'set' to update specific fields and 'unset' to remove fields. This allows you to update only the fields you need without replacing the entire activity. Useful for updating reply restrictions ('restrict_replies'), mentioned users, or custom data.
+	//
+	// Sends events:
+	// - feeds.activity.updated
+	UpdateActivityPartial(ctx context.Context, id string, request *UpdateActivityPartialRequest, opts ...RequestOption) (*StreamResponse[UpdateActivityPartialResponse], error)
+
+	// Replaces an activity with the provided data. Use this to update text, attachments, reply restrictions ('restrict_replies'), mentioned users, and other activity fields. Note: This is a full update - any fields not provided will be cleared.
+	//
+	// Sends events:
+	// - feeds.activity.updated
+	UpdateActivity(ctx context.Context, id string, request *UpdateActivityRequest, opts ...RequestOption) (*StreamResponse[UpdateActivityResponse], error)
+
+	// Restores a soft-deleted, moderation-removed, or shadow-blocked activity by its ID. Deleted activities can be restored by the owner (client-side). Moderation-blocked activities can only be restored server-side.
+	RestoreActivity(ctx context.Context, id string, request *RestoreActivityRequest, opts ...RequestOption) (*StreamResponse[RestoreActivityResponse], error)
+
+	// Translates an activity's text to a given language using automated translation
+	//
+	// Sends events:
+	// - feeds.activity.updated
+	TranslateActivity(ctx context.Context, id string, request *TranslateActivityRequest, opts ...RequestOption) (*StreamResponse[TranslateActivityResponse], error)
+
+	// Query bookmark folders with filter query
+	QueryBookmarkFolders(ctx context.Context, request *QueryBookmarkFoldersRequest, opts ...RequestOption) (*StreamResponse[QueryBookmarkFoldersResponse], error)
+
+	// Delete a bookmark folder by its ID
+	DeleteBookmarkFolder(ctx context.Context, folderID string, request *DeleteBookmarkFolderRequest, opts ...RequestOption) (*StreamResponse[DeleteBookmarkFolderResponse], error)
+
+	// Update a bookmark folder by its ID
+	UpdateBookmarkFolder(ctx context.Context, folderID string, request *UpdateBookmarkFolderRequest, opts ...RequestOption) (*StreamResponse[UpdateBookmarkFolderResponse], error)
+
+	// Query bookmarks with filter query
+	QueryBookmarks(ctx context.Context, request *QueryBookmarksRequest, opts ...RequestOption) (*StreamResponse[QueryBookmarksResponse], error)
+
+	// Delete collections in a batch operation. Users can only delete their own collections.
+	DeleteCollections(ctx context.Context, request *DeleteCollectionsRequest, opts ...RequestOption) (*StreamResponse[DeleteCollectionsResponse], error)
+
+	// Read collections by their references. By default, users can only read their own collections.
+	ReadCollections(ctx context.Context, request *ReadCollectionsRequest, opts ...RequestOption) (*StreamResponse[ReadCollectionsResponse], error)
+
+	// Update existing collections in a batch operation. Only the custom data field is updatable. Users can only update their own collections.
+	UpdateCollections(ctx context.Context, request *UpdateCollectionsRequest, opts ...RequestOption) (*StreamResponse[UpdateCollectionsResponse], error)
+
+	// Create new collections in a batch operation. Collections are data objects that can be attached to activities for managing shared data across multiple activities.
+	CreateCollections(ctx context.Context, request *CreateCollectionsRequest, opts ...RequestOption) (*StreamResponse[CreateCollectionsResponse], error)
+
+	// Insert new collections or update existing ones in a batch operation. Only the custom data field is updatable for existing collections.
+	UpsertCollections(ctx context.Context, request *UpsertCollectionsRequest, opts ...RequestOption) (*StreamResponse[UpsertCollectionsResponse], error)
+
+	// Query collections with filter query
+	QueryCollections(ctx context.Context, request *QueryCollectionsRequest, opts ...RequestOption) (*StreamResponse[QueryCollectionsResponse], error)
+
+	// Retrieve a threaded list of comments for a specific object (e.g., activity), with configurable depth, sorting, and pagination
+	GetComments(ctx context.Context, request *GetCommentsRequest, opts ...RequestOption) (*StreamResponse[GetCommentsResponse], error)
+
+	// Adds a comment to an object (e.g., activity) or a reply to an existing comment, and broadcasts appropriate events
+	AddComment(ctx context.Context, request *AddCommentRequest, opts ...RequestOption) (*StreamResponse[AddCommentResponse], error)
+
+	// Adds multiple comments in a single request. Each comment must specify the object type and ID.
+	AddCommentsBatch(ctx context.Context, request *AddCommentsBatchRequest, opts ...RequestOption) (*StreamResponse[AddCommentsBatchResponse], error)
+
+	// Query comments using MongoDB-style filters with pagination and sorting options
+	QueryComments(ctx context.Context, request *QueryCommentsRequest, opts ...RequestOption) (*StreamResponse[QueryCommentsResponse], error)
+
+	// Returns a single user's reactions across a set of comment IDs, without comment payloads
+	BatchQueryCommentReactions(ctx context.Context, request *BatchQueryCommentReactionsRequest, opts ...RequestOption) (*StreamResponse[BatchQueryCommentReactionsResponse], error)
+
+	// Deletes a bookmark from a comment
+	DeleteCommentBookmark(ctx context.Context, commentID string, request *DeleteCommentBookmarkRequest, opts ...RequestOption) (*StreamResponse[DeleteCommentBookmarkResponse], error)
+
+	// Updates a bookmark for a comment
+	UpdateCommentBookmark(ctx context.Context, commentID string, request *UpdateCommentBookmarkRequest, opts ...RequestOption) (*StreamResponse[UpdateCommentBookmarkResponse], error)
+
+	// Adds a bookmark to a comment
+	AddCommentBookmark(ctx context.Context, commentID string, request *AddCommentBookmarkRequest, opts ...RequestOption) (*StreamResponse[AddCommentBookmarkResponse], error)
+
+	// Deletes a comment from an object (e.g., activity) and broadcasts appropriate events
+	DeleteComment(ctx context.Context, id string, request *DeleteCommentRequest, opts ...RequestOption) (*StreamResponse[DeleteCommentResponse], error)
+
+	// Get a comment by ID
+	GetComment(ctx context.Context, id string, request *GetCommentRequest, opts ...RequestOption) (*StreamResponse[GetCommentResponse], error)
+
+	// Updates a comment on an object (e.g., activity) and broadcasts appropriate events
+	UpdateComment(ctx context.Context, id string, request *UpdateCommentRequest, opts ...RequestOption) (*StreamResponse[UpdateCommentResponse], error)
+
+	// Updates certain fields of the comment. Use 'set' to update specific fields and 'unset' to remove fields.
+	//
+	// Sends events:
+	// - feeds.activity.updated
+	// - feeds.comment.updated
+	UpdateCommentPartial(ctx context.Context, id string, request *UpdateCommentPartialRequest, opts ...RequestOption) (*StreamResponse[UpdateCommentPartialResponse], error)
+
+	// Adds a reaction to a comment
+	AddCommentReaction(ctx context.Context, id string, request *AddCommentReactionRequest, opts ...RequestOption) (*StreamResponse[AddCommentReactionResponse], error)
+
+	// Query comment reactions
+	QueryCommentReactions(ctx context.Context, id string, request *QueryCommentReactionsRequest, opts ...RequestOption) (*StreamResponse[QueryCommentReactionsResponse], error)
+
+	// Deletes a reaction from a comment
+	DeleteCommentReaction(ctx context.Context, id string, _type string, request *DeleteCommentReactionRequest, opts ...RequestOption) (*StreamResponse[DeleteCommentReactionResponse], error)
+
+	// Retrieve a threaded list of replies for a single comment, with configurable depth, sorting, and pagination
+	GetCommentReplies(ctx context.Context, id string, request *GetCommentRepliesRequest, opts ...RequestOption) (*StreamResponse[GetCommentRepliesResponse], error)
+
+	// Restores a soft-deleted, moderation-removed, or shadow-blocked comment by its ID. The comment and all its descendants are restored. Deleted comments can be restored client-side. Moderation-blocked comments can only be restored server-side.
+	RestoreComment(ctx context.Context, id string, request *RestoreCommentRequest, opts ...RequestOption) (*StreamResponse[RestoreCommentResponse], error)
+
+	// Translates a comment's text to a given language using automated translation
+	//
+	// Sends events:
+	// - feeds.comment.updated
+	TranslateComment(ctx context.Context, id string, request *TranslateCommentRequest, opts ...RequestOption) (*StreamResponse[TranslateCommentResponse], error)
+
+	// List all feed groups for the application
+	ListFeedGroups(ctx context.Context, request *ListFeedGroupsRequest, opts ...RequestOption) (*StreamResponse[ListFeedGroupsResponse], error)
+
+	// Creates a new feed group with the specified configuration
+	CreateFeedGroup(ctx context.Context, request *CreateFeedGroupRequest, opts ...RequestOption) (*StreamResponse[CreateFeedGroupResponse], error)
+
+	// Delete a single feed by its ID
+	DeleteFeed(ctx context.Context, feedGroupID string, feedID string, request *DeleteFeedRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedResponse], error)
+
+	// Create a single feed for a given feed group
+	GetOrCreateFeed(ctx context.Context, feedGroupID string, feedID string, request *GetOrCreateFeedRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateFeedResponse], error)
+
+	// Update an existing feed
+	UpdateFeed(ctx context.Context, feedGroupID string, feedID string, request *UpdateFeedRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedResponse], error)
+
+	// Mark activities as read/seen/watched. Can mark by timestamp (seen), activity IDs (read), or all as read.
+	MarkActivity(ctx context.Context, feedGroupID string, feedID string, request *MarkActivityRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Unpin an activity from a feed. This removes the pin, so the activity will no longer be displayed at the top of the feed.
+	UnpinActivity(ctx context.Context, feedGroupID string, feedID string, activityID string, request *UnpinActivityRequest, opts ...RequestOption) (*StreamResponse[UnpinActivityResponse], error)
+
+	// Pin an activity to a feed. Pinned activities are typically displayed at the top of a feed.
+	PinActivity(ctx context.Context, feedGroupID string, feedID string, activityID string, request *PinActivityRequest, opts ...RequestOption) (*StreamResponse[PinActivityResponse], error)
+
+	// Changes the visibility of an existing feed. Follow reconciliation (rewriting pending follows on loosening, or removing disallowed follows/members on tightening) runs asynchronously in the background; the response returns optimistically with the intended visibility.
+	ChangeFeedVisibility(ctx context.Context, feedGroupID string, feedID string, request *ChangeFeedVisibilityRequest, opts ...RequestOption) (*StreamResponse[ChangeFeedVisibilityResponse], error)
+
+	// Add, remove, or set members for a feed
+	UpdateFeedMembers(ctx context.Context, feedGroupID string, feedID string, request *UpdateFeedMembersRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedMembersResponse], error)
+
+	// Accepts a pending feed member request
+	AcceptFeedMemberInvite(ctx context.Context, feedID string, feedGroupID string, request *AcceptFeedMemberInviteRequest, opts ...RequestOption) (*StreamResponse[AcceptFeedMemberInviteResponse], error)
+
+	// Query feed members based on filters with pagination and sorting options
+	QueryFeedMembers(ctx context.Context, feedGroupID string, feedID string, request *QueryFeedMembersRequest, opts ...RequestOption) (*StreamResponse[QueryFeedMembersResponse], error)
+
+	// Rejects a pending feed member request
+	RejectFeedMemberInvite(ctx context.Context, feedGroupID string, feedID string, request *RejectFeedMemberInviteRequest, opts ...RequestOption) (*StreamResponse[RejectFeedMemberInviteResponse], error)
+
+	// Query pinned activities for a feed with filter query
+	QueryPinnedActivities(ctx context.Context, feedGroupID string, feedID string, request *QueryPinnedActivitiesRequest, opts ...RequestOption) (*StreamResponse[QueryPinnedActivitiesResponse], error)
+
+	// Get follow suggestions for a feed group
+	GetFollowSuggestions(ctx context.Context, feedGroupID string, request *GetFollowSuggestionsRequest, opts ...RequestOption) (*StreamResponse[GetFollowSuggestionsResponse], error)
+
+	// Restores a soft-deleted feed group by its ID. Only clears DeletedAt in the database; no other fields are updated.
+	RestoreFeedGroup(ctx context.Context, feedGroupID string, request *RestoreFeedGroupRequest, opts ...RequestOption) (*StreamResponse[RestoreFeedGroupResponse], error)
+
+	// Delete a feed group by its ID. Can perform a soft delete (default) or hard delete.
+	DeleteFeedGroup(ctx context.Context, id string, request *DeleteFeedGroupRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedGroupResponse], error)
+
+	// Get a feed group by ID
+	GetFeedGroup(ctx context.Context, id string, request *GetFeedGroupRequest, opts ...RequestOption) (*StreamResponse[GetFeedGroupResponse], error)
+
+	// Get an existing feed group or create a new one if it doesn't exist
+	GetOrCreateFeedGroup(ctx context.Context, id string, request *GetOrCreateFeedGroupRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateFeedGroupResponse], error)
+
+	// Update a feed group by ID
+	UpdateFeedGroup(ctx context.Context, id string, request *UpdateFeedGroupRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedGroupResponse], error)
+
+	// List all feed views for a feed group
+	ListFeedViews(ctx context.Context, request *ListFeedViewsRequest, opts ...RequestOption) (*StreamResponse[ListFeedViewsResponse], error)
+
+	// Create a custom view for a feed group with specific selectors, ranking, or aggregation options
+	CreateFeedView(ctx context.Context, request *CreateFeedViewRequest, opts ...RequestOption) (*StreamResponse[CreateFeedViewResponse], error)
+
+	// Delete an existing custom feed view
+	DeleteFeedView(ctx context.Context, id string, request *DeleteFeedViewRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedViewResponse], error)
+
+	// Get a feed view by its ID
+	GetFeedView(ctx context.Context, id string, request *GetFeedViewRequest, opts ...RequestOption) (*StreamResponse[GetFeedViewResponse], error)
+
+	// Get an existing feed view or create a new one if it doesn't exist
+	GetOrCreateFeedView(ctx context.Context, id string, request *GetOrCreateFeedViewRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateFeedViewResponse], error)
+
+	// Update an existing custom feed view with new selectors, ranking, or aggregation options
+	UpdateFeedView(ctx context.Context, id string, request *UpdateFeedViewRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedViewResponse], error)
+
+	// Gets all available feed visibility configurations and their permissions
+	ListFeedVisibilities(ctx context.Context, request *ListFeedVisibilitiesRequest, opts ...RequestOption) (*StreamResponse[ListFeedVisibilitiesResponse], error)
+
+	// Gets feed visibility configuration and permissions
+	GetFeedVisibility(ctx context.Context, name string, request *GetFeedVisibilityRequest, opts ...RequestOption) (*StreamResponse[GetFeedVisibilityResponse], error)
+
+	// Updates an existing predefined feed visibility configuration
+	UpdateFeedVisibility(ctx context.Context, name string, request *UpdateFeedVisibilityRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedVisibilityResponse], error)
+
+	// Create multiple feeds at once for a given feed group
+	CreateFeedsBatch(ctx context.Context, request *CreateFeedsBatchRequest, opts ...RequestOption) (*StreamResponse[CreateFeedsBatchResponse], error)
+
+	// Delete multiple feeds by their IDs. All feeds must exist. This endpoint is server-side only.
+	DeleteFeedsBatch(ctx context.Context, request *DeleteFeedsBatchRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedsBatchResponse], error)
+
+	// Retrieves own_follows, own_capabilities, and/or own_membership for multiple feeds in a single request. If fields are not specified, all three fields are returned.
+	OwnBatch(ctx context.Context, request *OwnBatchRequest, opts ...RequestOption) (*StreamResponse[OwnBatchResponse], error)
+
+	// Query feeds with filter query
+	QueryFeeds(ctx context.Context, request *QueryFeedsRequest, opts ...RequestOption) (*StreamResponse[QueryFeedsResponse], error)
+
+	// Retrieve current rate limit status for feeds operations.
+	// Returns information about limits, usage, and remaining quota for various feed operations.
+	GetFeedsRateLimits(ctx context.Context, request *GetFeedsRateLimitsRequest, opts ...RequestOption) (*StreamResponse[GetFeedsRateLimitsResponse], error)
+
+	// Updates a follow's custom data, push preference, and follower role. Source owner can update custom data and push preference. Follower role can only be updated via server-side requests.
+	UpdateFollow(ctx context.Context, request *UpdateFollowRequest, opts ...RequestOption) (*StreamResponse[UpdateFollowResponse], error)
+
+	// Creates a follow and broadcasts FollowAddedEvent
+	Follow(ctx context.Context, request *FollowRequest, opts ...RequestOption) (*StreamResponse[SingleFollowResponse], error)
+
+	// Accepts a pending follow request
+	AcceptFollow(ctx context.Context, request *AcceptFollowRequest, opts ...RequestOption) (*StreamResponse[AcceptFollowResponse], error)
+
+	// Creates multiple follows at once and broadcasts FollowAddedEvent for each follow
+	FollowBatch(ctx context.Context, request *FollowBatchRequest, opts ...RequestOption) (*StreamResponse[FollowBatchResponse], error)
+
+	// Creates or updates multiple follows at once. Does not return an error if follows already exist. Broadcasts FollowAddedEvent only for newly created follows.
+	GetOrCreateFollows(ctx context.Context, request *GetOrCreateFollowsRequest, opts ...RequestOption) (*StreamResponse[FollowBatchResponse], error)
+
+	// Query follows based on filters with pagination and sorting options
+	QueryFollows(ctx context.Context, request *QueryFollowsRequest, opts ...RequestOption) (*StreamResponse[QueryFollowsResponse], error)
+
+	// Rejects a pending follow request
+	RejectFollow(ctx context.Context, request *RejectFollowRequest, opts ...RequestOption) (*StreamResponse[RejectFollowResponse], error)
+
+	// Creates a follow if it does not exist, or returns the existing one. Broadcasts feeds.follow.created (FollowCreatedEvent) only when the follow is newly created.
+	GetOrCreateFollow(ctx context.Context, request *GetOrCreateFollowRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateFollowResponse], error)
+
+	// Removes a follow and broadcasts FollowRemovedEvent
+	Unfollow(ctx context.Context, source string, target string, request *UnfollowRequest, opts ...RequestOption) (*StreamResponse[UnfollowResponse], error)
+
+	// Create a new membership level with tag-based access controls
+	CreateMembershipLevel(ctx context.Context, request *CreateMembershipLevelRequest, opts ...RequestOption) (*StreamResponse[CreateMembershipLevelResponse], error)
+
+	// Query membership levels with filter query
+	QueryMembershipLevels(ctx context.Context, request *QueryMembershipLevelsRequest, opts ...RequestOption) (*StreamResponse[QueryMembershipLevelsResponse], error)
+
+	// Delete a membership level by its UUID. This operation is irreversible.
+	DeleteMembershipLevel(ctx context.Context, id string, request *DeleteMembershipLevelRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	// Update a membership level with partial updates. Only specified fields will be updated.
+	UpdateMembershipLevel(ctx context.Context, id string, request *UpdateMembershipLevelRequest, opts ...RequestOption) (*StreamResponse[UpdateMembershipLevelResponse], error)
+
+	// Queries revision history for activities and comments
+	QueryRevisionHistory(ctx context.Context, request *QueryRevisionHistoryRequest, opts ...RequestOption) (*StreamResponse[QueryRevisionHistoryResponse], error)
+
+	// Retrieve usage statistics for feeds including activity count, follow count, and API request count.
+	// Returns data aggregated by day with pagination support via from/to date parameters.
+	// This endpoint is server-side only.
+	QueryFeedsUsageStats(ctx context.Context, request *QueryFeedsUsageStatsRequest, opts ...RequestOption) (*StreamResponse[QueryFeedsUsageStatsResponse], error)
+
+	// Removes multiple follows at once and broadcasts FollowRemovedEvent for each one
+	UnfollowBatch(ctx context.Context, request *UnfollowBatchRequest, opts ...RequestOption) (*StreamResponse[UnfollowBatchResponse], error)
+
+	// Removes multiple follows and broadcasts FollowRemovedEvent for each. Does not return an error if follows don't exist.
+	GetOrCreateUnfollows(ctx context.Context, request *GetOrCreateUnfollowsRequest, opts ...RequestOption) (*StreamResponse[UnfollowBatchResponse], error)
+
+	// Removes a follow and broadcasts feeds.follow.deleted (FollowDeletedEvent). Does not return an error if the follow does not exist.
+	GetOrCreateUnfollow(ctx context.Context, request *GetOrCreateUnfollowRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateUnfollowResponse], error)
+
+	// Delete all feed data for a user including: feeds, activities, follows, comments, feed reactions, bookmark folders, bookmarks, and collections owned by the user
+	DeleteFeedUserData(ctx context.Context, userID string, request *DeleteFeedUserDataRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedUserDataResponse], error)
+
+	// Export all feed data for a user including: user profile, feeds, activities, follows, comments, feed reactions, bookmark folders, bookmarks, and collections owned by the user
+	ExportFeedUserData(ctx context.Context, userID string, request *ExportFeedUserDataRequest, opts ...RequestOption) (*StreamResponse[ExportFeedUserDataResponse], error)
+
+	// Returns the user's most common interest tags ranked by the number of distinct activities they reacted to that carried each tag. Client-side callers may only read their own interests; server-side callers may fetch any user. Results are sorted by descending count, then alphabetically by tag.
+	GetUserInterests(ctx context.Context, userID string, request *GetUserInterestsRequest, opts ...RequestOption) (*StreamResponse[GetUserInterestsResponse], error)
+}
+
+// ModerationAPI is the set of API calls of *ModerationClient. Depend on it
+// rather than on the concrete client to substitute mockgetstream.ModerationAPI
+// in tests.
+type ModerationAPI interface {
+	// Returns moderation action configs grouped by entity type, sorted by order ascending. Supports fetching DB-configured actions, hardcoded defaults, or both.
+	GetActionConfig(ctx context.Context, request *GetActionConfigRequest, opts ...RequestOption) (*StreamResponse[GetActionConfigResponse], error)
+
+	// Create a new moderation action config entry or update an existing one. Action configs control the action buttons displayed in the moderation dashboard for each entity type.
+	UpsertActionConfig(ctx context.Context, request *UpsertActionConfigRequest, opts ...RequestOption) (*StreamResponse[UpsertActionConfigResponse], error)
+
+	// Create or update multiple moderation action config entries in a single request. Omit the ID field to create; provide an ID to update.
+	BulkUpsertActionConfig(ctx context.Context, request *BulkUpsertActionConfigRequest, opts ...RequestOption) (*StreamResponse[BulkUpsertActionConfigResponse], error)
+
+	// Delete multiple moderation action config entries by UUID in a single request.
+	BulkDeleteActionConfig(ctx context.Context, request *BulkDeleteActionConfigRequest, opts ...RequestOption) (*StreamResponse[BulkDeleteActionConfigResponse], error)
+
+	// Delete a specific moderation action config entry by its UUID.
+	DeleteActionConfig(ctx context.Context, id string, request *DeleteActionConfigRequest, opts ...RequestOption) (*StreamResponse[DeleteActionConfigResponse], error)
+
+	// Insert a moderation action log entry. Server-side only. Used by product services to log moderation-related actions.
+	InsertActionLog(ctx context.Context, request *InsertActionLogRequest, opts ...RequestOption) (*StreamResponse[InsertActionLogResponse], error)
+
+	// Moderate named text fields and raw image bytes via multipart/form-data. Returns a per-field lightweight verdict.
+	Analyze(ctx context.Context, request *AnalyzeRequest, opts ...RequestOption) (*StreamResponse[AnalyzeResponse], error)
+
+	// Appeal against the moderation decision
+	Appeal(ctx context.Context, request *AppealRequest, opts ...RequestOption) (*StreamResponse[AppealResponse], error)
+
+	// Retrieve a specific appeal item by its ID
+	GetAppeal(ctx context.Context, id string, request *GetAppealRequest, opts ...RequestOption) (*StreamResponse[GetAppealResponse], error)
+
+	// Query Appeals
+	QueryAppeals(ctx context.Context, request *QueryAppealsRequest, opts ...RequestOption) (*StreamResponse[QueryAppealsResponse], error)
+
+	// Process multiple appeals in a single request by applying the specified action to each. Supported actions: unban, restore, unblock, mark_reviewed, reject_appeal. Each appeal goes through the same path as a single submit_action call.
+	BulkActionAppeals(ctx context.Context, request *BulkActionAppealsRequest, opts ...RequestOption) (*StreamResponse[BulkActionAppealsResponse], error)
+
+	// Ban a user from a channel or the entire app
+	Ban(ctx context.Context, request *BanRequest, opts ...RequestOption) (*StreamResponse[ModerationBanResponse], error)
+
+	// Moderate multiple images in bulk using a CSV file
+	BulkImageModeration(ctx context.Context, request *BulkImageModerationRequest, opts ...RequestOption) (*StreamResponse[BulkImageModerationResponse], error)
+
+	// Enable or disable moderation bypass for a user. This endpoint is server-side only.
+	Bypass(ctx context.Context, request *BypassRequest, opts ...RequestOption) (*StreamResponse[BypassResponse], error)
+
+	// Run moderation checks on the provided content
+	Check(ctx context.Context, request *CheckRequest, opts ...RequestOption) (*StreamResponse[CheckResponse], error)
+
+	// Verifies that the configured IAM role ARN can access private S3 images for moderation. Optionally accepts a stream+s3:// URL to check access to a specific object.
+	CheckS3Access(ctx context.Context, request *CheckS3AccessRequest, opts ...RequestOption) (*StreamResponse[CheckS3AccessResponse], error)
+
+	// Create a new moderation configuration or update an existing one. Configure settings for content filtering, AI analysis, toxicity detection, and other moderation features.
+	UpsertConfig(ctx context.Context, request *UpsertConfigRequest, opts ...RequestOption) (*StreamResponse[UpsertConfigResponse], error)
+
+	// Delete a specific moderation policy by its name
+	DeleteConfig(ctx context.Context, key string, request *DeleteConfigRequest, opts ...RequestOption) (*StreamResponse[DeleteModerationConfigResponse], error)
+
+	// Retrieve a specific moderation configuration by its key and team. This configuration contains settings for various moderation features like toxicity detection, AI analysis, and filtering rules.
+	GetConfig(ctx context.Context, key string, request *GetConfigRequest, opts ...RequestOption) (*StreamResponse[GetConfigResponse], error)
+
+	// Search and filter moderation configurations across your application. This endpoint is designed for building moderation dashboards and managing multiple configuration sets.
+	QueryModerationConfigs(ctx context.Context, request *QueryModerationConfigsRequest, opts ...RequestOption) (*StreamResponse[QueryModerationConfigsResponse], error)
+
+	// Custom check, add your own AI model reports to the review queue
+	CustomCheck(ctx context.Context, request *CustomCheckRequest, opts ...RequestOption) (*StreamResponse[CustomCheckResponse], error)
+
+	// Delete a specific moderation template by its name
+	V2DeleteTemplate(ctx context.Context, request *V2DeleteTemplateRequest, opts ...RequestOption) (*StreamResponse[DeleteModerationTemplateResponse], error)
+
+	// Retrieve a list of feed moderation templates that define preset moderation rules and configurations. Limited to 100 templates per request.
+	V2QueryTemplates(ctx context.Context, request *V2QueryTemplatesRequest, opts ...RequestOption) (*StreamResponse[QueryFeedModerationTemplatesResponse], error)
+
+	// Upsert feeds template for moderation
+	V2UpsertTemplate(ctx context.Context, request *V2UpsertTemplateRequest, opts ...RequestOption) (*StreamResponse[UpsertModerationTemplateResponse], error)
+
+	// Flag any type of content (messages, users, channels, activities) for moderation review. Supports custom content types and additional metadata for flagged content.
+	Flag(ctx context.Context, request *FlagRequest, opts ...RequestOption) (*StreamResponse[FlagItemResponse], error)
+
+	// Returns the number of moderation flags created against a specific user's content. Optionally filter by entity type.
+	GetFlagCount(ctx context.Context, request *GetFlagCountRequest, opts ...RequestOption) (*StreamResponse[GetFlagCountResponse], error)
+
+	// Query flags associated with moderation items. This is used for building a moderation dashboard.
+	QueryModerationFlags(ctx context.Context, request *QueryModerationFlagsRequest, opts ...RequestOption) (*StreamResponse[QueryModerationFlagsResponse], error)
+
+	// Run moderation on text and return labels
+	Labels(ctx context.Context, request *LabelsRequest, opts ...RequestOption) (*StreamResponse[LabelsResponse], error)
+
+	// Search and filter moderation label results with support for pagination and sorting. View the history of moderation labels applied to content.
+	QueryLabelResults(ctx context.Context, request *QueryLabelResultsRequest, opts ...RequestOption) (*StreamResponse[QueryLabelResultsResponse], error)
+
+	// Search and filter moderation action logs with support for pagination. View the history of moderation actions taken, including who performed them and when.
+	QueryModerationLogs(ctx context.Context, request *QueryModerationLogsRequest, opts ...RequestOption) (*StreamResponse[QueryModerationLogsResponse], error)
+
+	// Create or update a moderation rule that can apply app-wide or to specific moderation configs
+	UpsertModerationRule(ctx context.Context, request *UpsertModerationRuleRequest, opts ...RequestOption) (*StreamResponse[UpsertModerationRuleResponse], error)
+
+	// Delete an existing moderation rule
+	DeleteModerationRule(ctx context.Context, id string, request *DeleteModerationRuleRequest, opts ...RequestOption) (*StreamResponse[DeleteModerationRuleResponse], error)
+
+	// Get a specific moderation rule by ID
+	GetModerationRule(ctx context.Context, id string, request *GetModerationRuleRequest, opts ...RequestOption) (*StreamResponse[GetModerationRuleResponse], error)
+
+	// Search and filter moderation rules across your application. This endpoint is designed for building moderation dashboards and managing multiple rule sets.
+	QueryModerationRules(ctx context.Context, request *QueryModerationRulesRequest, opts ...RequestOption) (*StreamResponse[QueryModerationRulesResponse], error)
+
+	// Mute a user. Mutes are generally not visible to the user you mute, while block is something you notice.
+	Mute(ctx context.Context, request *MuteRequest, opts ...RequestOption) (*StreamResponse[MuteResponse], error)
+
+	GetPolicyTestRun(ctx context.Context, id string, request *GetPolicyTestRunRequest, opts ...RequestOption) (*StreamResponse[PolicyTestRunResponse], error)
+
+	ListPolicyTestSets(ctx context.Context, request *ListPolicyTestSetsRequest, opts ...RequestOption) (*StreamResponse[PolicyTestSetListResponse], error)
+
+	// Save a labeled set of messages that can be re-run against the moderation policy.
+	CreatePolicyTestSet(ctx context.Context, request *CreatePolicyTestSetRequest, opts ...RequestOption) (*StreamResponse[PolicyTestSetResponse], error)
+
+	DeletePolicyTestSet(ctx context.Context, id string, request *DeletePolicyTestSetRequest, opts ...RequestOption) (*StreamResponse[Response], error)
+
+	GetPolicyTestSet(ctx context.Context, id string, request *GetPolicyTestSetRequest, opts ...RequestOption) (*StreamResponse[PolicyTestSetResponse], error)
+
+	// Enqueue a background run of the set against the saved live moderation config.
+	StartPolicyTestRun(ctx context.Context, id string, request *StartPolicyTestRunRequest, opts ...RequestOption) (*StreamResponse[PolicyTestRunResponse], error)
+
+	ListQueues(ctx context.Context, request *ListQueuesRequest, opts ...RequestOption) (*StreamResponse[ListQueuesResponse], error)
+
+	CreateQueue(ctx context.Context, request *CreateQueueRequest, opts ...RequestOption) (*StreamResponse[QueueResponse], error)
+
+	GetQueue(ctx context.Context, id string, request *GetQueueRequest, opts ...RequestOption) (*StreamResponse[QueueResponse], error)
+
+	UpdateQueue(ctx context.Context, id string, request *UpdateQueueRequest, opts ...RequestOption) (*StreamResponse[QueueResponse], error)
+
+	DeleteQueue(ctx context.Context, id string, request *DeleteQueueRequest, opts ...RequestOption) (*StreamResponse[QueueResponse], error)
+
+	// Query review queue items allows you to filter the review queue items. This is used for building a moderation dashboard.
+	QueryReviewQueue(ctx context.Context, request *QueryReviewQueueRequest, opts ...RequestOption) (*StreamResponse[QueryReviewQueueResponse], error)
+
+	// Retrieve a specific review queue item by its ID
+	GetReviewQueueItem(ctx context.Context, id string, request *GetReviewQueueItemRequest, opts ...RequestOption) (*StreamResponse[GetReviewQueueItemResponse], error)
+
+	// Retrieve a setup session for an app
+	GetSetupSession(ctx context.Context, request *GetSetupSessionRequest, opts ...RequestOption) (*StreamResponse[GetSetupSessionResponse], error)
+
+	// Update a setup session for an app
+	UpsertSetupSession(ctx context.Context, request *UpsertSetupSessionRequest, opts ...RequestOption) (*StreamResponse[UpsertSetupSessionResponse], error)
+
+	// Take action on flagged content, such as marking content as safe, deleting content, banning users, or executing custom moderation actions. Supports various action types with configurable parameters.
+	SubmitAction(ctx context.Context, request *SubmitActionRequest, opts ...RequestOption) (*StreamResponse[SubmitActionResponse], error)
+
+	// Forward a moderator-supplied correction to the moderation feedback pipeline. Server-side only.
+	SubmitModerationFeedback(ctx context.Context, request *SubmitModerationFeedbackRequest, opts ...RequestOption) (*StreamResponse[SubmitModerationFeedbackResponse], error)
+
+	// Unban a user from a channel or globally.
+	Unban(ctx context.Context, request *UnbanRequest, opts ...RequestOption) (*StreamResponse[UnbanResponse], error)
+
+	// Unmute a user
+	Unmute(ctx context.Context, request *UnmuteRequest, opts ...RequestOption) (*StreamResponse[UnmuteResponse], error)
+}
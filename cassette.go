@@ -0,0 +1,282 @@
+package getstream
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ErrCassetteMiss is returned by a replaying Cassette for a request it has no
+// recording of. SDK calls surface it as an ErrTransport *StreamError;
+// errors.Is(err, ErrCassetteMiss) still matches through the Unwrap chain.
+var ErrCassetteMiss = errors.New("stream: no recorded interaction")
+
+// CassetteMode selects what a Cassette does with requests.
+type CassetteMode int
+
+const (
+	// CassetteReplay serves recorded responses and never touches the network.
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord sends requests through CassetteConfig.Transport and
+	// records them; Save writes the cassette file.
+	CassetteRecord
+)
+
+// CassetteConfig configures a Cassette.
+type CassetteConfig struct {
+	// Path is the cassette file. Required.
+	Path string
+	// Mode is CassetteReplay (default) or CassetteRecord.
+	Mode CassetteMode
+	// Transport sends requests while recording. Default the SDK's default
+	// HTTP client.
+	Transport HttpClient
+	// IgnoreBodyKeys are top-level JSON body keys left out when matching
+	// requests, e.g. IDs or timestamps a test generates on every run.
+	IgnoreBodyKeys []string
+}
+
+// Cassette is an HttpClient that records API traffic to a file and replays
+// it, so tests that exercise the SDK end to end can run offline and
+// deterministically:
+//
+//	cassette, err := getstream.NewCassette(getstream.CassetteConfig{
+//		Path: "testdata/cassettes/create_channel.json",
+//		Mode: mode, // CassetteRecord once with real credentials, then CassetteReplay in CI
+//	})
+//	client, err := getstream.NewClient(key, secret, getstream.WithHTTPClient(cassette))
+//	defer cassette.Save()
+//
+// Recordings are redacted with the same rules as request logging: api_key
+// and other credentials in the query, and secret top-level JSON body keys,
+// are replaced by <redacted>. Request headers, including Authorization, are
+// not recorded at all.
+//
+// Replay matches a request by method, path, query (without credentials)
+// and JSON body, compared after redaction with key order and whitespace
+// normalized. Non-JSON bodies, e.g. multipart uploads, are neither recorded
+// nor compared.
+// Identical requests are served the recorded responses in order; once
+// those run out the last one is repeated, so polling loops replay
+// correctly.
+//
+// A Cassette is safe for concurrent use.
+type Cassette struct {
+	cfg    CassetteConfig
+	ignore map[string]struct{}
+
+	mu           sync.Mutex
+	interactions []cassetteInteraction
+	served       map[string]int
+}
+
+// cassetteFile is the on-disk format.
+type cassetteFile struct {
+	Interactions []cassetteInteraction `json:"interactions"`
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// NewCassette returns a Cassette. In replay mode it loads cfg.Path, which
+// must exist.
+func NewCassette(cfg CassetteConfig) (*Cassette, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("cassette: path is required")
+	}
+	c := &Cassette{cfg: cfg, ignore: map[string]struct{}{}, served: map[string]int{}}
+	for _, k := range cfg.IgnoreBodyKeys {
+		c.ignore[k] = struct{}{}
+	}
+	switch cfg.Mode {
+	case CassetteRecord:
+		if c.cfg.Transport == nil {
+			c.cfg.Transport = buildDefaultHTTPClient(defaultRequestTimeout, defaultMaxConnsPerHost, defaultIdleTimeout, defaultConnectTimeout)
+		}
+	case CassetteReplay:
+		b, err := os.ReadFile(cfg.Path)
+		if err != nil {
+			return nil, fmt.Errorf("cassette: %w", err)
+		}
+		var f cassetteFile
+		if err := json.Unmarshal(b, &f); err != nil {
+			return nil, fmt.Errorf("cassette: parse %s: %w", cfg.Path, err)
+		}
+		c.interactions = f.Interactions
+	default:
+		return nil, fmt.Errorf("cassette: unknown mode %d", cfg.Mode)
+	}
+	return c, nil
+}
+
+// Do records or replays r. Only JSON request bodies are read and recorded;
+// multipart uploads are passed through unread.
+func (c *Cassette) Do(r *http.Request) (*http.Response, error) {
+	req := cassetteRequest{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  redactQuery(r.URL.Query()),
+	}
+	if r.Body != nil && !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		body, err := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if json.Valid(body) {
+			req.Body = redactJSONBody(body)
+		}
+	}
+	if c.cfg.Mode == CassetteRecord {
+		return c.record(r, req)
+	}
+	return c.replay(r, req)
+}
+
+func (c *Cassette) record(r *http.Request, req cassetteRequest) (*http.Response, error) {
+	resp, err := c.cfg.Transport.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+	c.mu.Lock()
+	c.interactions = append(c.interactions, cassetteInteraction{
+		Request: req,
+		Response: cassetteResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       redactJSONBody(body),
+		},
+	})
+	c.mu.Unlock()
+	return resp, nil
+}
+
+func (c *Cassette) replay(r *http.Request, req cassetteRequest) (*http.Response, error) {
+	if r.Body != nil {
+		_ = r.Body.Close()
+	}
+	key := c.matchKey(req)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var matched []int
+	for i := range c.interactions {
+		if c.matchKey(c.interactions[i].Request) == key {
+			matched = append(matched, i)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("%w for %s %s in %s", ErrCassetteMiss, req.Method, req.Path, c.cfg.Path)
+	}
+	n := c.served[key]
+	c.served[key] = n + 1
+	if n >= len(matched) {
+		n = len(matched) - 1
+	}
+	rec := c.interactions[matched[n]].Response
+	header := rec.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+		StatusCode:    rec.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(rec.Body)),
+		ContentLength: int64(len(rec.Body)),
+		Request:       r,
+	}, nil
+}
+
+// matchKey normalizes a recorded or live request for matching.
+func (c *Cassette) matchKey(req cassetteRequest) string {
+	query, _ := url.ParseQuery(req.Query)
+	for k := range query {
+		if _, secret := redactedQueryParams[strings.ToLower(k)]; secret {
+			query.Del(k)
+		}
+	}
+	return req.Method + " " + req.Path + "?" + query.Encode() + "\n" + c.normalizeBody(req.Body)
+}
+
+// normalizeBody re-marshals a JSON body, which sorts object keys, without
+// the ignored top-level keys. Other bodies normalize to "".
+func (c *Cassette) normalizeBody(body string) string {
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return ""
+	}
+	if m, ok := v.(map[string]any); ok {
+		for k := range c.ignore {
+			delete(m, k)
+		}
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
+
+// Save writes the recorded interactions to the cassette file, creating its
+// directory if needed. It does nothing in replay mode.
+func (c *Cassette) Save() error {
+	if c.cfg.Mode != CassetteRecord {
+		return nil
+	}
+	c.mu.Lock()
+	b, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.cfg.Path), 0o755); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	// Write then rename so an interrupted run never leaves a torn file.
+	tmp := c.cfg.Path + ".tmp"
+	if err := os.WriteFile(tmp, append(b, '\n'), 0o644); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	if err := os.Rename(tmp, c.cfg.Path); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countingAPI answers QueryUsers and UpdateUsers with the per-call sequence
+// number as the user ID.
+type countingAPI struct {
+	calls int32
+}
+
+func (a *countingAPI) Do(r *http.Request) (*http.Response, error) {
+	n := atomic.AddInt32(&a.calls, 1)
+	body := fmt.Sprintf(`{"duration":"%d","token":"server-secret-token","users":[{"id":"call-%d"}]}`, n, n)
+	if r.Method == http.MethodPost {
+		body = fmt.Sprintf(`{"duration":"%d","users":{"john":{"id":"call-%d"}}}`, n, n)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}, "Set-Cookie": {"session=abc"}, HeaderRateLimit: {"1000"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func queryUserIDs(t *testing.T, c *Stream, filter map[string]any) (string, error) {
+	t.Helper()
+	resp, err := c.QueryUsers(context.Background(), &QueryUsersRequest{Payload: &QueryUsersPayload{FilterConditions: filter}})
+	if err != nil {
+		return "", err
+	}
+	return resp.Data.Users[0].ID, nil
+}
+
+func TestCassette_RecordThenReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "users.json")
+	api := &countingAPI{}
+	rec, err := NewCassette(CassetteConfig{Path: path, Mode: CassetteRecord, Transport: api})
+	require.NoError(t, err)
+	c, err := NewClient("recording-key", "recording-secret", WithHTTPClient(rec))
+	require.NoError(t, err)
+
+	for _, filter := range []map[string]any{{"id": "a"}, {"id": "b"}, {"id": "a"}} {
+		_, err := queryUserIDs(t, c, filter)
+		require.NoError(t, err)
+	}
+	_, err = c.UpdateUsers(context.Background(), &UpdateUsersRequest{Users: map[string]UserRequest{"john": {ID: "john"}}})
+	require.NoError(t, err)
+	require.NoError(t, rec.Save())
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "recording-key")
+	require.NotContains(t, string(raw), "server-secret-token")
+	require.NotContains(t, string(raw), "Authorization")
+	require.NotContains(t, string(raw), "session=abc")
+
+	// Replay with other credentials and no network.
+	play, err := NewCassette(CassetteConfig{Path: path})
+	require.NoError(t, err)
+	c, err = NewClient("ci-key", "ci-secret", WithHTTPClient(play), WithBaseUrl("http://127.0.0.1:1"))
+	require.NoError(t, err)
+
+	id, err := queryUserIDs(t, c, map[string]any{"id": "b"})
+	require.NoError(t, err)
+	require.Equal(t, "call-2", id)
+	id, err = queryUserIDs(t, c, map[string]any{"id": "a"})
+	require.NoError(t, err)
+	require.Equal(t, "call-1", id)
+	id, err = queryUserIDs(t, c, map[string]any{"id": "a"})
+	require.NoError(t, err)
+	require.Equal(t, "call-3", id, "identical requests replay in recorded order")
+	id, err = queryUserIDs(t, c, map[string]any{"id": "a"})
+	require.NoError(t, err)
+	require.Equal(t, "call-3", id, "the last recording repeats")
+
+	resp, err := c.UpdateUsers(context.Background(), &UpdateUsersRequest{Users: map[string]UserRequest{"john": {ID: "john"}}})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), resp.RateLimitInfo.Limit)
+
+	_, err = c.UpdateUsers(context.Background(), &UpdateUsersRequest{Users: map[string]UserRequest{"jane": {ID: "jane"}}})
+	require.True(t, errors.Is(err, ErrCassetteMiss), "got %v", err)
+	require.True(t, errors.Is(err, ErrTransport))
+	require.Equal(t, int32(4), atomic.LoadInt32(&api.calls))
+}
+
+func TestCassette_BodyNormalization(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "c.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"interactions":[{
+		"request":{"method":"POST","path":"/api/v2/feeds/activities","query":"api_key=%3Credacted%3E","body":"{\"type\":\"post\",\"id\":\"run-1\",\"feeds\":[\"user:john\"]}"},
+		"response":{"status_code":201,"body":"{\"ok\":true}"}
+	}]}`), 0o644))
+	play, err := NewCassette(CassetteConfig{Path: path, IgnoreBodyKeys: []string{"id"}})
+	require.NoError(t, err)
+
+	do := func(body string) (*http.Response, error) {
+		r, _ := http.NewRequest("POST", "https://example.com/api/v2/feeds/activities?api_key=other", strings.NewReader(body))
+		return play.Do(r)
+	}
+	resp, err := do(`{ "feeds": ["user:john"],  "id": "run-2", "type": "post" }`)
+	require.NoError(t, err)
+	require.Equal(t, 201, resp.StatusCode)
+	b, _ := io.ReadAll(resp.Body)
+	require.JSONEq(t, `{"ok":true}`, string(b))
+
+	_, err = do(`{"feeds":["user:jane"],"type":"post"}`)
+	require.True(t, errors.Is(err, ErrCassetteMiss))
+}
+
+func TestNewCassette_Errors(t *testing.T) {
+	_, err := NewCassette(CassetteConfig{})
+	require.Error(t, err)
+	_, err = NewCassette(CassetteConfig{Path: filepath.Join(t.TempDir(), "missing.json")})
+	require.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestCassette_UploadBodiesNotRecorded(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "upload.json")
+	rec, err := NewCassette(CassetteConfig{Path: path, Mode: CassetteRecord, Transport: &multipartReadingClient{}})
+	require.NoError(t, err)
+	c, err := NewClient("k", "s", WithHTTPClient(rec))
+	require.NoError(t, err)
+	upload := func() error {
+		_, err := c.UploadFileStream(context.Background(), &UploadFileStreamRequest{
+			Source: UploadSource{Reader: strings.NewReader("private file content"), FileName: "f.txt"},
+		})
+		return err
+	}
+	require.NoError(t, upload())
+	require.NoError(t, rec.Save())
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "private file content")
+
+	play, err := NewCassette(CassetteConfig{Path: path})
+	require.NoError(t, err)
+	c, err = NewClient("k", "s", WithHTTPClient(play))
+	require.NoError(t, err)
+	require.NoError(t, upload())
+}
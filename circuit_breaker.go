@@ -0,0 +1,263 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCircuitFailureThreshold = 5
+	defaultCircuitOpenTimeout      = 30 * time.Second
+	defaultCircuitHalfOpenProbes   = 1
+)
+
+// CircuitBreakerConfig is the opt-in per-endpoint circuit breaker. Disabled
+// by default. When enabled, each operation (method + path template, e.g.
+// "POST /api/v2/video/call_stats") has its own circuit: after
+// FailureThreshold consecutive failures (transport errors or HTTP 5xx) it
+// opens and calls fail fast with ErrCircuitOpen instead of waiting for the
+// struggling endpoint. After OpenTimeout it lets probe requests through; a
+// successful probe closes the circuit, a failed one opens it again.
+type CircuitBreakerConfig struct {
+	// Enabled turns the breaker on. Default false.
+	Enabled bool
+	// FailureThreshold is the number of consecutive failures that opens a
+	// circuit. Default 5.
+	FailureThreshold int
+	// OpenTimeout is how long a circuit stays open before probing. Default
+	// 30s.
+	OpenTimeout time.Duration
+	// HalfOpenProbes caps the concurrent probe requests while half-open;
+	// other calls keep failing fast. Default 1.
+	HalfOpenProbes int
+}
+
+// WithCircuitBreaker enables the opt-in per-endpoint circuit breaker. Zero
+// values fall back to the documented defaults. State changes are logged as
+// circuit.state_changed events.
+func WithCircuitBreaker(cfg CircuitBreakerConfig) ClientOption {
+	return func(c *Client) {
+		if !cfg.Enabled {
+			c.circuitBreaker = nil
+			return
+		}
+		if cfg.FailureThreshold <= 0 {
+			cfg.FailureThreshold = defaultCircuitFailureThreshold
+		}
+		if cfg.OpenTimeout <= 0 {
+			cfg.OpenTimeout = defaultCircuitOpenTimeout
+		}
+		if cfg.HalfOpenProbes <= 0 {
+			cfg.HalfOpenProbes = defaultCircuitHalfOpenProbes
+		}
+		c.circuitBreaker = newCircuitBreaker(cfg)
+	}
+}
+
+// CircuitState is the state of one endpoint's circuit.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every request through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects requests with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe requests through.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half_open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+// circuitOutcome classifies a finished attempt for the breaker.
+type circuitOutcome int
+
+const (
+	// circuitNeutral: the request never reached the endpoint (e.g. it could
+	// not be built, or the caller cancelled it).
+	circuitNeutral circuitOutcome = iota
+	circuitSuccess
+	circuitFailure
+)
+
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probes   int // in-flight probe requests while half-open
+}
+
+type circuitTransition struct {
+	from, to CircuitState
+	failures int
+}
+
+type circuitBreaker struct {
+	cfg CircuitBreakerConfig
+	now func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+func newCircuitBreaker(cfg CircuitBreakerConfig) *circuitBreaker {
+	return &circuitBreaker{
+		cfg:      cfg,
+		now:      time.Now,
+		circuits: map[string]*circuit{},
+	}
+}
+
+// acquire decides whether a request for key may proceed. When it may not, it
+// returns the time left until the circuit half-opens (zero while half-open
+// probes are already in flight). probe reports whether the caller holds a
+// half-open probe slot, which release must return.
+func (b *circuitBreaker) acquire(key string) (ok, probe bool, wait time.Duration, t *circuitTransition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cb, found := b.circuits[key]
+	if !found || cb.state == CircuitClosed {
+		return true, false, 0, nil
+	}
+	if cb.state == CircuitOpen {
+		reopenAt := cb.openedAt.Add(b.cfg.OpenTimeout)
+		now := b.now()
+		if now.Before(reopenAt) {
+			return false, false, reopenAt.Sub(now), nil
+		}
+		cb.state = CircuitHalfOpen
+		t = &circuitTransition{from: CircuitOpen, to: CircuitHalfOpen, failures: cb.failures}
+	}
+	if cb.probes >= b.cfg.HalfOpenProbes {
+		return false, false, 0, t
+	}
+	cb.probes++
+	return true, true, 0, t
+}
+
+// release records the outcome of a request admitted by acquire.
+func (b *circuitBreaker) release(key string, probe bool, outcome circuitOutcome) *circuitTransition {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cb, found := b.circuits[key]
+	if !found {
+		if outcome != circuitFailure {
+			return nil
+		}
+		cb = &circuit{}
+		b.circuits[key] = cb
+	}
+	if probe && cb.probes > 0 {
+		cb.probes--
+	}
+
+	switch outcome {
+	case circuitSuccess:
+		if cb.state == CircuitClosed {
+			cb.failures = 0
+			return nil
+		}
+		if !probe {
+			// A request admitted before the circuit opened; it proves
+			// nothing about the endpoint now.
+			return nil
+		}
+		from := cb.state
+		delete(b.circuits, key)
+		return &circuitTransition{from: from, to: CircuitClosed}
+	case circuitFailure:
+		cb.failures++
+		switch {
+		case cb.state == CircuitHalfOpen && probe,
+			cb.state == CircuitClosed && cb.failures >= b.cfg.FailureThreshold:
+			from := cb.state
+			cb.state = CircuitOpen
+			cb.openedAt = b.now()
+			return &circuitTransition{from: from, to: CircuitOpen, failures: cb.failures}
+		}
+	}
+	return nil
+}
+
+// acquireCircuit admits op through its endpoint's circuit, returning a
+// *StreamError with ErrCircuitOpen when the circuit rejects it. On success the
+// returned func must be called with the attempt's error once it finishes.
+func (c *Client) acquireCircuit(ctx context.Context, op *Operation) (func(error), error) {
+	if c.circuitBreaker == nil {
+		return func(error) {}, nil
+	}
+	key := op.Name()
+	ok, probe, wait, t := c.circuitBreaker.acquire(key)
+	c.logCircuitTransition(ctx, key, t)
+	if !ok {
+		return nil, circuitOpenError(op.Method, op.Path, wait)
+	}
+	return func(err error) {
+		t := c.circuitBreaker.release(key, probe, circuitOutcomeOf(ctx, op, err))
+		c.logCircuitTransition(ctx, key, t)
+	}, nil
+}
+
+// circuitOutcomeOf classifies an attempt: transport errors and 5xx responses
+// count against the endpoint, any other response counts for it.
+func circuitOutcomeOf(ctx context.Context, op *Operation, err error) circuitOutcome {
+	if errors.Is(err, ErrTransport) {
+		if ctx.Err() == context.Canceled {
+			return circuitNeutral
+		}
+		return circuitFailure
+	}
+	switch {
+	case op.StatusCode >= 500:
+		return circuitFailure
+	case op.StatusCode > 0:
+		return circuitSuccess
+	}
+	return circuitNeutral
+}
+
+func (c *Client) logCircuitTransition(ctx context.Context, key string, t *circuitTransition) {
+	if t == nil {
+		return
+	}
+	level := LogLevelInfo
+	if t.to == CircuitOpen {
+		level = LogLevelWarn
+	}
+	c.logEvent(ctx, level, "circuit.state_changed",
+		LogField{"stream.operation", key},
+		LogField{"circuit.from", t.from.String()},
+		LogField{"circuit.to", t.to.String()},
+		LogField{"circuit.consecutive_failures", t.failures},
+	)
+}
+
+// circuitOpenError constructs the *StreamError surfaced when an endpoint's
+// circuit rejects a request.
+func circuitOpenError(method, path string, wait time.Duration) *StreamError {
+	msg := fmt.Sprintf("stream: circuit open for %s %s", method, path)
+	if wait > 0 {
+		msg += fmt.Sprintf(", probing in %s", wait.Round(time.Millisecond))
+	}
+	return &StreamError{
+		sentinel:   ErrCircuitOpen,
+		Message:    msg,
+		RetryAfter: wait,
+		cause:      stackWrap(errors.New(msg), "circuit breaker"),
+	}
+}
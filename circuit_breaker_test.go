@@ -0,0 +1,135 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// flakyClient fails with err (or status 503 when err is nil) while failing is
+// set, and answers 200 {} otherwise.
+type flakyClient struct {
+	failing bool
+	err     error
+	calls   int
+}
+
+func (f *flakyClient) Do(r *http.Request) (*http.Response, error) {
+	f.calls++
+	if !f.failing {
+		return canned(200, `{}`, nil)()
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return canned(503, `{"code":-1,"message":"unavailable"}`, nil)()
+}
+
+func TestCircuitBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	fake := &flakyClient{failing: true}
+	rec := &recordingLogger{}
+	c := newTestClient(t, fake, WithLogger(rec), WithCircuitBreaker(CircuitBreakerConfig{Enabled: true, FailureThreshold: 3, OpenTimeout: time.Minute}))
+	stubClock(&c.circuitBreaker.now)
+
+	for i := 0; i < 3; i++ {
+		_, err := c.GetApp(context.Background(), &GetAppRequest{})
+		require.True(t, errors.Is(err, ErrApiResponse))
+	}
+	require.Equal(t, 3, fake.calls)
+
+	_, err := c.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrCircuitOpen))
+	require.False(t, errors.Is(err, ErrApiResponse))
+	require.False(t, errors.Is(err, ErrTransport))
+	var se *StreamError
+	require.True(t, errors.As(err, &se))
+	require.Equal(t, time.Minute, se.RetryAfter)
+	require.Equal(t, 3, fake.calls, "an open circuit must not send")
+	require.True(t, has(rec.warn, "circuit.state_changed stream.operation=GET /api/v2/app circuit.from=closed circuit.to=open circuit.consecutive_failures=3"), rec.warn)
+
+	// Other endpoints are unaffected.
+	_, err = c.Chat().GetChannel(context.Background(), "messaging", "general", &GetChannelRequest{})
+	require.True(t, errors.Is(err, ErrApiResponse))
+}
+
+func TestCircuitBreaker_SuccessResetsCount(t *testing.T) {
+	fake := &flakyClient{failing: true, err: syscall.ECONNRESET}
+	c := newTestClient(t, fake, WithCircuitBreaker(CircuitBreakerConfig{Enabled: true, FailureThreshold: 2}))
+
+	_, err := c.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrTransport))
+	fake.failing = false
+	_, err = c.GetApp(context.Background(), &GetAppRequest{})
+	require.NoError(t, err)
+	fake.failing = true
+	_, err = c.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrTransport), "failures must be consecutive to open")
+}
+
+func TestCircuitBreaker_ClientErrorsDoNotCount(t *testing.T) {
+	fake := &oneShotClient{status: 400, body: `{"code":4,"message":"bad"}`}
+	c := newTestClient(t, fake, WithCircuitBreaker(CircuitBreakerConfig{Enabled: true, FailureThreshold: 1}))
+
+	for i := 0; i < 3; i++ {
+		_, err := c.GetApp(context.Background(), &GetAppRequest{})
+		require.True(t, errors.Is(err, ErrApiResponse))
+	}
+	require.Equal(t, 3, fake.calls)
+}
+
+func TestCircuitBreaker_HalfOpenProbe(t *testing.T) {
+	fake := &flakyClient{failing: true}
+	rec := &recordingLogger{}
+	c := newTestClient(t, fake, WithLogger(rec), WithCircuitBreaker(CircuitBreakerConfig{Enabled: true, FailureThreshold: 1, OpenTimeout: time.Minute}))
+	now := stubClock(&c.circuitBreaker.now)
+
+	_, err := c.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrApiResponse))
+
+	// A failed probe re-opens the circuit for another OpenTimeout.
+	*now = now.Add(time.Minute)
+	_, err = c.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrApiResponse))
+	require.Equal(t, 2, fake.calls)
+	_, err = c.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrCircuitOpen))
+	require.True(t, has(rec.warn, "circuit.from=half_open circuit.to=open"), rec.warn)
+
+	// A successful probe closes it.
+	*now = now.Add(time.Minute)
+	fake.failing = false
+	_, err = c.GetApp(context.Background(), &GetAppRequest{})
+	require.NoError(t, err)
+	require.True(t, has(rec.info, "circuit.from=half_open circuit.to=closed"), rec.info)
+	_, err = c.GetApp(context.Background(), &GetAppRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 4, fake.calls)
+}
+
+func TestCircuitBreaker_HalfOpenLimitsProbes(t *testing.T) {
+	b := newCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Second, HalfOpenProbes: 1})
+	now := time.Unix(1_700_000_000, 0)
+	b.now = func() time.Time { return now }
+
+	b.release("op", false, circuitFailure)
+	ok, _, _, _ := b.acquire("op")
+	require.False(t, ok)
+
+	now = now.Add(time.Second)
+	ok, probe, _, tr := b.acquire("op")
+	require.True(t, ok)
+	require.True(t, probe)
+	require.Equal(t, CircuitHalfOpen, tr.to)
+	ok, _, wait, _ := b.acquire("op")
+	require.False(t, ok, "only one probe may be in flight")
+	require.Zero(t, wait)
+
+	b.release("op", true, circuitNeutral)
+	ok, _, _, _ = b.acquire("op")
+	require.True(t, ok, "a neutral outcome frees the probe slot")
+}
@@ -2,6 +2,7 @@ package getstream
 
 import (
 	"bytes"
+	"context"
 	"crypto"
 	"crypto/hmac"
 	"encoding/hex"
@@ -44,6 +45,7 @@ type HttpClient interface {
 type Client struct {
 	apiKey             string
 	apiSecret          []byte
+	secondarySecrets   [][]byte
 	authToken          string
 	baseUrl            string
 	defaultTimeout     time.Duration
@@ -53,8 +55,18 @@ type Client struct {
 	httpClient         HttpClient
 	httpClientFromUser bool // true iff WithHTTPClient was used; gates transport build
 	logger             Logger
-	logBodies          bool // true iff WithLogBodies(true) was used; gates body fields on DEBUG events
+	structuredLogger   StructuredLogger // nil unless WithStructuredLogger was used; takes precedence for SDK events
+	logBodies          bool             // true iff WithLogBodies(true) was used; gates body fields on DEBUG events
 	retry              RetryConfig
+	rateLimiter        *rateLimiter     // nil unless WithRateLimiter enabled it
+	circuitBreaker     *circuitBreaker  // nil unless WithCircuitBreaker enabled it
+	failover           *failover        // nil unless WithFailover configured endpoints
+	responseCache      *responseCache   // nil unless WithResponseCache enabled it
+	coalescer          *coalescer       // nil unless WithRequestCoalescing was used
+	uploadValidator    *uploadValidator // nil unless WithUploadValidation enabled it
+	dryRun             *DryRunConfig    // nil unless WithDryRun enabled it
+	interceptors       []Interceptor
+	metrics            Metrics
 }
 
 func (c *Client) HttpClient() HttpClient {
@@ -69,7 +81,12 @@ func (c *Client) ApiKey() string {
 	return c.apiKey
 }
 
+// BaseUrl returns the base URL requests are sent to. With WithFailover it is
+// the currently active endpoint.
 func (c *Client) BaseUrl() string {
+	if c.failover != nil {
+		return c.failover.current()
+	}
 	return c.baseUrl
 }
 
@@ -259,6 +276,9 @@ func newClient(apiKey, apiSecret string, options ...ClientOption) (*Client, erro
 	}
 
 	// Set default logger if not provided
+	if client.logger == nil && client.structuredLogger != nil {
+		client.logger = structuredPrintfLogger{client.structuredLogger}
+	}
 	if client.logger == nil {
 		client.logger = DefaultLoggerInstance
 	}
@@ -280,12 +300,19 @@ func newClient(apiKey, apiSecret string, options ...ClientOption) (*Client, erro
 		client.authToken = token
 	}
 
-	client.logger.Info(
-		"client.initialized stream.sdk.name=getstream-go stream.sdk.version=%s stream.client.max_conns_per_host=%d stream.client.idle_timeout_seconds=%d stream.client.connect_timeout_seconds=%d stream.client.request_timeout_seconds=%d stream.client.gzip_enabled=%t stream.client.user_http_client=%t stream.client.log_bodies=%t",
-		versionName, client.maxConnsPerHost, int(client.idleTimeout.Seconds()), int(client.connectTimeout.Seconds()), int(client.defaultTimeout.Seconds()), true, client.httpClientFromUser, client.logBodies,
+	client.logEvent(context.Background(), LogLevelInfo, "client.initialized",
+		LogField{"stream.sdk.name", "getstream-go"},
+		LogField{"stream.sdk.version", versionName},
+		LogField{"stream.client.max_conns_per_host", client.maxConnsPerHost},
+		LogField{"stream.client.idle_timeout_seconds", int(client.idleTimeout.Seconds())},
+		LogField{"stream.client.connect_timeout_seconds", int(client.connectTimeout.Seconds())},
+		LogField{"stream.client.request_timeout_seconds", int(client.defaultTimeout.Seconds())},
+		LogField{"stream.client.gzip_enabled", true},
+		LogField{"stream.client.user_http_client", client.httpClientFromUser},
+		LogField{"stream.client.log_bodies", client.logBodies},
 	)
 	if client.logBodies {
-		client.logger.Warn("HTTP request/response bodies will be logged. Auth headers and known-secret fields are still redacted, but other sensitive data (messages, PII) may appear in logs. Disable for production.")
+		client.logEvent(context.Background(), LogLevelWarn, "HTTP request/response bodies will be logged. Auth headers and known-secret fields are still redacted, but other sensitive data (messages, PII) may appear in logs. Disable for production.")
 	}
 
 	return client, nil
@@ -364,11 +391,17 @@ func (c *Client) createTokenWithClaims(claims jwt.MapClaims) (string, error) {
 	return token.SignedString(c.apiSecret)
 }
 
-// VerifyWebhook validates if hmac signature is correct for message body.
+// VerifyWebhook validates if hmac signature is correct for message body,
+// accepting any of the client's API secrets.
 func (c *Client) VerifyWebhook(body, signature []byte) (valid bool) {
-	mac := hmac.New(crypto.SHA256.New, c.apiSecret)
-	_, _ = mac.Write(body)
+	for _, secret := range c.apiSecrets() {
+		mac := hmac.New(crypto.SHA256.New, secret)
+		_, _ = mac.Write(body)
 
-	expectedMAC := hex.EncodeToString(mac.Sum(nil))
-	return bytes.Equal(signature, []byte(expectedMAC))
+		expectedMAC := hex.EncodeToString(mac.Sum(nil))
+		if bytes.Equal(signature, []byte(expectedMAC)) {
+			return true
+		}
+	}
+	return false
 }
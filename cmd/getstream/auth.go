@@ -0,0 +1,149 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var tokenCommands = []command{
+	{
+		group: "token", name: "create", args: "<user-id>",
+		summary: "Create a user token",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			exp := fs.Duration("exp", 0, "token lifetime; 0 for a token that never expires")
+			role := fs.String("role", "", "role claim")
+			return func(e *env, args []string) (*result, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				var opts []getstream.TokenOption
+				if *exp > 0 {
+					opts = append(opts, getstream.WithExpiration(*exp))
+				}
+				if *role != "" {
+					opts = append(opts, getstream.WithClaims(getstream.Claims{Role: *role}))
+				}
+				token, err := client.CreateToken(args[0], opts...)
+				if err != nil {
+					return nil, err
+				}
+				return &result{
+					data:    map[string]string{"user_id": args[0], "token": token},
+					columns: []string{"TOKEN"},
+					rows:    [][]string{{token}},
+				}, nil
+			}
+		},
+	},
+	{
+		group: "token", name: "verify", args: "<token>",
+		summary: "Verify a user token and show its claims",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			leeway := fs.Duration("leeway", 0, "tolerated clock skew")
+			revocation := fs.Bool("revocation", false, "also check the app's and user's token revocations (makes API calls)")
+			return func(e *env, args []string) (*result, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				opts := []getstream.VerifyTokenOption{getstream.WithTokenLeeway(*leeway)}
+				if *revocation {
+					opts = append(opts, getstream.WithTokenRevocationCheck())
+				}
+				claims, err := client.VerifyToken(e.ctx, args[0], opts...)
+				if err != nil {
+					return nil, err
+				}
+				data := map[string]any{
+					"user_id": claims.UserID,
+					"secret":  claims.Secret.String(),
+				}
+				if claims.Role != "" {
+					data["role"] = claims.Role
+				}
+				if len(claims.ChannelCIDs) > 0 {
+					data["channel_cids"] = claims.ChannelCIDs
+				}
+				if len(claims.CallCIDs) > 0 {
+					data["call_cids"] = claims.CallCIDs
+				}
+				if len(claims.CustomClaims) > 0 {
+					data["custom"] = claims.CustomClaims
+				}
+				if !claims.IssuedAt.IsZero() {
+					data["issued_at"] = claims.IssuedAt
+				}
+				if !claims.ExpiresAt.IsZero() {
+					data["expires_at"] = claims.ExpiresAt
+				}
+				return &result{
+					data:    data,
+					columns: []string{"USER", "ROLE", "ISSUED", "EXPIRES", "SECRET"},
+					rows:    [][]string{{claims.UserID, str(&claims.Role), formatClaimTime(claims.IssuedAt), formatClaimTime(claims.ExpiresAt), claims.Secret.String()}},
+				}, nil
+			}
+		},
+	},
+}
+
+func formatClaimTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+var webhookCommands = []command{
+	{
+		group: "webhook", name: "verify", args: "[file]",
+		summary: "Check the signature of a webhook payload read from file or stdin",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			signature := fs.String("signature", "", "value of the X-Signature header (required)")
+			return func(e *env, args []string) (*result, error) {
+				if *signature == "" || len(args) > 1 {
+					return nil, errUsage
+				}
+				var body []byte
+				var err error
+				if len(args) == 0 || args[0] == "-" {
+					body, err = io.ReadAll(e.stdin)
+				} else {
+					body, err = os.ReadFile(args[0])
+				}
+				if err != nil {
+					return nil, err
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				payload, err := getstream.GunzipPayload(body)
+				if err != nil {
+					return nil, err
+				}
+				match, ok := client.MatchWebhookSignature(payload, strings.TrimSpace(*signature))
+				if !ok {
+					return nil, errors.New("signature does not match any API secret")
+				}
+				return &result{
+					data:    map[string]any{"valid": true, "secret": match.String()},
+					columns: []string{"VALID", "SECRET"},
+					rows:    [][]string{{"true", match.String()}},
+				}, nil
+			}
+		},
+	},
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var userCommands = []command{
+	{
+		group: "users", name: "get", args: "<id>...",
+		summary: "Show users by ID, deactivated ones included",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			return func(e *env, args []string) (*result, error) {
+				if len(args) == 0 {
+					return nil, errUsage
+				}
+				return queryUsers(e, map[string]any{"id": map[string]any{"$in": args}}, len(args))
+			}
+		},
+	},
+	{
+		group: "users", name: "query",
+		summary: "Query users",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			filter := filterFlag(fs)
+			limit := fs.Int("limit", 25, "maximum number of users")
+			return func(e *env, args []string) (*result, error) {
+				f, err := parseFilter(*filter)
+				if err != nil {
+					return nil, err
+				}
+				return queryUsers(e, f, *limit)
+			}
+		},
+	},
+}
+
+func queryUsers(e *env, filter map[string]any, limit int) (*result, error) {
+	client, err := e.connect()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.QueryUsers(e.ctx, &getstream.QueryUsersRequest{Payload: &getstream.QueryUsersPayload{
+		FilterConditions:        filter,
+		IncludeDeactivatedUsers: getstream.PtrTo(true),
+		Limit:                   getstream.PtrTo(limit),
+	}})
+	if err != nil {
+		return nil, err
+	}
+	res := &result{data: resp.Data.Users, columns: []string{"ID", "NAME", "ROLE", "CREATED", "LAST ACTIVE", "BANNED", "DEACTIVATED"}}
+	for _, u := range resp.Data.Users {
+		res.rows = append(res.rows, []string{u.ID, str(u.Name), u.Role, formatTime(&u.CreatedAt), formatTime(u.LastActive), fmt.Sprint(u.Banned), formatTime(u.DeactivatedAt)})
+	}
+	return res, nil
+}
+
+var channelCommands = []command{
+	{
+		group: "channels", name: "get", args: "<type> <id>",
+		summary: "Show a channel with its members and latest messages",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			messages := fs.Int("messages", 25, "number of latest messages")
+			members := fs.Int("members", 100, "number of members")
+			return func(e *env, args []string) (*result, error) {
+				if len(args) != 2 {
+					return nil, errUsage
+				}
+				cid := args[0] + ":" + args[1]
+				channels, err := queryChannels(e, &getstream.QueryChannelsRequest{
+					FilterConditions: map[string]any{"cid": cid},
+					State:            getstream.PtrTo(true),
+					MessageLimit:     getstream.PtrTo(*messages),
+					MemberLimit:      getstream.PtrTo(*members),
+				})
+				if err != nil {
+					return nil, err
+				}
+				if len(channels) == 0 {
+					return nil, fmt.Errorf("channel %s not found", cid)
+				}
+				ch := channels[0]
+				// The table lists the messages; the channel itself is in the
+				// JSON output.
+				res := &result{data: ch}
+				res.columns, res.rows = messageTable(ch.Messages)
+				return res, nil
+			}
+		},
+	},
+	{
+		group: "channels", name: "query",
+		summary: "Query channels",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			filter := filterFlag(fs)
+			limit := fs.Int("limit", 25, "maximum number of channels")
+			return func(e *env, args []string) (*result, error) {
+				f, err := parseFilter(*filter)
+				if err != nil {
+					return nil, err
+				}
+				channels, err := queryChannels(e, &getstream.QueryChannelsRequest{
+					FilterConditions: f,
+					Limit:            getstream.PtrTo(*limit),
+					MessageLimit:     getstream.PtrTo(0),
+				})
+				if err != nil {
+					return nil, err
+				}
+				res := &result{data: channels, columns: []string{"CID", "CREATED", "LAST MESSAGE", "MEMBERS", "FROZEN", "DISABLED"}}
+				for _, ch := range channels {
+					if c := ch.Channel; c != nil {
+						res.rows = append(res.rows, []string{c.Cid, formatTime(&c.CreatedAt), formatTime(c.LastMessageAt), num(c.MemberCount), fmt.Sprint(c.Frozen), fmt.Sprint(c.Disabled)})
+					}
+				}
+				return res, nil
+			}
+		},
+	},
+}
+
+func queryChannels(e *env, req *getstream.QueryChannelsRequest) ([]getstream.ChannelStateResponseFields, error) {
+	client, err := e.connect()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Chat().QueryChannels(e.ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data.Channels, nil
+}
+
+var messageCommands = []command{
+	{
+		group: "messages", name: "get", args: "<id>",
+		summary: "Show a message",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			return func(e *env, args []string) (*result, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := client.Chat().GetMessage(e.ctx, args[0], &getstream.GetMessageRequest{})
+				if err != nil {
+					return nil, err
+				}
+				m := resp.Data.Message
+				return &result{
+					data:    m,
+					columns: []string{"ID", "CID", "USER", "CREATED", "TYPE", "REPLIES", "TEXT"},
+					rows:    [][]string{{m.ID, m.Cid, m.User.ID, formatTime(&m.CreatedAt), m.Type, fmt.Sprint(m.ReplyCount), truncate(m.Text, 60)}},
+				}, nil
+			}
+		},
+	},
+	{
+		group: "messages", name: "replies", args: "<parent-id>",
+		summary: "List the replies in a thread",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			limit := fs.Int("limit", 25, "maximum number of replies")
+			return func(e *env, args []string) (*result, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := client.Chat().GetReplies(e.ctx, args[0], &getstream.GetRepliesRequest{Limit: getstream.PtrTo(*limit)})
+				if err != nil {
+					return nil, err
+				}
+				res := &result{data: resp.Data.Messages}
+				res.columns, res.rows = messageTable(resp.Data.Messages)
+				return res, nil
+			}
+		},
+	},
+}
+
+func messageTable(messages []getstream.MessageResponse) ([]string, [][]string) {
+	var rows [][]string
+	for _, m := range messages {
+		rows = append(rows, []string{m.ID, m.User.ID, formatTime(&m.CreatedAt), m.Type, fmt.Sprint(m.ReplyCount), truncate(m.Text, 60)})
+	}
+	return []string{"ID", "USER", "CREATED", "TYPE", "REPLIES", "TEXT"}, rows
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var feedCommands = []command{
+	{
+		group: "feeds", name: "query",
+		summary: "Query feeds",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			filter := filterFlag(fs)
+			limit := fs.Int("limit", 25, "maximum number of feeds")
+			return func(e *env, args []string) (*result, error) {
+				f, err := parseFilter(*filter)
+				if err != nil {
+					return nil, err
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := client.Feeds().QueryFeeds(e.ctx, &getstream.QueryFeedsRequest{Filter: f, Limit: getstream.PtrTo(*limit)})
+				if err != nil {
+					return nil, err
+				}
+				res := &result{data: resp.Data.Feeds, columns: []string{"FEED", "NAME", "CREATED BY", "CREATED", "ACTIVITIES", "FOLLOWERS", "FOLLOWING"}}
+				for _, f := range resp.Data.Feeds {
+					res.rows = append(res.rows, []string{f.Feed, f.Name, f.CreatedBy.ID, formatTime(&f.CreatedAt), fmt.Sprint(f.ActivityCount), fmt.Sprint(f.FollowerCount), fmt.Sprint(f.FollowingCount)})
+				}
+				return res, nil
+			}
+		},
+	},
+	{
+		group: "feeds", name: "activities",
+		summary: "Query activities",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			filter := filterFlag(fs)
+			limit := fs.Int("limit", 25, "maximum number of activities")
+			return func(e *env, args []string) (*result, error) {
+				f, err := parseFilter(*filter)
+				if err != nil {
+					return nil, err
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := client.Feeds().QueryActivities(e.ctx, &getstream.QueryActivitiesRequest{Filter: f, Limit: getstream.PtrTo(*limit)})
+				if err != nil {
+					return nil, err
+				}
+				res := &result{data: resp.Data.Activities, columns: activityColumns}
+				for _, a := range resp.Data.Activities {
+					res.rows = append(res.rows, activityRow(a))
+				}
+				return res, nil
+			}
+		},
+	},
+	{
+		group: "feeds", name: "activity", args: "<id>",
+		summary: "Show an activity",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			return func(e *env, args []string) (*result, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := client.Feeds().GetActivity(e.ctx, args[0], &getstream.GetActivityRequest{})
+				if err != nil {
+					return nil, err
+				}
+				return &result{data: resp.Data.Activity, columns: activityColumns, rows: [][]string{activityRow(resp.Data.Activity)}}, nil
+			}
+		},
+	},
+}
+
+var activityColumns = []string{"ID", "TYPE", "USER", "FEEDS", "CREATED", "REACTIONS", "COMMENTS", "TEXT"}
+
+func activityRow(a getstream.ActivityResponse) []string {
+	text := "-"
+	if a.Text != nil {
+		text = truncate(*a.Text, 60)
+	}
+	return []string{a.ID, a.Type, a.User.ID, joinOr(a.Feeds), formatTime(&a.CreatedAt), fmt.Sprint(a.ReactionCount), fmt.Sprint(a.CommentCount), text}
+}
@@ -0,0 +1,246 @@
+// Command getstream is a command-line client for the Stream API, for
+// day-to-day operations: inspecting users, channels, messages, calls, feeds
+// and moderation queues, checking on tasks, minting and verifying user
+// tokens, and checking webhook signatures.
+//
+// Credentials come from STREAM_API_KEY and STREAM_API_SECRET, and
+// optionally STREAM_BASE_URL, as for getstream.NewClientFromEnvVars.
+//
+// Usage:
+//
+//	getstream [-o json|table] [-timeout 30s] [-v] <command> <subcommand> [flags] [args]
+//
+// Run getstream without arguments for the list of commands.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdin, os.Stdout, os.Stderr, getstream.NewClientFromEnvVars))
+}
+
+// Exit codes.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// errUsage reports bad command-line arguments; the command's usage is
+// printed with it.
+var errUsage = errors.New("usage")
+
+// env is what a command runs with.
+type env struct {
+	ctx     context.Context
+	stdin   io.Reader
+	connect func() (*getstream.Stream, error)
+}
+
+// result is a command's output: data is printed as JSON, columns and rows
+// as a table.
+type result struct {
+	data    any
+	columns []string
+	rows    [][]string
+}
+
+// command is one subcommand. setup registers its flags on fs and returns
+// the function that runs it with the remaining arguments.
+type command struct {
+	group, name string
+	args        string // positional arguments, for usage
+	summary     string
+	setup       func(fs *flag.FlagSet) func(e *env, args []string) (*result, error)
+}
+
+// commands is every subcommand, in the order usage lists them.
+var commands = concat(userCommands, channelCommands, messageCommands, callCommands, feedCommands, moderationCommands, taskCommands, tokenCommands, webhookCommands)
+
+func concat(lists ...[]command) []command {
+	var out []command
+	for _, l := range lists {
+		out = append(out, l...)
+	}
+	return out
+}
+
+// run runs the command line args and returns the exit code. connect creates
+// the client, getstream.NewClientFromEnvVars outside tests.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer, connect func(...getstream.ClientOption) (*getstream.Stream, error)) int {
+	global := flag.NewFlagSet("getstream", flag.ContinueOnError)
+	global.SetOutput(stderr)
+	format := global.String("o", "json", "output format: json or table")
+	timeout := global.Duration("timeout", 30*time.Second, "overall timeout; 0 for none")
+	verbose := global.Bool("v", false, "log API requests and responses to stderr")
+	global.Usage = func() { usage(stderr, global) }
+	if err := global.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *format != "json" && *format != "table" {
+		fmt.Fprintf(stderr, "getstream: unknown output format %q\n", *format)
+		return exitUsage
+	}
+	args = global.Args()
+	if len(args) < 2 {
+		usage(stderr, global)
+		return exitUsage
+	}
+	cmd := findCommand(args[0], args[1])
+	if cmd == nil {
+		fmt.Fprintf(stderr, "getstream: unknown command %q\n\n", strings.Join(args[:2], " "))
+		usage(stderr, global)
+		return exitUsage
+	}
+
+	fs := flag.NewFlagSet("getstream "+cmd.group+" "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	runCmd := cmd.setup(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: getstream %s %s [flags] %s\n\n%s\n", cmd.group, cmd.name, cmd.args, cmd.summary)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args[2:]); err != nil {
+		return exitUsage
+	}
+
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+	// Only warnings and errors by default, so that stderr stays quiet.
+	level := getstream.LogLevelWarn
+	if *verbose {
+		level = getstream.LogLevelDebug
+	}
+	logger := getstream.NewDefaultLogger(stderr, "", log.LstdFlags, level)
+	e := &env{ctx: ctx, stdin: stdin, connect: func() (*getstream.Stream, error) {
+		return connect(getstream.WithLogger(logger))
+	}}
+	res, err := runCmd(e, fs.Args())
+	if errors.Is(err, errUsage) {
+		fs.Usage()
+		return exitUsage
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "getstream: %v\n", err)
+		return exitError
+	}
+	if err := write(stdout, *format, res); err != nil {
+		fmt.Fprintf(stderr, "getstream: %v\n", err)
+		return exitError
+	}
+	return exitOK
+}
+
+func findCommand(group, name string) *command {
+	for i := range commands {
+		if commands[i].group == group && commands[i].name == name {
+			return &commands[i]
+		}
+	}
+	return nil
+}
+
+func usage(w io.Writer, global *flag.FlagSet) {
+	fmt.Fprintln(w, "usage: getstream [flags] <command> <subcommand> [flags] [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Credentials are read from STREAM_API_KEY, STREAM_API_SECRET and STREAM_BASE_URL.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, c := range commands {
+		fmt.Fprintf(tw, "  %s %s %s\t%s\n", c.group, c.name, c.args, c.summary)
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Flags:")
+	global.PrintDefaults()
+}
+
+func write(w io.Writer, format string, res *result) error {
+	if format == "table" && res.columns != nil {
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(res.columns, "\t"))
+		for _, row := range res.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+	b, err := json.MarshalIndent(res.data, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
+
+// filterFlag registers the -filter flag of the query commands.
+func filterFlag(fs *flag.FlagSet) *string {
+	return fs.String("filter", "{}", "filter conditions as a JSON object")
+}
+
+func parseFilter(s string) (map[string]any, error) {
+	var filter map[string]any
+	if err := json.Unmarshal([]byte(s), &filter); err != nil {
+		return nil, fmt.Errorf("invalid -filter: %w", err)
+	}
+	if filter == nil {
+		filter = map[string]any{}
+	}
+	return filter, nil
+}
+
+// formatTime renders an API timestamp for tables; "-" when unset.
+func formatTime(t *getstream.Timestamp) string {
+	if t == nil || t.Time == nil {
+		return "-"
+	}
+	return t.Time.UTC().Format(time.RFC3339)
+}
+
+func str(s *string) string {
+	if s == nil || *s == "" {
+		return "-"
+	}
+	return *s
+}
+
+func num(n *int) string {
+	if n == nil {
+		return "-"
+	}
+	return fmt.Sprint(*n)
+}
+
+// truncate shortens free text to fit a table cell.
+func truncate(s string, n int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if r := []rune(s); len(r) > n {
+		return string(r[:n-1]) + "…"
+	}
+	return s
+}
+
+// joinOr renders a list for a table cell; "-" when empty.
+func joinOr(items []string) string {
+	if len(items) == 0 {
+		return "-"
+	}
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/GetStream/getstream-go/v5"
+	"github.com/GetStream/getstream-go/v5/streamtest"
+	"github.com/stretchr/testify/require"
+)
+
+// cli runs the command against a fake server seeded with two users, a
+// channel, a message and a reply.
+type cli struct {
+	fake *streamtest.Server
+}
+
+func newCLI(t *testing.T) *cli {
+	t.Helper()
+	fake := streamtest.New()
+	t.Cleanup(fake.Close)
+	client, err := fake.Client()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.UpdateUsers(ctx, &getstream.UpdateUsersRequest{Users: map[string]getstream.UserRequest{
+		"john": {ID: "john", Name: getstream.PtrTo("John"), Role: getstream.PtrTo("admin")},
+		"jane": {ID: "jane"},
+	}})
+	require.NoError(t, err)
+	_, err = client.Chat().GetOrCreateChannel(ctx, "messaging", "general", &getstream.GetOrCreateChannelRequest{
+		Data: &getstream.ChannelInput{CreatedByID: getstream.PtrTo("john"), Members: []getstream.ChannelMemberRequest{{UserID: "john"}, {UserID: "jane"}}},
+	})
+	require.NoError(t, err)
+	_, err = client.Chat().SendMessage(ctx, "messaging", "general", &getstream.SendMessageRequest{
+		Message: getstream.MessageRequest{ID: getstream.PtrTo("m1"), Text: getstream.PtrTo("hello\nworld"), UserID: getstream.PtrTo("john")},
+	})
+	require.NoError(t, err)
+	_, err = client.Chat().SendMessage(ctx, "messaging", "general", &getstream.SendMessageRequest{
+		Message: getstream.MessageRequest{ID: getstream.PtrTo("m2"), Text: getstream.PtrTo("hi"), UserID: getstream.PtrTo("jane"), ParentID: getstream.PtrTo("m1")},
+	})
+	require.NoError(t, err)
+
+	return &cli{fake: fake}
+}
+
+func (c *cli) run(stdin string, args ...string) (code int, stdout, stderr string) {
+	var out, errOut bytes.Buffer
+	code = run(context.Background(), args, strings.NewReader(stdin), &out, &errOut, c.fake.Client)
+	return code, out.String(), errOut.String()
+}
+
+func TestCLI_Chat(t *testing.T) {
+	c := newCLI(t)
+
+	code, out, errOut := c.run("", "users", "get", "john", "missing")
+	require.Equal(t, exitOK, code, errOut)
+	require.Empty(t, errOut, "client logs stay quiet without -v")
+	var users []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &users))
+	require.Len(t, users, 1)
+	require.Equal(t, "John", users[0]["name"])
+	require.Equal(t, "admin", users[0]["role"])
+
+	code, out, _ = c.run("", "-o", "table", "users", "query", "-filter", `{"role":"user"}`)
+	require.Equal(t, exitOK, code)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 2)
+	require.Regexp(t, `^ID\s+NAME\s+ROLE`, lines[0])
+	require.Regexp(t, `^jane\s+-\s+user`, lines[1])
+
+	code, out, _ = c.run("", "-o", "table", "channels", "get", "messaging", "general")
+	require.Equal(t, exitOK, code)
+	require.Contains(t, out, "hello world", "newlines are flattened in table cells")
+
+	code, out, _ = c.run("", "channels", "get", "messaging", "general")
+	require.Equal(t, exitOK, code)
+	var state struct {
+		Channel struct {
+			Cid string `json:"cid"`
+		} `json:"channel"`
+		Members  []map[string]any `json:"members"`
+		Messages []map[string]any `json:"messages"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &state))
+	require.Equal(t, "messaging:general", state.Channel.Cid)
+	require.Len(t, state.Members, 2)
+	require.Equal(t, "m1", state.Messages[0]["id"])
+
+	code, out, _ = c.run("", "-o", "table", "messages", "get", "m2")
+	require.Equal(t, exitOK, code)
+	require.Regexp(t, `m2\s+messaging:general\s+jane`, out)
+
+	code, _, errOut = c.run("", "messages", "get", "nope")
+	require.Equal(t, exitError, code)
+	require.Contains(t, errOut, "getstream: ")
+
+	code, _, errOut = c.run("", "channels", "get", "messaging", "nope")
+	require.Equal(t, exitError, code)
+	require.Contains(t, errOut, "channel messaging:nope not found")
+}
+
+func TestCLI_TokenAndWebhook(t *testing.T) {
+	c := newCLI(t)
+
+	code, out, errOut := c.run("", "token", "create", "-exp", "1h", "-role", "admin", "john")
+	require.Equal(t, exitOK, code, errOut)
+	var created map[string]string
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+
+	code, out, errOut = c.run("", "token", "verify", created["token"])
+	require.Equal(t, exitOK, code, errOut)
+	var claims map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &claims))
+	require.Equal(t, "john", claims["user_id"])
+	require.Equal(t, "admin", claims["role"])
+	require.Equal(t, "primary", claims["secret"])
+	require.Contains(t, claims, "expires_at")
+
+	code, _, errOut = c.run("", "token", "verify", "not-a-token")
+	require.Equal(t, exitError, code)
+	require.Contains(t, errOut, "getstream: ")
+
+	body := `{"type":"message.new"}`
+	mac := hmac.New(sha256.New, []byte(streamtest.DefaultAPISecret))
+	mac.Write([]byte(body))
+	sig := hex.EncodeToString(mac.Sum(nil))
+
+	code, out, errOut = c.run(body, "-o", "table", "webhook", "verify", "-signature", sig)
+	require.Equal(t, exitOK, code, errOut)
+	require.Regexp(t, `true\s+primary`, out)
+
+	code, _, errOut = c.run(body+" ", "webhook", "verify", "-signature", sig)
+	require.Equal(t, exitError, code)
+	require.Contains(t, errOut, "signature does not match")
+}
+
+func TestCLI_Usage(t *testing.T) {
+	c := newCLI(t)
+
+	code, _, errOut := c.run("")
+	require.Equal(t, exitUsage, code)
+	for _, cmd := range commands {
+		require.Contains(t, errOut, cmd.group+" "+cmd.name)
+	}
+
+	code, _, errOut = c.run("", "users", "nuke")
+	require.Equal(t, exitUsage, code)
+	require.Contains(t, errOut, `unknown command "users nuke"`)
+
+	code, _, errOut = c.run("", "calls", "get", "default")
+	require.Equal(t, exitUsage, code)
+	require.Contains(t, errOut, "usage: getstream calls get [flags] <type> <id>")
+
+	code, _, _ = c.run("", "webhook", "verify")
+	require.Equal(t, exitUsage, code)
+
+	code, _, errOut = c.run("", "-o", "yaml", "users", "get", "john")
+	require.Equal(t, exitUsage, code)
+	require.Contains(t, errOut, `unknown output format "yaml"`)
+}
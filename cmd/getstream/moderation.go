@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var moderationCommands = []command{
+	{
+		group: "moderation", name: "queue",
+		summary: "List review queue items, newest first",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			filter := filterFlag(fs)
+			limit := fs.Int("limit", 25, "maximum number of items")
+			return func(e *env, args []string) (*result, error) {
+				f, err := parseFilter(*filter)
+				if err != nil {
+					return nil, err
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := client.Moderation().QueryReviewQueue(e.ctx, &getstream.QueryReviewQueueRequest{
+					Filter: f,
+					Limit:  getstream.PtrTo(*limit),
+					Sort:   []getstream.SortParamRequest{{Field: getstream.PtrTo("created_at"), Direction: getstream.PtrTo(-1)}},
+				})
+				if err != nil {
+					return nil, err
+				}
+				res := &result{data: resp.Data.Items, columns: reviewItemColumns}
+				for _, item := range resp.Data.Items {
+					res.rows = append(res.rows, reviewItemRow(item))
+				}
+				return res, nil
+			}
+		},
+	},
+	{
+		group: "moderation", name: "item", args: "<id>",
+		summary: "Show a review queue item",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			return func(e *env, args []string) (*result, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := client.Moderation().GetReviewQueueItem(e.ctx, args[0], &getstream.GetReviewQueueItemRequest{})
+				if err != nil {
+					return nil, err
+				}
+				if resp.Data.Item == nil {
+					return nil, fmt.Errorf("review queue item %s not found", args[0])
+				}
+				return &result{data: resp.Data.Item, columns: reviewItemColumns, rows: [][]string{reviewItemRow(*resp.Data.Item)}}, nil
+			}
+		},
+	},
+}
+
+var reviewItemColumns = []string{"ID", "ENTITY TYPE", "ENTITY ID", "CREATOR", "STATUS", "RECOMMENDED", "SEVERITY", "FLAGS", "CREATED"}
+
+func reviewItemRow(item getstream.ReviewQueueItemResponse) []string {
+	return []string{item.ID, item.EntityType, item.EntityID, str(item.EntityCreatorID), item.Status, item.RecommendedAction, fmt.Sprint(item.Severity), fmt.Sprint(item.FlagsCount), formatTime(&item.CreatedAt)}
+}
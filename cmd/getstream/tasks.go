@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"time"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var taskCommands = []command{
+	{
+		group: "tasks", name: "get", args: "<id>",
+		summary: "Show the status of an async task",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			return func(e *env, args []string) (*result, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := client.GetTask(e.ctx, args[0], &getstream.GetTaskRequest{})
+				if err != nil {
+					return nil, err
+				}
+				return taskResult(resp.Data), nil
+			}
+		},
+	},
+	{
+		group: "tasks", name: "wait", args: "<id>",
+		summary: "Wait for an async task to complete or fail",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			// The overall -timeout still applies; set it to 0 for long tasks.
+			timeout := fs.Duration("wait", time.Minute, "maximum wait; 0 for no limit")
+			interval := fs.Duration("interval", time.Second, "poll interval")
+			return func(e *env, args []string) (*result, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := getstream.WaitForTask(e.ctx, client, args[0],
+					getstream.WithWaitForTaskTimeout(*timeout),
+					getstream.WithWaitForTaskPollInterval(*interval))
+				if err != nil {
+					return nil, err
+				}
+				return taskResult(resp.Data), nil
+			}
+		},
+	},
+}
+
+func taskResult(t getstream.GetTaskResponse) *result {
+	errText := "-"
+	if t.Error != nil {
+		errText = truncate(t.Error.Description, 60)
+	}
+	return &result{
+		data:    t,
+		columns: []string{"ID", "STATUS", "CREATED", "UPDATED", "ERROR"},
+		rows:    [][]string{{t.TaskID, t.Status, formatTime(&t.CreatedAt), formatTime(&t.UpdatedAt), errText}},
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var callCommands = []command{
+	{
+		group: "calls", name: "get", args: "<type> <id>",
+		summary: "Show a call with its members",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			return func(e *env, args []string) (*result, error) {
+				if len(args) != 2 {
+					return nil, errUsage
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := client.Video().GetCall(e.ctx, args[0], args[1], &getstream.GetCallRequest{})
+				if err != nil {
+					return nil, err
+				}
+				return &result{
+					data:    resp.Data,
+					columns: callColumns,
+					rows:    [][]string{callRow(resp.Data.Call, len(resp.Data.Members))},
+				}, nil
+			}
+		},
+	},
+	{
+		group: "calls", name: "query",
+		summary: "Query calls",
+		setup: func(fs *flag.FlagSet) func(e *env, args []string) (*result, error) {
+			filter := filterFlag(fs)
+			limit := fs.Int("limit", 25, "maximum number of calls")
+			return func(e *env, args []string) (*result, error) {
+				f, err := parseFilter(*filter)
+				if err != nil {
+					return nil, err
+				}
+				client, err := e.connect()
+				if err != nil {
+					return nil, err
+				}
+				resp, err := client.Video().QueryCalls(e.ctx, &getstream.QueryCallsRequest{FilterConditions: f, Limit: getstream.PtrTo(*limit)})
+				if err != nil {
+					return nil, err
+				}
+				res := &result{data: resp.Data.Calls, columns: callColumns}
+				for _, c := range resp.Data.Calls {
+					res.rows = append(res.rows, callRow(c.Call, len(c.Members)))
+				}
+				return res, nil
+			}
+		},
+	},
+}
+
+var callColumns = []string{"CID", "CREATED BY", "CREATED", "STARTS", "ENDED", "MEMBERS", "LIVE PARTICIPANTS", "RECORDING"}
+
+func callRow(c getstream.CallResponse, members int) []string {
+	participants := "-"
+	if c.Session != nil && c.Session.EndedAt == nil {
+		participants = fmt.Sprint(len(c.Session.Participants))
+	}
+	return []string{c.Cid, c.CreatedBy.ID, formatTime(&c.CreatedAt), formatTime(c.StartsAt), formatTime(c.EndedAt), fmt.Sprint(members), participants, fmt.Sprint(c.Recording)}
+}
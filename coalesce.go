@@ -0,0 +1,120 @@
+package getstream
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithRequestCoalescing makes identical concurrent GET requests (same path,
+// path params and query) share a single HTTP call. Every caller receives its
+// own copy of the *StreamResponse, carrying its own RequestID, but the
+// response data is shared and must therefore be treated as read-only. Each
+// caller's ctx still bounds its own wait; the shared call is cancelled only
+// once every caller waiting on it has given up, or when the RequestTimeout of
+// the caller that started it runs out.
+func WithRequestCoalescing() ClientOption {
+	return func(c *Client) {
+		c.coalescer = &coalescer{calls: map[string]*flight{}}
+	}
+}
+
+// coalescer tracks the in-flight shared calls by request key.
+type coalescer struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	done    chan struct{}
+	res     any
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// coalesce joins the in-flight call for key or starts one running do. The
+// shared call runs on a ctx that keeps the values of the caller that started
+// it but none of its cancellation, bounded by that caller's RequestTimeout.
+func coalesce[GResponse any](c *Client, ctx context.Context, key string, response *GResponse, do func(context.Context) (*StreamResponse[GResponse], error)) (*StreamResponse[GResponse], error) {
+	g := c.coalescer
+	g.mu.Lock()
+	f, joined := g.calls[key]
+	if !joined {
+		fctx, cancel := context.WithCancel(detachedContext{ctx})
+		if timeout := requestOptionsFromContext(ctx).timeout; timeout > 0 {
+			fctx, cancel = context.WithTimeout(detachedContext{ctx}, timeout)
+		}
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = f
+		go func() {
+			res, err := do(fctx)
+			g.mu.Lock()
+			if g.calls[key] == f {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+			f.res, f.err = res, err
+			cancel()
+			close(f.done)
+		}()
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	if joined {
+		c.logEvent(ctx, LogLevelDebug, "http.request.coalesced", LogField{"request.key", key})
+	}
+
+	select {
+	case <-f.done:
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			// Nobody is left to receive the result.
+			if g.calls[key] == f {
+				delete(g.calls, key)
+			}
+			f.cancel()
+		}
+		g.mu.Unlock()
+		return nil, wrapTransportError(ctx.Err())
+	}
+
+	requestID, _ := RequestIDFromContext(ctx)
+	if f.err != nil {
+		if se, ok := f.err.(*StreamError); ok {
+			own := *se
+			own.RequestID = requestID
+			return nil, &own
+		}
+		return nil, f.err
+	}
+	res, ok := f.res.(*StreamResponse[GResponse])
+	if !ok {
+		// Another generated method shares this path with a different
+		// response type; don't share across types.
+		return do(ctx)
+	}
+	if res == nil {
+		return nil, nil
+	}
+	own := *res
+	own.RequestID = requestID
+	if response != nil {
+		*response = own.Data
+	}
+	return &own, nil
+}
+
+// detachedContext carries its parent's values without its deadline or
+// cancellation (context.WithoutCancel needs Go 1.21).
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+func (d detachedContext) Value(key any) any         { return d.parent.Value(key) }
@@ -0,0 +1,189 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// gatedClient blocks every request until release is closed (or the request
+// ctx ends), then answers 200 with body.
+type gatedClient struct {
+	body    string
+	release chan struct{}
+	calls   int32
+	started chan struct{}
+}
+
+func newGatedClient(body string) *gatedClient {
+	return &gatedClient{body: body, release: make(chan struct{}), started: make(chan struct{}, 100)}
+}
+
+func (g *gatedClient) Do(r *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&g.calls, 1)
+	g.started <- struct{}{}
+	select {
+	case <-g.release:
+		return canned(200, g.body, nil)()
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+}
+
+// discardLogging replaces the recordingLogger, which isn't safe for
+// concurrent use.
+var discardLogging = WithLogger(NewDefaultLogger(io.Discard, "", 0, LogLevelDebug))
+
+func TestCoalescing_SharesConcurrentGets(t *testing.T) {
+	fake := newGatedClient(`{"duration":"1ms"}`)
+	c := newTestClient(t, fake, discardLogging, WithRequestCoalescing())
+
+	chat := c.Chat()
+	const n = 20
+	results := make([]*StreamResponse[ChannelStateResponse], n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx := ContextWithRequestID(context.Background(), fmt.Sprintf("req-%d", i))
+			res, err := chat.GetChannel(ctx, "messaging", "viral", &GetChannelRequest{})
+			require.NoError(t, err)
+			results[i] = res
+		}(i)
+	}
+	<-fake.started
+	// Let the other callers join the in-flight call before it completes.
+	require.Eventually(t, func() bool {
+		c.coalescer.mu.Lock()
+		defer c.coalescer.mu.Unlock()
+		for _, f := range c.coalescer.calls {
+			return f.waiters == n
+		}
+		return false
+	}, time.Second, time.Millisecond)
+	close(fake.release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&fake.calls))
+	for i, res := range results {
+		require.Equal(t, "1ms", res.Data.Duration)
+		require.Equal(t, fmt.Sprintf("req-%d", i), res.RequestID, "every caller gets its own request ID")
+	}
+}
+
+func TestCoalescing_DistinctKeysNotShared(t *testing.T) {
+	fake := newGatedClient(`{}`)
+	close(fake.release)
+	c := newTestClient(t, fake, discardLogging, WithRequestCoalescing())
+
+	_, err := c.Chat().GetChannel(context.Background(), "messaging", "a", &GetChannelRequest{})
+	require.NoError(t, err)
+	_, err = c.Chat().GetChannel(context.Background(), "messaging", "b", &GetChannelRequest{})
+	require.NoError(t, err)
+	_, err = c.Chat().GetChannel(context.Background(), "messaging", "a", &GetChannelRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), atomic.LoadInt32(&fake.calls), "sequential calls are never coalesced")
+}
+
+func TestCoalescing_CallerCancellation(t *testing.T) {
+	fake := newGatedClient(`{}`)
+	c := newTestClient(t, fake, discardLogging, WithRequestCoalescing())
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := c.GetApp(leaderCtx, &GetAppRequest{})
+		leaderErr <- err
+	}()
+	<-fake.started
+
+	followerRes := make(chan error, 1)
+	go func() {
+		_, err := c.GetApp(context.Background(), &GetAppRequest{})
+		followerRes <- err
+	}()
+	require.Eventually(t, func() bool {
+		c.coalescer.mu.Lock()
+		defer c.coalescer.mu.Unlock()
+		for _, f := range c.coalescer.calls {
+			return f.waiters == 2
+		}
+		return false
+	}, time.Second, time.Millisecond)
+
+	cancelLeader()
+	err := <-leaderErr
+	require.True(t, errors.Is(err, ErrTransport))
+	require.True(t, errors.Is(err, context.Canceled))
+
+	// The follower is unaffected by the leader giving up.
+	close(fake.release)
+	require.NoError(t, <-followerRes)
+	require.Equal(t, int32(1), atomic.LoadInt32(&fake.calls))
+}
+
+func TestCoalescing_LastWaiterCancelsCall(t *testing.T) {
+	fake := newGatedClient(`{}`)
+	c := newTestClient(t, fake, discardLogging, WithRequestCoalescing())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.GetApp(ctx, &GetAppRequest{})
+		done <- err
+	}()
+	<-fake.started
+	cancel()
+	require.Error(t, <-done)
+
+	// A new call starts a fresh request instead of joining the abandoned one.
+	close(fake.release)
+	_, err := c.GetApp(context.Background(), &GetAppRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&fake.calls))
+}
+
+func TestCoalescing_SharedCallKeepsStarterTimeout(t *testing.T) {
+	fake := newGatedClient(`{}`)
+	c := newTestClient(t, fake, discardLogging, WithRequestCoalescing())
+
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := c.GetApp(context.Background(), &GetAppRequest{}, RequestTimeout(200*time.Millisecond))
+		leaderErr <- err
+	}()
+	<-fake.started
+
+	followerErr := make(chan error, 1)
+	go func() {
+		_, err := c.GetApp(context.Background(), &GetAppRequest{})
+		followerErr <- err
+	}()
+	require.Eventually(t, func() bool {
+		c.coalescer.mu.Lock()
+		defer c.coalescer.mu.Unlock()
+		for _, f := range c.coalescer.calls {
+			return f.waiters == 2
+		}
+		return false
+	}, 150*time.Millisecond, time.Millisecond)
+
+	require.True(t, errors.Is(<-leaderErr, context.DeadlineExceeded))
+	select {
+	case err := <-followerErr:
+		// The shared call itself ended at the starter's timeout.
+		require.True(t, errors.Is(err, context.DeadlineExceeded))
+	case <-time.After(time.Second):
+		t.Fatal("the shared call outlived the starter's RequestTimeout")
+	}
+	close(fake.release)
+}
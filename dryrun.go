@@ -0,0 +1,130 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DryRunConfig is the opt-in dry-run mode, for checking what a backfill or
+// cleanup script (DeleteUsers, TruncateChannel, DeleteChannels,
+// DeleteActivities, ...) would do before running it for real. Disabled by
+// default.
+//
+// In dry-run mode every call other than GET and HEAD is built exactly as it
+// would be sent, URL, query, headers and JSON or multipart body included,
+// then logged and handed to Sink instead of being sent. The call returns a
+// nil response and a *StreamError with ErrDryRun. Reads still go to the API,
+// so a script can compute its plan against real data:
+//
+//	client, _ := getstream.NewClientFromEnvVars(getstream.WithDryRun(getstream.DryRunConfig{
+//		Enabled: *dryRun,
+//		Sink: func(ctx context.Context, req *getstream.DryRunRequest) {
+//			fmt.Println(req.Operation.Name(), string(req.Body))
+//		},
+//	}))
+//	_, err := client.DeleteUsers(ctx, req)
+//	if err != nil && !errors.Is(err, getstream.ErrDryRun) {
+//		return err
+//	}
+type DryRunConfig struct {
+	// Enabled turns dry-run mode on. Default false.
+	Enabled bool
+	// Sink receives each request that was built but not sent. Optional;
+	// requests are always logged as http.request.dry_run events at INFO.
+	Sink func(ctx context.Context, req *DryRunRequest)
+	// Send reports whether a non-GET call should be sent anyway, e.g. the
+	// POST query endpoints such as QueryChannels or QueryActivities that a
+	// script reads from. Default none.
+	Send func(op *Operation) bool
+}
+
+// DryRunRequest is a request built but not sent in dry-run mode.
+// Credentials are redacted as in request logging.
+type DryRunRequest struct {
+	// Operation is the call, as interceptors see it.
+	Operation *Operation
+	// URL is the full request URL, api_key redacted.
+	URL string
+	// Header is the request header, Authorization redacted.
+	Header http.Header
+	// Body is the encoded request body: JSON with secret top-level keys
+	// redacted, or the multipart form of an upload. nil when the call sends
+	// no body.
+	Body []byte
+}
+
+// WithDryRun enables the opt-in dry-run mode.
+func WithDryRun(cfg DryRunConfig) ClientOption {
+	return func(c *Client) {
+		if !cfg.Enabled {
+			c.dryRun = nil
+			return
+		}
+		c.dryRun = &cfg
+	}
+}
+
+// skips reports whether op is held back by dry-run mode.
+func (cfg *DryRunConfig) skips(op *Operation) bool {
+	if cfg == nil || op.Method == http.MethodGet || op.Method == http.MethodHead {
+		return false
+	}
+	return cfg.Send == nil || !cfg.Send(op)
+}
+
+// dryRun builds the request for op as makeRequestOnce would and reports it
+// instead of sending it.
+func dryRun[GRequest any](c *Client, ctx context.Context, op *Operation, data *GRequest) error {
+	r, err := newRequest(c, ctx, c.BaseUrl(), op.Method, op.Path, op.Query, data, op.PathParams)
+	if err != nil {
+		return err
+	}
+	for k, vs := range op.Header {
+		r.Header[k] = vs
+	}
+	var body []byte
+	if r.Body != nil {
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return stackWrap(err, "dry run: failed to build request body")
+		}
+	}
+
+	header := r.Header.Clone()
+	if header.Get("Authorization") != "" {
+		header.Set("Authorization", "<redacted>")
+	}
+	u := *r.URL
+	u.RawQuery = redactQuery(r.URL.Query())
+	isJSON := strings.HasPrefix(r.Header.Get("Content-Type"), "application/json")
+	if isJSON && body != nil {
+		body = []byte(redactJSONBody(body))
+	}
+	req := &DryRunRequest{Operation: op, URL: u.String(), Header: header, Body: body}
+
+	fields := []LogField{
+		{"http.request.method", op.Method},
+		{"url.path", op.Path},
+		{"url.query", u.RawQuery},
+		{"http.request.body.size", len(body)},
+	}
+	if c.logBodies && isJSON && body != nil {
+		fields = append(fields, LogField{"http.request.body", string(body)})
+	}
+	c.logEvent(ctx, LogLevelInfo, "http.request.dry_run", fields...)
+	if c.dryRun.Sink != nil {
+		c.dryRun.Sink(ctx, req)
+	}
+
+	msg := fmt.Sprintf("stream: dry run, %s %s not sent", op.Method, r.URL.Path)
+	return &StreamError{
+		sentinel: ErrDryRun,
+		Message:  msg,
+		cause:    stackWrap(errors.New(msg), "dry run"),
+	}
+}
@@ -0,0 +1,82 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun_MutationsBuiltNotSent(t *testing.T) {
+	api := &countingAPI{}
+	logs := &recordingStructuredLogger{}
+	var sunk []*DryRunRequest
+	c, err := NewClient("key", "secret", WithHTTPClient(api), WithStructuredLogger(logs), WithLogBodies(true), WithDryRun(DryRunConfig{
+		Enabled: true,
+		Sink:    func(ctx context.Context, req *DryRunRequest) { sunk = append(sunk, req) },
+	}))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Reads still execute.
+	id, err := queryUserIDs(t, c, map[string]any{"id": "a"})
+	require.NoError(t, err)
+	require.Equal(t, "call-1", id)
+
+	resp, err := c.DeleteUsers(ctx, &DeleteUsersRequest{UserIds: []string{"john", "jane"}, Calls: PtrTo("hard")})
+	require.Nil(t, resp)
+	require.True(t, errors.Is(err, ErrDryRun), "got %v", err)
+	var se *StreamError
+	require.True(t, errors.As(err, &se))
+	require.Equal(t, "stream: dry run, POST /api/v2/users/delete not sent", se.Message)
+
+	require.Len(t, sunk, 1)
+	req := sunk[0]
+	require.Equal(t, "POST /api/v2/users/delete", req.Operation.Name())
+	require.Equal(t, "https://chat.stream-io-api.com/api/v2/users/delete?api_key=%3Credacted%3E", req.URL)
+	require.Equal(t, "<redacted>", req.Header.Get("Authorization"))
+	require.JSONEq(t, `{"user_ids":["john","jane"],"calls":"hard"}`, string(req.Body))
+
+	ev := logs.find("http.request.dry_run")
+	require.NotNil(t, ev)
+	require.Equal(t, LogLevelInfo, ev.level)
+	require.Equal(t, "/api/v2/users/delete", ev.fields["url.path"])
+	require.JSONEq(t, string(req.Body), ev.fields["http.request.body"].(string))
+
+	// Uploads are built as multipart forms.
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o644))
+	_, err = c.UploadFile(ctx, &UploadFileRequest{File: PtrTo(path)})
+	require.True(t, errors.Is(err, ErrDryRun))
+	require.Len(t, sunk, 2)
+	require.Contains(t, sunk[1].Header.Get("Content-Type"), "multipart/form-data")
+	require.Contains(t, string(sunk[1].Body), "hello")
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&api.calls), "only the GET was sent")
+}
+
+func TestDryRun_SendAndDisabled(t *testing.T) {
+	api := &countingAPI{}
+	c, err := NewClient("key", "secret", WithHTTPClient(api), WithDryRun(DryRunConfig{
+		Enabled: true,
+		Send:    func(op *Operation) bool { return op.Path == "/api/v2/users" },
+	}))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = c.UpdateUsers(ctx, &UpdateUsersRequest{Users: map[string]UserRequest{"john": {ID: "john"}}})
+	require.NoError(t, err)
+	_, err = c.DeleteUsers(ctx, &DeleteUsersRequest{UserIds: []string{"john"}})
+	require.True(t, errors.Is(err, ErrDryRun))
+	require.Equal(t, int32(1), atomic.LoadInt32(&api.calls))
+
+	c, err = NewClient("key", "secret", WithHTTPClient(api), WithDryRun(DryRunConfig{}))
+	require.NoError(t, err)
+	_, err = c.DeleteUsers(ctx, &DeleteUsersRequest{UserIds: []string{"john"}})
+	require.False(t, errors.Is(err, ErrDryRun))
+	require.Equal(t, int32(2), atomic.LoadInt32(&api.calls))
+}
@@ -36,6 +36,43 @@ var (
 	// ErrTaskFailed fires when WaitForTask observes status=="failed".
 	// StreamError.Task carries the task's ErrorResult.
 	ErrTaskFailed = errors.New("stream: task failed")
+
+	// ErrCircuitOpen fires when the client-side circuit breaker (see
+	// WithCircuitBreaker) rejected a request without sending it because
+	// the endpoint kept failing. StreamError.RetryAfter carries the time
+	// until the circuit lets a probe request through.
+	ErrCircuitOpen = errors.New("stream: circuit open")
+
+	// ErrUploadRejected fires when client-side upload validation (see
+	// WithUploadValidation) refused a file before sending it because it
+	// breaks the app's file or image upload config.
+	ErrUploadRejected = errors.New("stream: upload rejected")
+
+	// ErrDryRun fires when a call was built but not sent because the client
+	// is in dry-run mode (see WithDryRun).
+	ErrDryRun = errors.New("stream: dry run")
+
+	// ErrTokenInvalid fires when Stream.ParseToken or Stream.VerifyToken
+	// rejected a user token. Errors carrying the more specific
+	// ErrTokenMalformed, ErrTokenSignatureInvalid, ErrTokenExpired or
+	// ErrTokenRevoked also return true for errors.Is(err, ErrTokenInvalid).
+	ErrTokenInvalid = errors.New("stream: invalid token")
+
+	// ErrTokenMalformed fires when a token is not a well-formed Stream
+	// user JWT (bad encoding, or no user_id claim).
+	ErrTokenMalformed = errors.New("stream: malformed token")
+
+	// ErrTokenSignatureInvalid fires when a token is not signed with HS256
+	// and one of the app's secrets, i.e. it was not minted by this app.
+	ErrTokenSignatureInvalid = errors.New("stream: token signature invalid")
+
+	// ErrTokenExpired fires when a correctly signed token is past its exp.
+	ErrTokenExpired = errors.New("stream: token expired")
+
+	// ErrTokenRevoked fires when a correctly signed token was issued before
+	// the app's or user's RevokeTokensIssuedBefore (see
+	// WithTokenRevocationCheck).
+	ErrTokenRevoked = errors.New("stream: token revoked")
 )
 
 // Transport-error subtype values populated on StreamError.ErrorType when the
@@ -0,0 +1,576 @@
+package getstream
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Export formats accepted by ExportChannelsRequest.Format.
+const (
+	ExportFormatJSON = "json"
+	ExportFormatCSV  = "csv"
+)
+
+// ExportRecordKind identifies the entity an ExportRecord holds.
+type ExportRecordKind string
+
+const (
+	ExportRecordChannel  ExportRecordKind = "channel"
+	ExportRecordMessage  ExportRecordKind = "message"
+	ExportRecordUser     ExportRecordKind = "user"
+	ExportRecordReaction ExportRecordKind = "reaction"
+	// ExportRecordUnknown marks a record the reader could not attribute to
+	// one of the kinds above. Only Raw (or Fields, for CSV) is set.
+	ExportRecordUnknown ExportRecordKind = ""
+)
+
+// exportCollections maps the keys of an export document to the kind of the
+// records stored under them. Plural keys hold arrays, singular keys a single
+// object.
+var exportCollections = map[string]ExportRecordKind{
+	"channels":  ExportRecordChannel,
+	"channel":   ExportRecordChannel,
+	"messages":  ExportRecordMessage,
+	"message":   ExportRecordMessage,
+	"users":     ExportRecordUser,
+	"user":      ExportRecordUser,
+	"reactions": ExportRecordReaction,
+	"reaction":  ExportRecordReaction,
+}
+
+// ExportRecord is one entity read from an export file. Exactly one of
+// Channel, Message, User and Reaction is set, matching Kind.
+type ExportRecord struct {
+	Kind     ExportRecordKind
+	Channel  *ChannelResponse
+	Message  *MessageResponse
+	User     *UserResponse
+	Reaction *ReactionResponse
+	// Raw is the record as it appeared in a JSON export, including any
+	// fields the typed models don't cover.
+	Raw json.RawMessage
+	// Fields holds the columns of a CSV export row, keyed by header name.
+	Fields map[string]string
+}
+
+// ExportReader decodes an export file record by record, so exports of any
+// size can be processed without holding them in memory. Gzipped files are
+// decompressed transparently and the format (JSON, NDJSON or CSV) is
+// detected from the content.
+//
+// JSON documents are read as objects whose "channels", "messages", "users"
+// and "reactions" arrays (or singular "channel", "message", "user" and
+// "reaction" objects) hold the records; NDJSON files are any sequence of
+// such objects. Objects without any of these keys, and the elements of a
+// top-level array, are yielded as ExportRecordUnknown. The kind of CSV rows
+// is inferred from the header: columns only one kind has (e.g. "text" for
+// messages, "score" for reactions, "member_count" for channels, "role" for
+// users) pick it, and the columns map onto its model by JSON name, with
+// dotted headers (e.g. "user.id") addressing nested fields. A header that
+// matches no kind, or several, yields ExportRecordUnknown rows with only
+// Fields set.
+//
+// An ExportReader is not safe for concurrent use.
+//
+//	r, err := getstream.DownloadExport(ctx, client, resp.Data.TaskID)
+//	if err != nil {
+//		...
+//	}
+//	defer r.Close()
+//	for r.Next() {
+//		rec := r.Record()
+//		...
+//	}
+//	if err := r.Err(); err != nil {
+//		...
+//	}
+type ExportReader struct {
+	closer io.Closer
+	next   func() (ExportRecord, error)
+
+	record ExportRecord
+	err    error
+	done   bool
+}
+
+// NewExportReader returns an ExportReader over r. If r is an io.Closer,
+// Close closes it.
+func NewExportReader(r io.Reader) (*ExportReader, error) {
+	er := &ExportReader{}
+	if c, ok := r.(io.Closer); ok {
+		er.closer = c
+	}
+
+	br := bufio.NewReader(r)
+	if head, err := br.Peek(2); err == nil && head[0] == 0x1f && head[1] == 0x8b {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, stackWrap(err, "failed to open gzipped export")
+		}
+		br = bufio.NewReader(zr)
+	}
+
+	if exportIsJSON(br) {
+		er.next = newExportJSONDecoder(br).next
+	} else {
+		er.next = newExportCSVDecoder(br).next
+	}
+	return er, nil
+}
+
+// exportIsJSON reports whether the first non-blank byte opens a JSON value.
+// CSV headers are plain column names, so an object or array rules them out.
+func exportIsJSON(br *bufio.Reader) bool {
+	for n := 1; ; n++ {
+		head, err := br.Peek(n)
+		if len(head) < n {
+			return err == io.EOF
+		}
+		switch b := head[n-1]; b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		default:
+			return b == '{' || b == '['
+		}
+	}
+}
+
+// Next advances to the next record. It returns false at the end of the file
+// or on error; check Err to tell the two apart.
+func (r *ExportReader) Next() bool {
+	if r.done || r.err != nil {
+		return false
+	}
+	rec, err := r.next()
+	if err == io.EOF {
+		r.done = true
+		r.record = ExportRecord{}
+		return false
+	}
+	if err != nil {
+		r.err = err
+		r.record = ExportRecord{}
+		return false
+	}
+	r.record = rec
+	return true
+}
+
+// Record returns the current record. Only valid after Next returned true.
+func (r *ExportReader) Record() ExportRecord {
+	return r.record
+}
+
+// Err returns the error that stopped iteration, if any.
+func (r *ExportReader) Err() error {
+	return r.err
+}
+
+// Close releases the underlying file or download.
+func (r *ExportReader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
+
+// DownloadExport waits for an export task (as returned by
+// ChatClient.ExportChannels, Client.ExportUsers or
+// FeedsClient.ExportFeedUserData) to complete, then opens its result file
+// with Client.OpenExport. opts configure the wait as for WaitForTask.
+//
+// Client.ExportUser has no result file: its messages, reactions and user
+// are returned inline in ExportUserResponse.
+func DownloadExport(ctx context.Context, client *Stream, taskID string, opts ...WaitForTaskOption) (*ExportReader, error) {
+	task, err := WaitForTask(ctx, client, taskID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	url, _ := task.Data.Result["url"].(string)
+	if url == "" {
+		return nil, stackWrap(fmt.Errorf("task %s completed without a result url", taskID), "export download")
+	}
+	return client.OpenExport(ctx, url)
+}
+
+// OpenExport downloads the export file at url and returns a reader over its
+// records. The download is streamed as the reader advances and is bounded
+// by ctx rather than the client's request timeout; Close the reader to
+// release the connection.
+//
+// The request is sent with the client's HttpClient but without Stream
+// credentials, as export URLs are pre-signed.
+func (c *Client) OpenExport(ctx context.Context, url string) (*ExportReader, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, stackWrap(err, "failed to create export request")
+	}
+	resp, err := c.exportHTTPClient().Do(req)
+	if err != nil {
+		return nil, wrapTransportError(err)
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		return nil, buildAPIError(resp, body)
+	}
+
+	r, err := NewExportReader(exportBody{resp.Body})
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// exportHTTPClient returns the client's HttpClient without the overall
+// request timeout of the default *http.Client, which would otherwise cut
+// long downloads short while the caller is still reading.
+func (c *Client) exportHTTPClient() HttpClient {
+	if hc, ok := c.httpClient.(*http.Client); ok && hc.Timeout > 0 {
+		cp := *hc
+		cp.Timeout = 0
+		return &cp
+	}
+	return c.httpClient
+}
+
+// exportBody surfaces read failures of a download as transport errors.
+type exportBody struct {
+	io.ReadCloser
+}
+
+func (b exportBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		return n, wrapTransportError(err)
+	}
+	return n, err
+}
+
+// exportJSONDecoder walks JSON and NDJSON exports token by token, decoding
+// one record at a time.
+type exportJSONDecoder struct {
+	dec *json.Decoder
+
+	inObject  bool
+	inArray   bool
+	arrayKind ExportRecordKind
+	matched   bool
+	leftover  map[string]json.RawMessage
+}
+
+func newExportJSONDecoder(r io.Reader) *exportJSONDecoder {
+	return &exportJSONDecoder{dec: json.NewDecoder(r)}
+}
+
+func (d *exportJSONDecoder) next() (ExportRecord, error) {
+	for {
+		if d.inArray {
+			if d.dec.More() {
+				var raw json.RawMessage
+				if err := d.dec.Decode(&raw); err != nil {
+					return ExportRecord{}, exportDecodeError(err)
+				}
+				return newExportRecord(d.arrayKind, raw)
+			}
+			if _, err := d.dec.Token(); err != nil {
+				return ExportRecord{}, exportDecodeError(err)
+			}
+			d.inArray = false
+			continue
+		}
+
+		tok, err := d.dec.Token()
+		if err == io.EOF && !d.inObject {
+			return ExportRecord{}, io.EOF
+		}
+		if err != nil {
+			return ExportRecord{}, exportDecodeError(err)
+		}
+
+		if !d.inObject {
+			switch tok {
+			case json.Delim('{'):
+				d.inObject, d.matched, d.leftover = true, false, map[string]json.RawMessage{}
+			case json.Delim('['):
+				d.inArray, d.arrayKind = true, ExportRecordUnknown
+			default:
+				return ExportRecord{}, exportDecodeError(fmt.Errorf("unexpected %v at top level", tok))
+			}
+			continue
+		}
+
+		if tok == json.Delim('}') {
+			d.inObject = false
+			if d.matched || len(d.leftover) == 0 {
+				continue
+			}
+			raw, err := json.Marshal(d.leftover)
+			if err != nil {
+				return ExportRecord{}, exportDecodeError(err)
+			}
+			return ExportRecord{Kind: ExportRecordUnknown, Raw: raw}, nil
+		}
+
+		key, _ := tok.(string)
+		kind, ok := exportCollections[key]
+		if !ok {
+			var raw json.RawMessage
+			if err := d.dec.Decode(&raw); err != nil {
+				return ExportRecord{}, exportDecodeError(err)
+			}
+			if !d.matched {
+				d.leftover[key] = raw
+			}
+			continue
+		}
+		d.matched, d.leftover = true, nil
+
+		if strings.HasSuffix(key, "s") {
+			tok, err := d.dec.Token()
+			if err != nil {
+				return ExportRecord{}, exportDecodeError(err)
+			}
+			switch tok {
+			case json.Delim('['):
+				d.inArray, d.arrayKind = true, kind
+			case nil:
+			default:
+				return ExportRecord{}, exportDecodeError(fmt.Errorf("expected an array for %q", key))
+			}
+			continue
+		}
+
+		var raw json.RawMessage
+		if err := d.dec.Decode(&raw); err != nil {
+			return ExportRecord{}, exportDecodeError(err)
+		}
+		if string(raw) == "null" {
+			continue
+		}
+		return newExportRecord(kind, raw)
+	}
+}
+
+// newExportRecord decodes raw into the typed model for kind.
+func newExportRecord(kind ExportRecordKind, raw json.RawMessage) (ExportRecord, error) {
+	rec := ExportRecord{Kind: kind, Raw: raw}
+	var target any
+	switch kind {
+	case ExportRecordChannel:
+		rec.Channel = &ChannelResponse{}
+		target = rec.Channel
+	case ExportRecordMessage:
+		rec.Message = &MessageResponse{}
+		target = rec.Message
+	case ExportRecordUser:
+		rec.User = &UserResponse{}
+		target = rec.User
+	case ExportRecordReaction:
+		rec.Reaction = &ReactionResponse{}
+		target = rec.Reaction
+	default:
+		return rec, nil
+	}
+	if err := decodeExportJSON(raw, target); err != nil {
+		return ExportRecord{}, stackWrap(err, fmt.Sprintf("failed to decode exported %s", kind))
+	}
+	return rec, nil
+}
+
+// decodeExportJSON unmarshals raw into v. Export files carry RFC 3339
+// timestamps where the API returns nanoseconds, so on failure the
+// timestamps are converted and the decode retried.
+func decodeExportJSON(raw []byte, v any) error {
+	err := json.Unmarshal(raw, v)
+	if err == nil {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var doc any
+	if dec.Decode(&doc) != nil {
+		return err
+	}
+	fixed, mErr := json.Marshal(exportTimestampsToNanos(doc, ""))
+	if mErr != nil {
+		return err
+	}
+	return json.Unmarshal(fixed, v)
+}
+
+// exportTimestampsToNanos rewrites RFC 3339 strings under timestamp keys
+// ("*_at", "last_active") into Unix nanoseconds, recursively.
+func exportTimestampsToNanos(v any, key string) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			t[k] = exportTimestampsToNanos(val, k)
+		}
+	case []any:
+		for i, val := range t {
+			t[i] = exportTimestampsToNanos(val, key)
+		}
+	case string:
+		if strings.HasSuffix(key, "_at") || key == "last_active" {
+			if ts, err := time.Parse(time.RFC3339Nano, t); err == nil {
+				return json.Number(strconv.FormatInt(ts.UnixNano(), 10))
+			}
+		}
+	}
+	return v
+}
+
+func exportDecodeError(err error) error {
+	var se *StreamError
+	if errors.As(err, &se) {
+		return err
+	}
+	return stackWrap(err, "failed to decode export")
+}
+
+// exportCSVColumns are the columns that identify the kind of a CSV export:
+// top-level JSON names only that kind's model has.
+var exportCSVColumns = map[string]ExportRecordKind{
+	"text":              ExportRecordMessage,
+	"html":              ExportRecordMessage,
+	"mml":               ExportRecordMessage,
+	"parent_id":         ExportRecordMessage,
+	"reply_count":       ExportRecordMessage,
+	"quoted_message_id": ExportRecordMessage,
+	"message_id":        ExportRecordReaction,
+	"score":             ExportRecordReaction,
+	"member_count":      ExportRecordChannel,
+	"message_count":     ExportRecordChannel,
+	"frozen":            ExportRecordChannel,
+	"last_message_at":   ExportRecordChannel,
+	"role":              ExportRecordUser,
+	"banned":            ExportRecordUser,
+	"shadow_banned":     ExportRecordUser,
+	"online":            ExportRecordUser,
+	"last_active":       ExportRecordUser,
+}
+
+// exportCSVKind returns the kind the header's identifying columns agree on,
+// or ExportRecordUnknown if they name none or several.
+func exportCSVKind(header []string) ExportRecordKind {
+	kind := ExportRecordUnknown
+	for _, name := range header {
+		k, ok := exportCSVColumns[name]
+		if !ok {
+			continue
+		}
+		if kind != ExportRecordUnknown && kind != k {
+			return ExportRecordUnknown
+		}
+		kind = k
+	}
+	return kind
+}
+
+// exportCSVDecoder reads CSV exports, yielding one record per row of the
+// kind inferred from the header.
+type exportCSVDecoder struct {
+	r      *csv.Reader
+	header []string
+	kind   ExportRecordKind
+}
+
+func newExportCSVDecoder(r io.Reader) *exportCSVDecoder {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	return &exportCSVDecoder{r: cr}
+}
+
+func (d *exportCSVDecoder) next() (ExportRecord, error) {
+	if d.header == nil {
+		header, err := d.r.Read()
+		if err == io.EOF {
+			return ExportRecord{}, io.EOF
+		}
+		if err != nil {
+			return ExportRecord{}, exportDecodeError(err)
+		}
+		if len(header) > 0 {
+			header[0] = strings.TrimPrefix(header[0], "\ufeff")
+		}
+		d.header = header
+		d.kind = exportCSVKind(header)
+	}
+
+	row, err := d.r.Read()
+	if err == io.EOF {
+		return ExportRecord{}, io.EOF
+	}
+	if err != nil {
+		return ExportRecord{}, exportDecodeError(err)
+	}
+
+	rec := ExportRecord{Kind: d.kind, Fields: make(map[string]string, len(d.header))}
+	var model any
+	switch d.kind {
+	case ExportRecordChannel:
+		rec.Channel = &ChannelResponse{}
+		model = rec.Channel
+	case ExportRecordMessage:
+		rec.Message = &MessageResponse{}
+		model = rec.Message
+	case ExportRecordUser:
+		rec.User = &UserResponse{}
+		model = rec.User
+	case ExportRecordReaction:
+		rec.Reaction = &ReactionResponse{}
+		model = rec.Reaction
+	}
+	for i, name := range d.header {
+		if i >= len(row) {
+			break
+		}
+		rec.Fields[name] = row[i]
+		if model != nil && row[i] != "" {
+			setExportColumn(model, name, row[i])
+		}
+	}
+	return rec, nil
+}
+
+// setExportColumn assigns a CSV cell to the field of v with the column's
+// JSON name. The cell is tried as a JSON literal, then as a string, then as
+// an RFC 3339 timestamp; cells that fit none of them are left to
+// ExportRecord.Fields.
+func setExportColumn(v any, column, cell string) {
+	path := strings.Split(column, ".")
+	var candidates []any
+	if json.Valid([]byte(cell)) {
+		candidates = append(candidates, json.RawMessage(cell))
+	}
+	candidates = append(candidates, cell)
+	if ts, err := time.Parse(time.RFC3339Nano, cell); err == nil {
+		candidates = append(candidates, ts.UnixNano())
+	}
+
+	for _, value := range candidates {
+		for i := len(path) - 1; i >= 0; i-- {
+			value = map[string]any{path[i]: value}
+		}
+		b, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		if json.Unmarshal(b, v) == nil {
+			return
+		}
+	}
+}
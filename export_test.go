@@ -0,0 +1,192 @@
+package getstream
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readExport(t *testing.T, r *ExportReader) []ExportRecord {
+	t.Helper()
+	var out []ExportRecord
+	for r.Next() {
+		out = append(out, r.Record())
+	}
+	require.NoError(t, r.Err())
+	return out
+}
+
+func gzipBytes(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestExportReader_JSONDocument(t *testing.T) {
+	doc := `{
+		"duration": "1ms",
+		"channels": [{"cid": "messaging:a", "id": "a", "type": "messaging", "created_at": "2024-01-02T03:04:05Z"}],
+		"messages": [
+			{"id": "m1", "cid": "messaging:a", "text": "hi", "created_at": "2024-01-02T03:04:05.5Z", "user": {"id": "u1"}},
+			{"id": "m2", "cid": "messaging:a", "text": "yo"}
+		],
+		"reactions": null,
+		"user": {"id": "u1", "name": "Ann"}
+	}`
+	r, err := NewExportReader(strings.NewReader(doc))
+	require.NoError(t, err)
+	recs := readExport(t, r)
+	require.Len(t, recs, 4)
+
+	require.Equal(t, ExportRecordChannel, recs[0].Kind)
+	require.Equal(t, "a", recs[0].Channel.ID)
+	require.NotNil(t, recs[0].Channel.CreatedAt.Time)
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), *recs[0].Channel.CreatedAt.Time)
+
+	require.Equal(t, ExportRecordMessage, recs[1].Kind)
+	require.Equal(t, "hi", recs[1].Message.Text)
+	require.Equal(t, "u1", recs[1].Message.User.ID)
+	require.Equal(t, 500*time.Millisecond, time.Duration(recs[1].Message.CreatedAt.Time.Nanosecond()))
+	require.Contains(t, string(recs[1].Raw), `"text": "hi"`)
+	require.Equal(t, "m2", recs[2].Message.ID)
+
+	require.Equal(t, ExportRecordUser, recs[3].Kind)
+	require.Equal(t, "Ann", *recs[3].User.Name)
+}
+
+func TestExportReader_GzippedNDJSON(t *testing.T) {
+	lines := `{"channel": {"cid": "messaging:a", "id": "a", "type": "messaging"}}
+{"messages": [{"id": "m1", "text": "hi"}]}
+{"reaction": {"type": "like", "message_id": "m1", "user_id": "u1"}}
+{"something": "else"}
+`
+	r, err := NewExportReader(bytes.NewReader(gzipBytes(t, lines)))
+	require.NoError(t, err)
+	recs := readExport(t, r)
+	require.Len(t, recs, 4)
+	require.Equal(t, ExportRecordChannel, recs[0].Kind)
+	require.Equal(t, ExportRecordMessage, recs[1].Kind)
+	require.Equal(t, ExportRecordReaction, recs[2].Kind)
+	require.Equal(t, "like", recs[2].Reaction.Type)
+	require.Equal(t, ExportRecordUnknown, recs[3].Kind)
+	require.JSONEq(t, `{"something":"else"}`, string(recs[3].Raw))
+}
+
+func TestExportReader_CSV(t *testing.T) {
+	data := "id,text,user.id,created_at,reply_count,extra\n" +
+		"m1,\"hello, world\",u1,2024-01-02T03:04:05Z,2,x\n" +
+		"m2,42,u2,,,\n"
+	r, err := NewExportReader(bytes.NewReader(gzipBytes(t, data)))
+	require.NoError(t, err)
+	recs := readExport(t, r)
+	require.Len(t, recs, 2)
+
+	m := recs[0]
+	require.Equal(t, ExportRecordMessage, m.Kind)
+	require.Equal(t, "m1", m.Message.ID)
+	require.Equal(t, "hello, world", m.Message.Text)
+	require.Equal(t, "u1", m.Message.User.ID)
+	require.Equal(t, 2, m.Message.ReplyCount)
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), *m.Message.CreatedAt.Time)
+	require.Equal(t, "x", m.Fields["extra"])
+
+	require.Equal(t, "42", recs[1].Message.Text, "numeric-looking text stays a string")
+	require.Nil(t, recs[1].Message.CreatedAt.Time)
+}
+
+func TestExportReader_CSVKindFromHeader(t *testing.T) {
+	read := func(data string) ExportRecord {
+		r, err := NewExportReader(strings.NewReader(data))
+		require.NoError(t, err)
+		recs := readExport(t, r)
+		require.Len(t, recs, 1)
+		return recs[0]
+	}
+
+	u := read("id,name,role,banned\nu1,Jane,admin,true\n")
+	require.Equal(t, ExportRecordUser, u.Kind)
+	require.Equal(t, "admin", u.User.Role)
+	require.True(t, u.User.Banned)
+	require.Nil(t, u.Message)
+
+	re := read("message_id,type,score,user_id\nm1,like,3,u1\n")
+	require.Equal(t, ExportRecordReaction, re.Kind)
+	require.Equal(t, 3, re.Reaction.Score)
+
+	ch := read("cid,type,id,member_count\nmessaging:general,messaging,general,12\n")
+	require.Equal(t, ExportRecordChannel, ch.Kind)
+	require.Equal(t, "messaging:general", ch.Channel.Cid)
+
+	for _, header := range []string{"a,b", "text,role"} {
+		unknown := read(header + "\n1,2\n")
+		require.Equal(t, ExportRecordUnknown, unknown.Kind, header)
+		require.Nil(t, unknown.Message)
+		require.Equal(t, "2", unknown.Fields[strings.Split(header, ",")[1]])
+	}
+}
+
+func TestExportReader_MalformedJSON(t *testing.T) {
+	r, err := NewExportReader(strings.NewReader(`{"messages": [{"id": "m1"}, {"id": `))
+	require.NoError(t, err)
+	require.True(t, r.Next())
+	require.False(t, r.Next())
+	require.Error(t, r.Err())
+}
+
+// exportDownloadClient serves the task-status endpoint and the export file.
+type exportDownloadClient struct {
+	file      []byte
+	status    int
+	exportReq *http.Request
+}
+
+func (c *exportDownloadClient) Do(req *http.Request) (*http.Response, error) {
+	if strings.HasPrefix(req.URL.Path, "/api/v2/tasks/") {
+		body := `{"task_id":"t1","status":"completed","result":{"url":"https://files.example.com/export.json.gz?sig=abc"}}`
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}
+	c.exportReq = req
+	return &http.Response{StatusCode: c.status, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(c.file))}, nil
+}
+
+func TestDownloadExport(t *testing.T) {
+	fake := &exportDownloadClient{status: http.StatusOK, file: gzipBytes(t, `{"users": [{"id": "u1"}, {"id": "u2"}]}`)}
+	client, err := NewClient("k", "s", WithBaseUrl("https://api.example.com"), WithHTTPClient(fake))
+	require.NoError(t, err)
+
+	r, err := DownloadExport(context.Background(), client, "t1")
+	require.NoError(t, err)
+	defer r.Close()
+	recs := readExport(t, r)
+	require.Len(t, recs, 2)
+	require.Equal(t, "u2", recs[1].User.ID)
+
+	require.Equal(t, "files.example.com", fake.exportReq.URL.Host)
+	require.Equal(t, "abc", fake.exportReq.URL.Query().Get("sig"))
+	require.Empty(t, fake.exportReq.Header.Get("Authorization"), "pre-signed URLs must not carry Stream credentials")
+}
+
+func TestOpenExport_HTTPError(t *testing.T) {
+	fake := &exportDownloadClient{status: http.StatusForbidden, file: []byte("<Error>AccessDenied</Error>")}
+	client, err := NewClient("k", "s", WithBaseUrl("https://api.example.com"), WithHTTPClient(fake))
+	require.NoError(t, err)
+
+	_, err = client.OpenExport(context.Background(), "https://files.example.com/export.json")
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrApiResponse))
+	var se *StreamError
+	require.True(t, errors.As(err, &se))
+	require.Equal(t, http.StatusForbidden, se.StatusCode)
+}
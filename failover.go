@@ -0,0 +1,190 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultFailoverThreshold = 3
+	defaultFailoverCooldown  = time.Minute
+)
+
+// FailoverConfig routes requests across an ordered list of base URLs, e.g.
+// a primary region followed by disaster-recovery regions. Requests go to the
+// active endpoint, starting with the first. After FailureThreshold
+// consecutive transport errors (connection reset, timeout, DNS or TLS
+// failure) on it, the client switches to the next endpoint in the list. Once
+// Cooldown has passed since the switch, it fails back to the first endpoint;
+// a transport error right after failing back switches away again at once.
+//
+// Failover changes where later attempts go; it does not resend the failed
+// one. Combine it with WithRetry to have retried GETs land on the new
+// endpoint.
+type FailoverConfig struct {
+	// BaseURLs is the ordered endpoint list; the first is the primary.
+	BaseURLs []string
+	// FailureThreshold is the number of consecutive transport errors on the
+	// active endpoint that triggers a switch. Default 3.
+	FailureThreshold int
+	// Cooldown is how long the client stays on a secondary endpoint before
+	// failing back to the primary. Default 1m.
+	Cooldown time.Duration
+	// ErrorTypes restricts which transport errors count towards
+	// FailureThreshold (ErrorTypeTimeout, ...). Default: all except
+	// ErrorTypeUnknown.
+	ErrorTypes []string
+}
+
+// WithFailover enables multi-endpoint failover. It replaces the base URL set
+// by WithBaseUrl or STREAM_BASE_URL; Client.BaseUrl reports the endpoint in
+// use. Switches are logged as http.endpoint.failover (WARN) and
+// http.endpoint.failback (INFO) events.
+func WithFailover(cfg FailoverConfig) ClientOption {
+	return func(c *Client) {
+		if len(cfg.BaseURLs) == 0 {
+			c.failover = nil
+			return
+		}
+		if cfg.FailureThreshold <= 0 {
+			cfg.FailureThreshold = defaultFailoverThreshold
+		}
+		if cfg.Cooldown <= 0 {
+			cfg.Cooldown = defaultFailoverCooldown
+		}
+		if len(cfg.ErrorTypes) == 0 {
+			cfg.ErrorTypes = []string{ErrorTypeConnectionReset, ErrorTypeTimeout, ErrorTypeDNSFailure, ErrorTypeTLSHandshake}
+		}
+		cfg.BaseURLs = append([]string(nil), cfg.BaseURLs...)
+		c.baseUrl = cfg.BaseURLs[0]
+		c.failover = newFailover(cfg)
+	}
+}
+
+// failover tracks the health of the active endpoint.
+type failover struct {
+	cfg FailoverConfig
+	now func() time.Time
+
+	mu         sync.Mutex
+	active     int
+	failures   int
+	switchedAt time.Time
+	probation  bool // failed back to the primary and not yet confirmed healthy
+}
+
+type endpointSwitch struct {
+	from, to string
+	failback bool
+	failures int
+}
+
+func newFailover(cfg FailoverConfig) *failover {
+	return &failover{cfg: cfg, now: time.Now}
+}
+
+// endpoint returns the base URL to send the next request to, failing back to
+// the primary once the cooldown has passed.
+func (f *failover) endpoint() (string, *endpointSwitch) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.active == 0 || f.now().Sub(f.switchedAt) < f.cfg.Cooldown {
+		return f.cfg.BaseURLs[f.active], nil
+	}
+	s := &endpointSwitch{from: f.cfg.BaseURLs[f.active], to: f.cfg.BaseURLs[0], failback: true}
+	f.active, f.failures, f.probation = 0, 0, true
+	f.switchedAt = f.now()
+	return f.cfg.BaseURLs[0], s
+}
+
+// current returns the active base URL without failing back.
+func (f *failover) current() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.cfg.BaseURLs[f.active]
+}
+
+// observe records the outcome of a request sent to base. Outcomes for an
+// endpoint that is no longer active are ignored.
+func (f *failover) observe(base string, errorType string, failed bool) *endpointSwitch {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if base != f.cfg.BaseURLs[f.active] {
+		return nil
+	}
+	if !failed {
+		f.failures, f.probation = 0, false
+		return nil
+	}
+	if !f.countsTowardsFailover(errorType) {
+		return nil
+	}
+	f.failures++
+	if len(f.cfg.BaseURLs) < 2 || (!f.probation && f.failures < f.cfg.FailureThreshold) {
+		return nil
+	}
+	s := &endpointSwitch{from: base, failures: f.failures}
+	f.active = (f.active + 1) % len(f.cfg.BaseURLs)
+	f.failures, f.probation = 0, false
+	f.switchedAt = f.now()
+	s.to = f.cfg.BaseURLs[f.active]
+	return s
+}
+
+func (f *failover) countsTowardsFailover(errorType string) bool {
+	for _, t := range f.cfg.ErrorTypes {
+		if t == errorType {
+			return true
+		}
+	}
+	return false
+}
+
+// activeBaseURL returns the base URL for the next request, logging a
+// failback if the cooldown just ran out.
+func (c *Client) activeBaseURL(ctx context.Context) string {
+	if c.failover == nil {
+		return c.baseUrl
+	}
+	base, s := c.failover.endpoint()
+	c.logEndpointSwitch(ctx, s)
+	return base
+}
+
+// observeEndpoint feeds an attempt's outcome to the failover tracker. Errors
+// caused by the caller's ctx ending say nothing about the endpoint.
+func (c *Client) observeEndpoint(ctx context.Context, base string, err error) {
+	if c.failover == nil || ctx.Err() != nil {
+		return
+	}
+	var se *StreamError
+	if errors.Is(err, ErrTransport) && errors.As(err, &se) {
+		c.logEndpointSwitch(ctx, c.failover.observe(base, se.ErrorType, true))
+		return
+	}
+	if err == nil || errors.Is(err, ErrApiResponse) {
+		c.failover.observe(base, "", false)
+	}
+}
+
+func (c *Client) logEndpointSwitch(ctx context.Context, s *endpointSwitch) {
+	if s == nil {
+		return
+	}
+	if s.failback {
+		c.logEvent(ctx, LogLevelInfo, "http.endpoint.failback",
+			LogField{"endpoint.from", s.from},
+			LogField{"endpoint.to", s.to},
+		)
+		return
+	}
+	c.logEvent(ctx, LogLevelWarn, "http.endpoint.failover",
+		LogField{"endpoint.from", s.from},
+		LogField{"endpoint.to", s.to},
+		LogField{"consecutive_failures", s.failures},
+	)
+}
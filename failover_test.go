@@ -0,0 +1,106 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// hostRoutingClient fails requests to hosts in down with a connection reset
+// and answers 200 {} otherwise, recording the host of every request.
+type hostRoutingClient struct {
+	mu    sync.Mutex
+	down  map[string]bool
+	hosts []string
+}
+
+func (h *hostRoutingClient) Do(r *http.Request) (*http.Response, error) {
+	h.mu.Lock()
+	h.hosts = append(h.hosts, r.URL.Host)
+	down := h.down[r.URL.Host]
+	h.mu.Unlock()
+	if down {
+		return nil, syscall.ECONNRESET
+	}
+	return canned(200, `{}`, nil)()
+}
+
+var failoverTestURLs = []string{"https://primary.test", "https://secondary.test", "https://tertiary.test"}
+
+func TestFailover_SwitchesAfterThreshold(t *testing.T) {
+	fake := &hostRoutingClient{down: map[string]bool{"primary.test": true}}
+	rec := &recordingLogger{}
+	c := newTestClient(t, fake, WithLogger(rec), WithFailover(FailoverConfig{BaseURLs: failoverTestURLs, FailureThreshold: 2}))
+	require.Equal(t, "https://primary.test", c.BaseUrl())
+
+	for i := 0; i < 2; i++ {
+		_, err := c.GetApp(context.Background(), &GetAppRequest{})
+		require.True(t, errors.Is(err, ErrTransport))
+	}
+	require.Equal(t, "https://secondary.test", c.BaseUrl())
+	require.True(t, has(rec.warn, "http.endpoint.failover endpoint.from=https://primary.test endpoint.to=https://secondary.test consecutive_failures=2"), rec.warn)
+
+	_, err := c.GetApp(context.Background(), &GetAppRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"primary.test", "primary.test", "secondary.test"}, fake.hosts)
+}
+
+func TestFailover_APIErrorsDoNotSwitch(t *testing.T) {
+	fake := &oneShotClient{status: 500, body: `{"code":-1,"message":"boom"}`}
+	c := newTestClient(t, fake, WithFailover(FailoverConfig{BaseURLs: failoverTestURLs, FailureThreshold: 1}))
+
+	_, err := c.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrApiResponse))
+	require.Equal(t, "https://primary.test", c.BaseUrl())
+}
+
+func TestFailover_RetryLandsOnSecondary(t *testing.T) {
+	fake := &hostRoutingClient{down: map[string]bool{"primary.test": true}}
+	c := newTestClient(t, fake, WithFailover(FailoverConfig{BaseURLs: failoverTestURLs, FailureThreshold: 1}),
+		WithRetry(RetryConfig{Enabled: true, MaxAttempts: 2, MaxBackoff: time.Millisecond}))
+
+	_, err := c.GetApp(context.Background(), &GetAppRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"primary.test", "secondary.test"}, fake.hosts)
+}
+
+func TestFailover_FailsBackAfterCooldown(t *testing.T) {
+	fake := &hostRoutingClient{down: map[string]bool{"primary.test": true}}
+	rec := &recordingLogger{}
+	c := newTestClient(t, fake, WithLogger(rec), WithFailover(FailoverConfig{BaseURLs: failoverTestURLs, FailureThreshold: 1, Cooldown: time.Minute}))
+	now := stubClock(&c.failover.now)
+
+	_, _ = c.GetApp(context.Background(), &GetAppRequest{})
+	require.Equal(t, "https://secondary.test", c.BaseUrl())
+
+	// Still down after the cooldown: one failure switches away again.
+	*now = now.Add(time.Minute)
+	_, err := c.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrTransport))
+	require.True(t, has(rec.info, "http.endpoint.failback endpoint.from=https://secondary.test endpoint.to=https://primary.test"), rec.info)
+	require.Equal(t, "https://secondary.test", c.BaseUrl())
+
+	// Recovered: the client stays on the primary.
+	*now = now.Add(time.Minute)
+	fake.down = nil
+	_, err = c.GetApp(context.Background(), &GetAppRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "https://primary.test", c.BaseUrl())
+	require.Equal(t, []string{"primary.test", "primary.test", "primary.test"}, fake.hosts)
+}
+
+func TestFailover_IgnoresCallerCancellation(t *testing.T) {
+	fake := &hostRoutingClient{}
+	c := newTestClient(t, fake, WithFailover(FailoverConfig{BaseURLs: failoverTestURLs, FailureThreshold: 1}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.observeEndpoint(ctx, "https://primary.test", wrapTransportError(context.Canceled))
+	require.Equal(t, "https://primary.test", c.BaseUrl())
+}
@@ -16,16 +16,16 @@ func NewFeedsClient(client *Client) *FeedsClient {
 }
 
 // Create a new activity or update an existing one
-func (c *FeedsClient) AddActivity(ctx context.Context, request *AddActivityRequest) (*StreamResponse[AddActivityResponse], error) {
+func (c *FeedsClient) AddActivity(ctx context.Context, request *AddActivityRequest, opts ...RequestOption) (*StreamResponse[AddActivityResponse], error) {
 	var result AddActivityResponse
-	res, err := MakeRequest[AddActivityRequest, AddActivityResponse](c.client, ctx, "POST", "/api/v2/feeds/activities", nil, request, &result, nil)
+	res, err := MakeRequest[AddActivityRequest, AddActivityResponse](c.client, ctx, "POST", "/api/v2/feeds/activities", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Create new activities or update existing ones in a batch operation
-func (c *FeedsClient) UpsertActivities(ctx context.Context, request *UpsertActivitiesRequest) (*StreamResponse[UpsertActivitiesResponse], error) {
+func (c *FeedsClient) UpsertActivities(ctx context.Context, request *UpsertActivitiesRequest, opts ...RequestOption) (*StreamResponse[UpsertActivitiesResponse], error) {
 	var result UpsertActivitiesResponse
-	res, err := MakeRequest[UpsertActivitiesRequest, UpsertActivitiesResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/batch", nil, request, &result, nil)
+	res, err := MakeRequest[UpsertActivitiesRequest, UpsertActivitiesResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/batch", nil, request, &result, nil, opts...)
 	return res, err
 }
 
@@ -33,79 +33,79 @@ func (c *FeedsClient) UpsertActivities(ctx context.Context, request *UpsertActiv
 //
 // Sends events:
 // - feeds.activity.updated
-func (c *FeedsClient) UpdateActivitiesPartialBatch(ctx context.Context, request *UpdateActivitiesPartialBatchRequest) (*StreamResponse[UpdateActivitiesPartialBatchResponse], error) {
+func (c *FeedsClient) UpdateActivitiesPartialBatch(ctx context.Context, request *UpdateActivitiesPartialBatchRequest, opts ...RequestOption) (*StreamResponse[UpdateActivitiesPartialBatchResponse], error) {
 	var result UpdateActivitiesPartialBatchResponse
-	res, err := MakeRequest[UpdateActivitiesPartialBatchRequest, UpdateActivitiesPartialBatchResponse](c.client, ctx, "PATCH", "/api/v2/feeds/activities/batch/partial", nil, request, &result, nil)
+	res, err := MakeRequest[UpdateActivitiesPartialBatchRequest, UpdateActivitiesPartialBatchResponse](c.client, ctx, "PATCH", "/api/v2/feeds/activities/batch/partial", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Delete one or more activities by their IDs
-func (c *FeedsClient) DeleteActivities(ctx context.Context, request *DeleteActivitiesRequest) (*StreamResponse[DeleteActivitiesResponse], error) {
+func (c *FeedsClient) DeleteActivities(ctx context.Context, request *DeleteActivitiesRequest, opts ...RequestOption) (*StreamResponse[DeleteActivitiesResponse], error) {
 	var result DeleteActivitiesResponse
-	res, err := MakeRequest[DeleteActivitiesRequest, DeleteActivitiesResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/delete", nil, request, &result, nil)
+	res, err := MakeRequest[DeleteActivitiesRequest, DeleteActivitiesResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/delete", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Track metric events (views, clicks, impressions) for activities. Supports batching up to 100 events per request. Each event is independently rate-limited per user per activity per metric. Server-side calls must include user_id.
-func (c *FeedsClient) TrackActivityMetrics(ctx context.Context, request *TrackActivityMetricsRequest) (*StreamResponse[TrackActivityMetricsResponse], error) {
+func (c *FeedsClient) TrackActivityMetrics(ctx context.Context, request *TrackActivityMetricsRequest, opts ...RequestOption) (*StreamResponse[TrackActivityMetricsResponse], error) {
 	var result TrackActivityMetricsResponse
-	res, err := MakeRequest[TrackActivityMetricsRequest, TrackActivityMetricsResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/metrics/track", nil, request, &result, nil)
+	res, err := MakeRequest[TrackActivityMetricsRequest, TrackActivityMetricsResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/metrics/track", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Query activities based on filters with pagination and sorting options
-func (c *FeedsClient) QueryActivities(ctx context.Context, request *QueryActivitiesRequest) (*StreamResponse[QueryActivitiesResponse], error) {
+func (c *FeedsClient) QueryActivities(ctx context.Context, request *QueryActivitiesRequest, opts ...RequestOption) (*StreamResponse[QueryActivitiesResponse], error) {
 	var result QueryActivitiesResponse
 	params := extractQueryParams(request)
-	res, err := MakeRequest[QueryActivitiesRequest, QueryActivitiesResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/query", params, request, &result, nil)
+	res, err := MakeRequest[QueryActivitiesRequest, QueryActivitiesResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/query", params, request, &result, nil, opts...)
 	return res, err
 }
 
 // Returns a single user's reactions across a set of activity IDs, without activity payloads
-func (c *FeedsClient) BatchQueryActivityReactions(ctx context.Context, request *BatchQueryActivityReactionsRequest) (*StreamResponse[BatchQueryActivityReactionsResponse], error) {
+func (c *FeedsClient) BatchQueryActivityReactions(ctx context.Context, request *BatchQueryActivityReactionsRequest, opts ...RequestOption) (*StreamResponse[BatchQueryActivityReactionsResponse], error) {
 	var result BatchQueryActivityReactionsResponse
-	res, err := MakeRequest[BatchQueryActivityReactionsRequest, BatchQueryActivityReactionsResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/reactions/query", nil, request, &result, nil)
+	res, err := MakeRequest[BatchQueryActivityReactionsRequest, BatchQueryActivityReactionsResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/reactions/query", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Deletes a bookmark from an activity
-func (c *FeedsClient) DeleteBookmark(ctx context.Context, activityID string, request *DeleteBookmarkRequest) (*StreamResponse[DeleteBookmarkResponse], error) {
+func (c *FeedsClient) DeleteBookmark(ctx context.Context, activityID string, request *DeleteBookmarkRequest, opts ...RequestOption) (*StreamResponse[DeleteBookmarkResponse], error) {
 	var result DeleteBookmarkResponse
 	pathParams := map[string]string{
 		"activity_id": activityID,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, DeleteBookmarkResponse](c.client, ctx, "DELETE", "/api/v2/feeds/activities/{activity_id}/bookmarks", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, DeleteBookmarkResponse](c.client, ctx, "DELETE", "/api/v2/feeds/activities/{activity_id}/bookmarks", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Updates a bookmark for an activity
-func (c *FeedsClient) UpdateBookmark(ctx context.Context, activityID string, request *UpdateBookmarkRequest) (*StreamResponse[UpdateBookmarkResponse], error) {
+func (c *FeedsClient) UpdateBookmark(ctx context.Context, activityID string, request *UpdateBookmarkRequest, opts ...RequestOption) (*StreamResponse[UpdateBookmarkResponse], error) {
 	var result UpdateBookmarkResponse
 	pathParams := map[string]string{
 		"activity_id": activityID,
 	}
-	res, err := MakeRequest[UpdateBookmarkRequest, UpdateBookmarkResponse](c.client, ctx, "PATCH", "/api/v2/feeds/activities/{activity_id}/bookmarks", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateBookmarkRequest, UpdateBookmarkResponse](c.client, ctx, "PATCH", "/api/v2/feeds/activities/{activity_id}/bookmarks", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Adds a bookmark to an activity
-func (c *FeedsClient) AddBookmark(ctx context.Context, activityID string, request *AddBookmarkRequest) (*StreamResponse[AddBookmarkResponse], error) {
+func (c *FeedsClient) AddBookmark(ctx context.Context, activityID string, request *AddBookmarkRequest, opts ...RequestOption) (*StreamResponse[AddBookmarkResponse], error) {
 	var result AddBookmarkResponse
 	pathParams := map[string]string{
 		"activity_id": activityID,
 	}
-	res, err := MakeRequest[AddBookmarkRequest, AddBookmarkResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{activity_id}/bookmarks", nil, request, &result, pathParams)
+	res, err := MakeRequest[AddBookmarkRequest, AddBookmarkResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{activity_id}/bookmarks", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Submit feedback for an activity including options to show less, hide, report, or mute the user
-func (c *FeedsClient) ActivityFeedback(ctx context.Context, activityID string, request *ActivityFeedbackRequest) (*StreamResponse[ActivityFeedbackResponse], error) {
+func (c *FeedsClient) ActivityFeedback(ctx context.Context, activityID string, request *ActivityFeedbackRequest, opts ...RequestOption) (*StreamResponse[ActivityFeedbackResponse], error) {
 	var result ActivityFeedbackResponse
 	pathParams := map[string]string{
 		"activity_id": activityID,
 	}
-	res, err := MakeRequest[ActivityFeedbackRequest, ActivityFeedbackResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{activity_id}/feedback", nil, request, &result, pathParams)
+	res, err := MakeRequest[ActivityFeedbackRequest, ActivityFeedbackResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{activity_id}/feedback", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
@@ -118,13 +118,13 @@ func (c *FeedsClient) ActivityFeedback(ctx context.Context, activityID string, r
 // - poll.vote_casted
 // - poll.vote_changed
 // - poll.vote_removed
-func (c *FeedsClient) CastPollVote(ctx context.Context, activityID string, pollID string, request *CastPollVoteRequest) (*StreamResponse[PollVoteResponse], error) {
+func (c *FeedsClient) CastPollVote(ctx context.Context, activityID string, pollID string, request *CastPollVoteRequest, opts ...RequestOption) (*StreamResponse[PollVoteResponse], error) {
 	var result PollVoteResponse
 	pathParams := map[string]string{
 		"activity_id": activityID,
 		"poll_id":     pollID,
 	}
-	res, err := MakeRequest[CastPollVoteRequest, PollVoteResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{activity_id}/polls/{poll_id}/vote", nil, request, &result, pathParams)
+	res, err := MakeRequest[CastPollVoteRequest, PollVoteResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{activity_id}/polls/{poll_id}/vote", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
@@ -133,7 +133,7 @@ func (c *FeedsClient) CastPollVote(ctx context.Context, activityID string, pollI
 // Sends events:
 // - feeds.poll.vote_removed
 // - poll.vote_removed
-func (c *FeedsClient) DeletePollVote(ctx context.Context, activityID string, pollID string, voteID string, request *DeletePollVoteRequest) (*StreamResponse[PollVoteResponse], error) {
+func (c *FeedsClient) DeletePollVote(ctx context.Context, activityID string, pollID string, voteID string, request *DeletePollVoteRequest, opts ...RequestOption) (*StreamResponse[PollVoteResponse], error) {
 	var result PollVoteResponse
 	pathParams := map[string]string{
 		"activity_id": activityID,
@@ -141,72 +141,72 @@ func (c *FeedsClient) DeletePollVote(ctx context.Context, activityID string, pol
 		"vote_id":     voteID,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, PollVoteResponse](c.client, ctx, "DELETE", "/api/v2/feeds/activities/{activity_id}/polls/{poll_id}/vote/{vote_id}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, PollVoteResponse](c.client, ctx, "DELETE", "/api/v2/feeds/activities/{activity_id}/polls/{poll_id}/vote/{vote_id}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Adds a reaction to an activity
-func (c *FeedsClient) AddActivityReaction(ctx context.Context, activityID string, request *AddActivityReactionRequest) (*StreamResponse[AddReactionResponse], error) {
+func (c *FeedsClient) AddActivityReaction(ctx context.Context, activityID string, request *AddActivityReactionRequest, opts ...RequestOption) (*StreamResponse[AddReactionResponse], error) {
 	var result AddReactionResponse
 	pathParams := map[string]string{
 		"activity_id": activityID,
 	}
-	res, err := MakeRequest[AddActivityReactionRequest, AddReactionResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{activity_id}/reactions", nil, request, &result, pathParams)
+	res, err := MakeRequest[AddActivityReactionRequest, AddReactionResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{activity_id}/reactions", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Query activity reactions
-func (c *FeedsClient) QueryActivityReactions(ctx context.Context, activityID string, request *QueryActivityReactionsRequest) (*StreamResponse[QueryActivityReactionsResponse], error) {
+func (c *FeedsClient) QueryActivityReactions(ctx context.Context, activityID string, request *QueryActivityReactionsRequest, opts ...RequestOption) (*StreamResponse[QueryActivityReactionsResponse], error) {
 	var result QueryActivityReactionsResponse
 	pathParams := map[string]string{
 		"activity_id": activityID,
 	}
-	res, err := MakeRequest[QueryActivityReactionsRequest, QueryActivityReactionsResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{activity_id}/reactions/query", nil, request, &result, pathParams)
+	res, err := MakeRequest[QueryActivityReactionsRequest, QueryActivityReactionsResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{activity_id}/reactions/query", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Removes a reaction from an activity
-func (c *FeedsClient) DeleteActivityReaction(ctx context.Context, activityID string, _type string, request *DeleteActivityReactionRequest) (*StreamResponse[DeleteActivityReactionResponse], error) {
+func (c *FeedsClient) DeleteActivityReaction(ctx context.Context, activityID string, _type string, request *DeleteActivityReactionRequest, opts ...RequestOption) (*StreamResponse[DeleteActivityReactionResponse], error) {
 	var result DeleteActivityReactionResponse
 	pathParams := map[string]string{
 		"activity_id": activityID,
 		"type":        _type,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, DeleteActivityReactionResponse](c.client, ctx, "DELETE", "/api/v2/feeds/activities/{activity_id}/reactions/{type}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, DeleteActivityReactionResponse](c.client, ctx, "DELETE", "/api/v2/feeds/activities/{activity_id}/reactions/{type}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // List the shares recorded for an activity, newest-first
-func (c *FeedsClient) QueryActivityShares(ctx context.Context, activityID string, request *QueryActivitySharesRequest) (*StreamResponse[QueryActivitySharesResponse], error) {
+func (c *FeedsClient) QueryActivityShares(ctx context.Context, activityID string, request *QueryActivitySharesRequest, opts ...RequestOption) (*StreamResponse[QueryActivitySharesResponse], error) {
 	var result QueryActivitySharesResponse
 	pathParams := map[string]string{
 		"activity_id": activityID,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, QueryActivitySharesResponse](c.client, ctx, "GET", "/api/v2/feeds/activities/{activity_id}/shares", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, QueryActivitySharesResponse](c.client, ctx, "GET", "/api/v2/feeds/activities/{activity_id}/shares", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Delete a single activity by its ID
-func (c *FeedsClient) DeleteActivity(ctx context.Context, id string, request *DeleteActivityRequest) (*StreamResponse[DeleteActivityResponse], error) {
+func (c *FeedsClient) DeleteActivity(ctx context.Context, id string, request *DeleteActivityRequest, opts ...RequestOption) (*StreamResponse[DeleteActivityResponse], error) {
 	var result DeleteActivityResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, DeleteActivityResponse](c.client, ctx, "DELETE", "/api/v2/feeds/activities/{id}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, DeleteActivityResponse](c.client, ctx, "DELETE", "/api/v2/feeds/activities/{id}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Returns activity by ID
-func (c *FeedsClient) GetActivity(ctx context.Context, id string, request *GetActivityRequest) (*StreamResponse[GetActivityResponse], error) {
+func (c *FeedsClient) GetActivity(ctx context.Context, id string, request *GetActivityRequest, opts ...RequestOption) (*StreamResponse[GetActivityResponse], error) {
 	var result GetActivityResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, GetActivityResponse](c.client, ctx, "GET", "/api/v2/feeds/activities/{id}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, GetActivityResponse](c.client, ctx, "GET", "/api/v2/feeds/activities/{id}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
@@ -214,12 +214,12 @@ func (c *FeedsClient) GetActivity(ctx context.Context, id string, request *GetAc
 //
 // Sends events:
 // - feeds.activity.updated
-func (c *FeedsClient) UpdateActivityPartial(ctx context.Context, id string, request *UpdateActivityPartialRequest) (*StreamResponse[UpdateActivityPartialResponse], error) {
+func (c *FeedsClient) UpdateActivityPartial(ctx context.Context, id string, request *UpdateActivityPartialRequest, opts ...RequestOption) (*StreamResponse[UpdateActivityPartialResponse], error) {
 	var result UpdateActivityPartialResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[UpdateActivityPartialRequest, UpdateActivityPartialResponse](c.client, ctx, "PATCH", "/api/v2/feeds/activities/{id}", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateActivityPartialRequest, UpdateActivityPartialResponse](c.client, ctx, "PATCH", "/api/v2/feeds/activities/{id}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
@@ -227,23 +227,23 @@ func (c *FeedsClient) UpdateActivityPartial(ctx context.Context, id string, requ
 //
 // Sends events:
 // - feeds.activity.updated
-func (c *FeedsClient) UpdateActivity(ctx context.Context, id string, request *UpdateActivityRequest) (*StreamResponse[UpdateActivityResponse], error) {
+func (c *FeedsClient) UpdateActivity(ctx context.Context, id string, request *UpdateActivityRequest, opts ...RequestOption) (*StreamResponse[UpdateActivityResponse], error) {
 	var result UpdateActivityResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[UpdateActivityRequest, UpdateActivityResponse](c.client, ctx, "PUT", "/api/v2/feeds/activities/{id}", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateActivityRequest, UpdateActivityResponse](c.client, ctx, "PUT", "/api/v2/feeds/activities/{id}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Restores a soft-deleted, moderation-removed, or shadow-blocked activity by its ID. Deleted activities can be restored by the owner (client-side). Moderation-blocked activities can only be restored server-side.
-func (c *FeedsClient) RestoreActivity(ctx context.Context, id string, request *RestoreActivityRequest) (*StreamResponse[RestoreActivityResponse], error) {
+func (c *FeedsClient) RestoreActivity(ctx context.Context, id string, request *RestoreActivityRequest, opts ...RequestOption) (*StreamResponse[RestoreActivityResponse], error) {
 	var result RestoreActivityResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[RestoreActivityRequest, RestoreActivityResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{id}/restore", params, request, &result, pathParams)
+	res, err := MakeRequest[RestoreActivityRequest, RestoreActivityResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{id}/restore", params, request, &result, pathParams, opts...)
 	return res, err
 }
 
@@ -251,191 +251,191 @@ func (c *FeedsClient) RestoreActivity(ctx context.Context, id string, request *R
 //
 // Sends events:
 // - feeds.activity.updated
-func (c *FeedsClient) TranslateActivity(ctx context.Context, id string, request *TranslateActivityRequest) (*StreamResponse[TranslateActivityResponse], error) {
+func (c *FeedsClient) TranslateActivity(ctx context.Context, id string, request *TranslateActivityRequest, opts ...RequestOption) (*StreamResponse[TranslateActivityResponse], error) {
 	var result TranslateActivityResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[TranslateActivityRequest, TranslateActivityResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{id}/translate", nil, request, &result, pathParams)
+	res, err := MakeRequest[TranslateActivityRequest, TranslateActivityResponse](c.client, ctx, "POST", "/api/v2/feeds/activities/{id}/translate", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Query bookmark folders with filter query
-func (c *FeedsClient) QueryBookmarkFolders(ctx context.Context, request *QueryBookmarkFoldersRequest) (*StreamResponse[QueryBookmarkFoldersResponse], error) {
+func (c *FeedsClient) QueryBookmarkFolders(ctx context.Context, request *QueryBookmarkFoldersRequest, opts ...RequestOption) (*StreamResponse[QueryBookmarkFoldersResponse], error) {
 	var result QueryBookmarkFoldersResponse
-	res, err := MakeRequest[QueryBookmarkFoldersRequest, QueryBookmarkFoldersResponse](c.client, ctx, "POST", "/api/v2/feeds/bookmark_folders/query", nil, request, &result, nil)
+	res, err := MakeRequest[QueryBookmarkFoldersRequest, QueryBookmarkFoldersResponse](c.client, ctx, "POST", "/api/v2/feeds/bookmark_folders/query", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Delete a bookmark folder by its ID
-func (c *FeedsClient) DeleteBookmarkFolder(ctx context.Context, folderID string, request *DeleteBookmarkFolderRequest) (*StreamResponse[DeleteBookmarkFolderResponse], error) {
+func (c *FeedsClient) DeleteBookmarkFolder(ctx context.Context, folderID string, request *DeleteBookmarkFolderRequest, opts ...RequestOption) (*StreamResponse[DeleteBookmarkFolderResponse], error) {
 	var result DeleteBookmarkFolderResponse
 	pathParams := map[string]string{
 		"folder_id": folderID,
 	}
-	res, err := MakeRequest[any, DeleteBookmarkFolderResponse](c.client, ctx, "DELETE", "/api/v2/feeds/bookmark_folders/{folder_id}", nil, nil, &result, pathParams)
+	res, err := MakeRequest[any, DeleteBookmarkFolderResponse](c.client, ctx, "DELETE", "/api/v2/feeds/bookmark_folders/{folder_id}", nil, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Update a bookmark folder by its ID
-func (c *FeedsClient) UpdateBookmarkFolder(ctx context.Context, folderID string, request *UpdateBookmarkFolderRequest) (*StreamResponse[UpdateBookmarkFolderResponse], error) {
+func (c *FeedsClient) UpdateBookmarkFolder(ctx context.Context, folderID string, request *UpdateBookmarkFolderRequest, opts ...RequestOption) (*StreamResponse[UpdateBookmarkFolderResponse], error) {
 	var result UpdateBookmarkFolderResponse
 	pathParams := map[string]string{
 		"folder_id": folderID,
 	}
-	res, err := MakeRequest[UpdateBookmarkFolderRequest, UpdateBookmarkFolderResponse](c.client, ctx, "PATCH", "/api/v2/feeds/bookmark_folders/{folder_id}", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateBookmarkFolderRequest, UpdateBookmarkFolderResponse](c.client, ctx, "PATCH", "/api/v2/feeds/bookmark_folders/{folder_id}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Query bookmarks with filter query
-func (c *FeedsClient) QueryBookmarks(ctx context.Context, request *QueryBookmarksRequest) (*StreamResponse[QueryBookmarksResponse], error) {
+func (c *FeedsClient) QueryBookmarks(ctx context.Context, request *QueryBookmarksRequest, opts ...RequestOption) (*StreamResponse[QueryBookmarksResponse], error) {
 	var result QueryBookmarksResponse
 	params := extractQueryParams(request)
-	res, err := MakeRequest[QueryBookmarksRequest, QueryBookmarksResponse](c.client, ctx, "POST", "/api/v2/feeds/bookmarks/query", params, request, &result, nil)
+	res, err := MakeRequest[QueryBookmarksRequest, QueryBookmarksResponse](c.client, ctx, "POST", "/api/v2/feeds/bookmarks/query", params, request, &result, nil, opts...)
 	return res, err
 }
 
 // Delete collections in a batch operation. Users can only delete their own collections.
-func (c *FeedsClient) DeleteCollections(ctx context.Context, request *DeleteCollectionsRequest) (*StreamResponse[DeleteCollectionsResponse], error) {
+func (c *FeedsClient) DeleteCollections(ctx context.Context, request *DeleteCollectionsRequest, opts ...RequestOption) (*StreamResponse[DeleteCollectionsResponse], error) {
 	var result DeleteCollectionsResponse
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, DeleteCollectionsResponse](c.client, ctx, "DELETE", "/api/v2/feeds/collections", params, nil, &result, nil)
+	res, err := MakeRequest[any, DeleteCollectionsResponse](c.client, ctx, "DELETE", "/api/v2/feeds/collections", params, nil, &result, nil, opts...)
 	return res, err
 }
 
 // Read collections by their references. By default, users can only read their own collections.
-func (c *FeedsClient) ReadCollections(ctx context.Context, request *ReadCollectionsRequest) (*StreamResponse[ReadCollectionsResponse], error) {
+func (c *FeedsClient) ReadCollections(ctx context.Context, request *ReadCollectionsRequest, opts ...RequestOption) (*StreamResponse[ReadCollectionsResponse], error) {
 	var result ReadCollectionsResponse
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, ReadCollectionsResponse](c.client, ctx, "GET", "/api/v2/feeds/collections", params, nil, &result, nil)
+	res, err := MakeRequest[any, ReadCollectionsResponse](c.client, ctx, "GET", "/api/v2/feeds/collections", params, nil, &result, nil, opts...)
 	return res, err
 }
 
 // Update existing collections in a batch operation. Only the custom data field is updatable. Users can only update their own collections.
-func (c *FeedsClient) UpdateCollections(ctx context.Context, request *UpdateCollectionsRequest) (*StreamResponse[UpdateCollectionsResponse], error) {
+func (c *FeedsClient) UpdateCollections(ctx context.Context, request *UpdateCollectionsRequest, opts ...RequestOption) (*StreamResponse[UpdateCollectionsResponse], error) {
 	var result UpdateCollectionsResponse
-	res, err := MakeRequest[UpdateCollectionsRequest, UpdateCollectionsResponse](c.client, ctx, "PATCH", "/api/v2/feeds/collections", nil, request, &result, nil)
+	res, err := MakeRequest[UpdateCollectionsRequest, UpdateCollectionsResponse](c.client, ctx, "PATCH", "/api/v2/feeds/collections", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Create new collections in a batch operation. Collections are data objects that can be attached to activities for managing shared data across multiple activities.
-func (c *FeedsClient) CreateCollections(ctx context.Context, request *CreateCollectionsRequest) (*StreamResponse[CreateCollectionsResponse], error) {
+func (c *FeedsClient) CreateCollections(ctx context.Context, request *CreateCollectionsRequest, opts ...RequestOption) (*StreamResponse[CreateCollectionsResponse], error) {
 	var result CreateCollectionsResponse
-	res, err := MakeRequest[CreateCollectionsRequest, CreateCollectionsResponse](c.client, ctx, "POST", "/api/v2/feeds/collections", nil, request, &result, nil)
+	res, err := MakeRequest[CreateCollectionsRequest, CreateCollectionsResponse](c.client, ctx, "POST", "/api/v2/feeds/collections", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Insert new collections or update existing ones in a batch operation. Only the custom data field is updatable for existing collections.
-func (c *FeedsClient) UpsertCollections(ctx context.Context, request *UpsertCollectionsRequest) (*StreamResponse[UpsertCollectionsResponse], error) {
+func (c *FeedsClient) UpsertCollections(ctx context.Context, request *UpsertCollectionsRequest, opts ...RequestOption) (*StreamResponse[UpsertCollectionsResponse], error) {
 	var result UpsertCollectionsResponse
-	res, err := MakeRequest[UpsertCollectionsRequest, UpsertCollectionsResponse](c.client, ctx, "PUT", "/api/v2/feeds/collections", nil, request, &result, nil)
+	res, err := MakeRequest[UpsertCollectionsRequest, UpsertCollectionsResponse](c.client, ctx, "PUT", "/api/v2/feeds/collections", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Query collections with filter query
-func (c *FeedsClient) QueryCollections(ctx context.Context, request *QueryCollectionsRequest) (*StreamResponse[QueryCollectionsResponse], error) {
+func (c *FeedsClient) QueryCollections(ctx context.Context, request *QueryCollectionsRequest, opts ...RequestOption) (*StreamResponse[QueryCollectionsResponse], error) {
 	var result QueryCollectionsResponse
-	res, err := MakeRequest[QueryCollectionsRequest, QueryCollectionsResponse](c.client, ctx, "POST", "/api/v2/feeds/collections/query", nil, request, &result, nil)
+	res, err := MakeRequest[QueryCollectionsRequest, QueryCollectionsResponse](c.client, ctx, "POST", "/api/v2/feeds/collections/query", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Retrieve a threaded list of comments for a specific object (e.g., activity), with configurable depth, sorting, and pagination
-func (c *FeedsClient) GetComments(ctx context.Context, request *GetCommentsRequest) (*StreamResponse[GetCommentsResponse], error) {
+func (c *FeedsClient) GetComments(ctx context.Context, request *GetCommentsRequest, opts ...RequestOption) (*StreamResponse[GetCommentsResponse], error) {
 	var result GetCommentsResponse
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, GetCommentsResponse](c.client, ctx, "GET", "/api/v2/feeds/comments", params, nil, &result, nil)
+	res, err := MakeRequest[any, GetCommentsResponse](c.client, ctx, "GET", "/api/v2/feeds/comments", params, nil, &result, nil, opts...)
 	return res, err
 }
 
 // Adds a comment to an object (e.g., activity) or a reply to an existing comment, and broadcasts appropriate events
-func (c *FeedsClient) AddComment(ctx context.Context, request *AddCommentRequest) (*StreamResponse[AddCommentResponse], error) {
+func (c *FeedsClient) AddComment(ctx context.Context, request *AddCommentRequest, opts ...RequestOption) (*StreamResponse[AddCommentResponse], error) {
 	var result AddCommentResponse
-	res, err := MakeRequest[AddCommentRequest, AddCommentResponse](c.client, ctx, "POST", "/api/v2/feeds/comments", nil, request, &result, nil)
+	res, err := MakeRequest[AddCommentRequest, AddCommentResponse](c.client, ctx, "POST", "/api/v2/feeds/comments", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Adds multiple comments in a single request. Each comment must specify the object type and ID.
-func (c *FeedsClient) AddCommentsBatch(ctx context.Context, request *AddCommentsBatchRequest) (*StreamResponse[AddCommentsBatchResponse], error) {
+func (c *FeedsClient) AddCommentsBatch(ctx context.Context, request *AddCommentsBatchRequest, opts ...RequestOption) (*StreamResponse[AddCommentsBatchResponse], error) {
 	var result AddCommentsBatchResponse
-	res, err := MakeRequest[AddCommentsBatchRequest, AddCommentsBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/batch", nil, request, &result, nil)
+	res, err := MakeRequest[AddCommentsBatchRequest, AddCommentsBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/batch", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Query comments using MongoDB-style filters with pagination and sorting options
-func (c *FeedsClient) QueryComments(ctx context.Context, request *QueryCommentsRequest) (*StreamResponse[QueryCommentsResponse], error) {
+func (c *FeedsClient) QueryComments(ctx context.Context, request *QueryCommentsRequest, opts ...RequestOption) (*StreamResponse[QueryCommentsResponse], error) {
 	var result QueryCommentsResponse
 	params := extractQueryParams(request)
-	res, err := MakeRequest[QueryCommentsRequest, QueryCommentsResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/query", params, request, &result, nil)
+	res, err := MakeRequest[QueryCommentsRequest, QueryCommentsResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/query", params, request, &result, nil, opts...)
 	return res, err
 }
 
 // Returns a single user's reactions across a set of comment IDs, without comment payloads
-func (c *FeedsClient) BatchQueryCommentReactions(ctx context.Context, request *BatchQueryCommentReactionsRequest) (*StreamResponse[BatchQueryCommentReactionsResponse], error) {
+func (c *FeedsClient) BatchQueryCommentReactions(ctx context.Context, request *BatchQueryCommentReactionsRequest, opts ...RequestOption) (*StreamResponse[BatchQueryCommentReactionsResponse], error) {
 	var result BatchQueryCommentReactionsResponse
-	res, err := MakeRequest[BatchQueryCommentReactionsRequest, BatchQueryCommentReactionsResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/reactions/query", nil, request, &result, nil)
+	res, err := MakeRequest[BatchQueryCommentReactionsRequest, BatchQueryCommentReactionsResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/reactions/query", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Deletes a bookmark from a comment
-func (c *FeedsClient) DeleteCommentBookmark(ctx context.Context, commentID string, request *DeleteCommentBookmarkRequest) (*StreamResponse[DeleteCommentBookmarkResponse], error) {
+func (c *FeedsClient) DeleteCommentBookmark(ctx context.Context, commentID string, request *DeleteCommentBookmarkRequest, opts ...RequestOption) (*StreamResponse[DeleteCommentBookmarkResponse], error) {
 	var result DeleteCommentBookmarkResponse
 	pathParams := map[string]string{
 		"comment_id": commentID,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, DeleteCommentBookmarkResponse](c.client, ctx, "DELETE", "/api/v2/feeds/comments/{comment_id}/bookmarks", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, DeleteCommentBookmarkResponse](c.client, ctx, "DELETE", "/api/v2/feeds/comments/{comment_id}/bookmarks", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Updates a bookmark for a comment
-func (c *FeedsClient) UpdateCommentBookmark(ctx context.Context, commentID string, request *UpdateCommentBookmarkRequest) (*StreamResponse[UpdateCommentBookmarkResponse], error) {
+func (c *FeedsClient) UpdateCommentBookmark(ctx context.Context, commentID string, request *UpdateCommentBookmarkRequest, opts ...RequestOption) (*StreamResponse[UpdateCommentBookmarkResponse], error) {
 	var result UpdateCommentBookmarkResponse
 	pathParams := map[string]string{
 		"comment_id": commentID,
 	}
-	res, err := MakeRequest[UpdateCommentBookmarkRequest, UpdateCommentBookmarkResponse](c.client, ctx, "PATCH", "/api/v2/feeds/comments/{comment_id}/bookmarks", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateCommentBookmarkRequest, UpdateCommentBookmarkResponse](c.client, ctx, "PATCH", "/api/v2/feeds/comments/{comment_id}/bookmarks", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Adds a bookmark to a comment
-func (c *FeedsClient) AddCommentBookmark(ctx context.Context, commentID string, request *AddCommentBookmarkRequest) (*StreamResponse[AddCommentBookmarkResponse], error) {
+func (c *FeedsClient) AddCommentBookmark(ctx context.Context, commentID string, request *AddCommentBookmarkRequest, opts ...RequestOption) (*StreamResponse[AddCommentBookmarkResponse], error) {
 	var result AddCommentBookmarkResponse
 	pathParams := map[string]string{
 		"comment_id": commentID,
 	}
-	res, err := MakeRequest[AddCommentBookmarkRequest, AddCommentBookmarkResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{comment_id}/bookmarks", nil, request, &result, pathParams)
+	res, err := MakeRequest[AddCommentBookmarkRequest, AddCommentBookmarkResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{comment_id}/bookmarks", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Deletes a comment from an object (e.g., activity) and broadcasts appropriate events
-func (c *FeedsClient) DeleteComment(ctx context.Context, id string, request *DeleteCommentRequest) (*StreamResponse[DeleteCommentResponse], error) {
+func (c *FeedsClient) DeleteComment(ctx context.Context, id string, request *DeleteCommentRequest, opts ...RequestOption) (*StreamResponse[DeleteCommentResponse], error) {
 	var result DeleteCommentResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, DeleteCommentResponse](c.client, ctx, "DELETE", "/api/v2/feeds/comments/{id}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, DeleteCommentResponse](c.client, ctx, "DELETE", "/api/v2/feeds/comments/{id}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Get a comment by ID
-func (c *FeedsClient) GetComment(ctx context.Context, id string, request *GetCommentRequest) (*StreamResponse[GetCommentResponse], error) {
+func (c *FeedsClient) GetComment(ctx context.Context, id string, request *GetCommentRequest, opts ...RequestOption) (*StreamResponse[GetCommentResponse], error) {
 	var result GetCommentResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, GetCommentResponse](c.client, ctx, "GET", "/api/v2/feeds/comments/{id}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, GetCommentResponse](c.client, ctx, "GET", "/api/v2/feeds/comments/{id}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Updates a comment on an object (e.g., activity) and broadcasts appropriate events
-func (c *FeedsClient) UpdateComment(ctx context.Context, id string, request *UpdateCommentRequest) (*StreamResponse[UpdateCommentResponse], error) {
+func (c *FeedsClient) UpdateComment(ctx context.Context, id string, request *UpdateCommentRequest, opts ...RequestOption) (*StreamResponse[UpdateCommentResponse], error) {
 	var result UpdateCommentResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[UpdateCommentRequest, UpdateCommentResponse](c.client, ctx, "PATCH", "/api/v2/feeds/comments/{id}", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateCommentRequest, UpdateCommentResponse](c.client, ctx, "PATCH", "/api/v2/feeds/comments/{id}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
@@ -444,65 +444,65 @@ func (c *FeedsClient) UpdateComment(ctx context.Context, id string, request *Upd
 // Sends events:
 // - feeds.activity.updated
 // - feeds.comment.updated
-func (c *FeedsClient) UpdateCommentPartial(ctx context.Context, id string, request *UpdateCommentPartialRequest) (*StreamResponse[UpdateCommentPartialResponse], error) {
+func (c *FeedsClient) UpdateCommentPartial(ctx context.Context, id string, request *UpdateCommentPartialRequest, opts ...RequestOption) (*StreamResponse[UpdateCommentPartialResponse], error) {
 	var result UpdateCommentPartialResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[UpdateCommentPartialRequest, UpdateCommentPartialResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{id}/partial", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateCommentPartialRequest, UpdateCommentPartialResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{id}/partial", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Adds a reaction to a comment
-func (c *FeedsClient) AddCommentReaction(ctx context.Context, id string, request *AddCommentReactionRequest) (*StreamResponse[AddCommentReactionResponse], error) {
+func (c *FeedsClient) AddCommentReaction(ctx context.Context, id string, request *AddCommentReactionRequest, opts ...RequestOption) (*StreamResponse[AddCommentReactionResponse], error) {
 	var result AddCommentReactionResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[AddCommentReactionRequest, AddCommentReactionResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{id}/reactions", nil, request, &result, pathParams)
+	res, err := MakeRequest[AddCommentReactionRequest, AddCommentReactionResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{id}/reactions", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Query comment reactions
-func (c *FeedsClient) QueryCommentReactions(ctx context.Context, id string, request *QueryCommentReactionsRequest) (*StreamResponse[QueryCommentReactionsResponse], error) {
+func (c *FeedsClient) QueryCommentReactions(ctx context.Context, id string, request *QueryCommentReactionsRequest, opts ...RequestOption) (*StreamResponse[QueryCommentReactionsResponse], error) {
 	var result QueryCommentReactionsResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[QueryCommentReactionsRequest, QueryCommentReactionsResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{id}/reactions/query", nil, request, &result, pathParams)
+	res, err := MakeRequest[QueryCommentReactionsRequest, QueryCommentReactionsResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{id}/reactions/query", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Deletes a reaction from a comment
-func (c *FeedsClient) DeleteCommentReaction(ctx context.Context, id string, _type string, request *DeleteCommentReactionRequest) (*StreamResponse[DeleteCommentReactionResponse], error) {
+func (c *FeedsClient) DeleteCommentReaction(ctx context.Context, id string, _type string, request *DeleteCommentReactionRequest, opts ...RequestOption) (*StreamResponse[DeleteCommentReactionResponse], error) {
 	var result DeleteCommentReactionResponse
 	pathParams := map[string]string{
 		"id":   id,
 		"type": _type,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, DeleteCommentReactionResponse](c.client, ctx, "DELETE", "/api/v2/feeds/comments/{id}/reactions/{type}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, DeleteCommentReactionResponse](c.client, ctx, "DELETE", "/api/v2/feeds/comments/{id}/reactions/{type}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Retrieve a threaded list of replies for a single comment, with configurable depth, sorting, and pagination
-func (c *FeedsClient) GetCommentReplies(ctx context.Context, id string, request *GetCommentRepliesRequest) (*StreamResponse[GetCommentRepliesResponse], error) {
+func (c *FeedsClient) GetCommentReplies(ctx context.Context, id string, request *GetCommentRepliesRequest, opts ...RequestOption) (*StreamResponse[GetCommentRepliesResponse], error) {
 	var result GetCommentRepliesResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, GetCommentRepliesResponse](c.client, ctx, "GET", "/api/v2/feeds/comments/{id}/replies", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, GetCommentRepliesResponse](c.client, ctx, "GET", "/api/v2/feeds/comments/{id}/replies", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Restores a soft-deleted, moderation-removed, or shadow-blocked comment by its ID. The comment and all its descendants are restored. Deleted comments can be restored client-side. Moderation-blocked comments can only be restored server-side.
-func (c *FeedsClient) RestoreComment(ctx context.Context, id string, request *RestoreCommentRequest) (*StreamResponse[RestoreCommentResponse], error) {
+func (c *FeedsClient) RestoreComment(ctx context.Context, id string, request *RestoreCommentRequest, opts ...RequestOption) (*StreamResponse[RestoreCommentResponse], error) {
 	var result RestoreCommentResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[RestoreCommentRequest, RestoreCommentResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{id}/restore", nil, request, &result, pathParams)
+	res, err := MakeRequest[RestoreCommentRequest, RestoreCommentResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{id}/restore", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
@@ -510,78 +510,78 @@ func (c *FeedsClient) RestoreComment(ctx context.Context, id string, request *Re
 //
 // Sends events:
 // - feeds.comment.updated
-func (c *FeedsClient) TranslateComment(ctx context.Context, id string, request *TranslateCommentRequest) (*StreamResponse[TranslateCommentResponse], error) {
+func (c *FeedsClient) TranslateComment(ctx context.Context, id string, request *TranslateCommentRequest, opts ...RequestOption) (*StreamResponse[TranslateCommentResponse], error) {
 	var result TranslateCommentResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[TranslateCommentRequest, TranslateCommentResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{id}/translate", nil, request, &result, pathParams)
+	res, err := MakeRequest[TranslateCommentRequest, TranslateCommentResponse](c.client, ctx, "POST", "/api/v2/feeds/comments/{id}/translate", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // List all feed groups for the application
-func (c *FeedsClient) ListFeedGroups(ctx context.Context, request *ListFeedGroupsRequest) (*StreamResponse[ListFeedGroupsResponse], error) {
+func (c *FeedsClient) ListFeedGroups(ctx context.Context, request *ListFeedGroupsRequest, opts ...RequestOption) (*StreamResponse[ListFeedGroupsResponse], error) {
 	var result ListFeedGroupsResponse
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, ListFeedGroupsResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_groups", params, nil, &result, nil)
+	res, err := MakeRequest[any, ListFeedGroupsResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_groups", params, nil, &result, nil, opts...)
 	return res, err
 }
 
 // Creates a new feed group with the specified configuration
-func (c *FeedsClient) CreateFeedGroup(ctx context.Context, request *CreateFeedGroupRequest) (*StreamResponse[CreateFeedGroupResponse], error) {
+func (c *FeedsClient) CreateFeedGroup(ctx context.Context, request *CreateFeedGroupRequest, opts ...RequestOption) (*StreamResponse[CreateFeedGroupResponse], error) {
 	var result CreateFeedGroupResponse
-	res, err := MakeRequest[CreateFeedGroupRequest, CreateFeedGroupResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups", nil, request, &result, nil)
+	res, err := MakeRequest[CreateFeedGroupRequest, CreateFeedGroupResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Delete a single feed by its ID
-func (c *FeedsClient) DeleteFeed(ctx context.Context, feedGroupID string, feedID string, request *DeleteFeedRequest) (*StreamResponse[DeleteFeedResponse], error) {
+func (c *FeedsClient) DeleteFeed(ctx context.Context, feedGroupID string, feedID string, request *DeleteFeedRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedResponse], error) {
 	var result DeleteFeedResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 		"feed_id":       feedID,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, DeleteFeedResponse](c.client, ctx, "DELETE", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, DeleteFeedResponse](c.client, ctx, "DELETE", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Create a single feed for a given feed group
-func (c *FeedsClient) GetOrCreateFeed(ctx context.Context, feedGroupID string, feedID string, request *GetOrCreateFeedRequest) (*StreamResponse[GetOrCreateFeedResponse], error) {
+func (c *FeedsClient) GetOrCreateFeed(ctx context.Context, feedGroupID string, feedID string, request *GetOrCreateFeedRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateFeedResponse], error) {
 	var result GetOrCreateFeedResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 		"feed_id":       feedID,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[GetOrCreateFeedRequest, GetOrCreateFeedResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}", params, request, &result, pathParams)
+	res, err := MakeRequest[GetOrCreateFeedRequest, GetOrCreateFeedResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}", params, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Update an existing feed
-func (c *FeedsClient) UpdateFeed(ctx context.Context, feedGroupID string, feedID string, request *UpdateFeedRequest) (*StreamResponse[UpdateFeedResponse], error) {
+func (c *FeedsClient) UpdateFeed(ctx context.Context, feedGroupID string, feedID string, request *UpdateFeedRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedResponse], error) {
 	var result UpdateFeedResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 		"feed_id":       feedID,
 	}
-	res, err := MakeRequest[UpdateFeedRequest, UpdateFeedResponse](c.client, ctx, "PUT", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateFeedRequest, UpdateFeedResponse](c.client, ctx, "PUT", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Mark activities as read/seen/watched. Can mark by timestamp (seen), activity IDs (read), or all as read.
-func (c *FeedsClient) MarkActivity(ctx context.Context, feedGroupID string, feedID string, request *MarkActivityRequest) (*StreamResponse[Response], error) {
+func (c *FeedsClient) MarkActivity(ctx context.Context, feedGroupID string, feedID string, request *MarkActivityRequest, opts ...RequestOption) (*StreamResponse[Response], error) {
 	var result Response
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 		"feed_id":       feedID,
 	}
-	res, err := MakeRequest[MarkActivityRequest, Response](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/activities/mark/batch", nil, request, &result, pathParams)
+	res, err := MakeRequest[MarkActivityRequest, Response](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/activities/mark/batch", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Unpin an activity from a feed. This removes the pin, so the activity will no longer be displayed at the top of the feed.
-func (c *FeedsClient) UnpinActivity(ctx context.Context, feedGroupID string, feedID string, activityID string, request *UnpinActivityRequest) (*StreamResponse[UnpinActivityResponse], error) {
+func (c *FeedsClient) UnpinActivity(ctx context.Context, feedGroupID string, feedID string, activityID string, request *UnpinActivityRequest, opts ...RequestOption) (*StreamResponse[UnpinActivityResponse], error) {
 	var result UnpinActivityResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
@@ -589,436 +589,436 @@ func (c *FeedsClient) UnpinActivity(ctx context.Context, feedGroupID string, fee
 		"activity_id":   activityID,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, UnpinActivityResponse](c.client, ctx, "DELETE", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/activities/{activity_id}/pin", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, UnpinActivityResponse](c.client, ctx, "DELETE", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/activities/{activity_id}/pin", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Pin an activity to a feed. Pinned activities are typically displayed at the top of a feed.
-func (c *FeedsClient) PinActivity(ctx context.Context, feedGroupID string, feedID string, activityID string, request *PinActivityRequest) (*StreamResponse[PinActivityResponse], error) {
+func (c *FeedsClient) PinActivity(ctx context.Context, feedGroupID string, feedID string, activityID string, request *PinActivityRequest, opts ...RequestOption) (*StreamResponse[PinActivityResponse], error) {
 	var result PinActivityResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 		"feed_id":       feedID,
 		"activity_id":   activityID,
 	}
-	res, err := MakeRequest[PinActivityRequest, PinActivityResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/activities/{activity_id}/pin", nil, request, &result, pathParams)
+	res, err := MakeRequest[PinActivityRequest, PinActivityResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/activities/{activity_id}/pin", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Changes the visibility of an existing feed. Follow reconciliation (rewriting pending follows on loosening, or removing disallowed follows/members on tightening) runs asynchronously in the background; the response returns optimistically with the intended visibility.
-func (c *FeedsClient) ChangeFeedVisibility(ctx context.Context, feedGroupID string, feedID string, request *ChangeFeedVisibilityRequest) (*StreamResponse[ChangeFeedVisibilityResponse], error) {
+func (c *FeedsClient) ChangeFeedVisibility(ctx context.Context, feedGroupID string, feedID string, request *ChangeFeedVisibilityRequest, opts ...RequestOption) (*StreamResponse[ChangeFeedVisibilityResponse], error) {
 	var result ChangeFeedVisibilityResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 		"feed_id":       feedID,
 	}
-	res, err := MakeRequest[ChangeFeedVisibilityRequest, ChangeFeedVisibilityResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/change_visibility", nil, request, &result, pathParams)
+	res, err := MakeRequest[ChangeFeedVisibilityRequest, ChangeFeedVisibilityResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/change_visibility", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Add, remove, or set members for a feed
-func (c *FeedsClient) UpdateFeedMembers(ctx context.Context, feedGroupID string, feedID string, request *UpdateFeedMembersRequest) (*StreamResponse[UpdateFeedMembersResponse], error) {
+func (c *FeedsClient) UpdateFeedMembers(ctx context.Context, feedGroupID string, feedID string, request *UpdateFeedMembersRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedMembersResponse], error) {
 	var result UpdateFeedMembersResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 		"feed_id":       feedID,
 	}
-	res, err := MakeRequest[UpdateFeedMembersRequest, UpdateFeedMembersResponse](c.client, ctx, "PATCH", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/members", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateFeedMembersRequest, UpdateFeedMembersResponse](c.client, ctx, "PATCH", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/members", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Accepts a pending feed member request
-func (c *FeedsClient) AcceptFeedMemberInvite(ctx context.Context, feedID string, feedGroupID string, request *AcceptFeedMemberInviteRequest) (*StreamResponse[AcceptFeedMemberInviteResponse], error) {
+func (c *FeedsClient) AcceptFeedMemberInvite(ctx context.Context, feedID string, feedGroupID string, request *AcceptFeedMemberInviteRequest, opts ...RequestOption) (*StreamResponse[AcceptFeedMemberInviteResponse], error) {
 	var result AcceptFeedMemberInviteResponse
 	pathParams := map[string]string{
 		"feed_id":       feedID,
 		"feed_group_id": feedGroupID,
 	}
-	res, err := MakeRequest[AcceptFeedMemberInviteRequest, AcceptFeedMemberInviteResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/members/accept", nil, request, &result, pathParams)
+	res, err := MakeRequest[AcceptFeedMemberInviteRequest, AcceptFeedMemberInviteResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/members/accept", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Query feed members based on filters with pagination and sorting options
-func (c *FeedsClient) QueryFeedMembers(ctx context.Context, feedGroupID string, feedID string, request *QueryFeedMembersRequest) (*StreamResponse[QueryFeedMembersResponse], error) {
+func (c *FeedsClient) QueryFeedMembers(ctx context.Context, feedGroupID string, feedID string, request *QueryFeedMembersRequest, opts ...RequestOption) (*StreamResponse[QueryFeedMembersResponse], error) {
 	var result QueryFeedMembersResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 		"feed_id":       feedID,
 	}
-	res, err := MakeRequest[QueryFeedMembersRequest, QueryFeedMembersResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/members/query", nil, request, &result, pathParams)
+	res, err := MakeRequest[QueryFeedMembersRequest, QueryFeedMembersResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/members/query", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Rejects a pending feed member request
-func (c *FeedsClient) RejectFeedMemberInvite(ctx context.Context, feedGroupID string, feedID string, request *RejectFeedMemberInviteRequest) (*StreamResponse[RejectFeedMemberInviteResponse], error) {
+func (c *FeedsClient) RejectFeedMemberInvite(ctx context.Context, feedGroupID string, feedID string, request *RejectFeedMemberInviteRequest, opts ...RequestOption) (*StreamResponse[RejectFeedMemberInviteResponse], error) {
 	var result RejectFeedMemberInviteResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 		"feed_id":       feedID,
 	}
-	res, err := MakeRequest[RejectFeedMemberInviteRequest, RejectFeedMemberInviteResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/members/reject", nil, request, &result, pathParams)
+	res, err := MakeRequest[RejectFeedMemberInviteRequest, RejectFeedMemberInviteResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/members/reject", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Query pinned activities for a feed with filter query
-func (c *FeedsClient) QueryPinnedActivities(ctx context.Context, feedGroupID string, feedID string, request *QueryPinnedActivitiesRequest) (*StreamResponse[QueryPinnedActivitiesResponse], error) {
+func (c *FeedsClient) QueryPinnedActivities(ctx context.Context, feedGroupID string, feedID string, request *QueryPinnedActivitiesRequest, opts ...RequestOption) (*StreamResponse[QueryPinnedActivitiesResponse], error) {
 	var result QueryPinnedActivitiesResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 		"feed_id":       feedID,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[QueryPinnedActivitiesRequest, QueryPinnedActivitiesResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/pinned_activities/query", params, request, &result, pathParams)
+	res, err := MakeRequest[QueryPinnedActivitiesRequest, QueryPinnedActivitiesResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/{feed_id}/pinned_activities/query", params, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Get follow suggestions for a feed group
-func (c *FeedsClient) GetFollowSuggestions(ctx context.Context, feedGroupID string, request *GetFollowSuggestionsRequest) (*StreamResponse[GetFollowSuggestionsResponse], error) {
+func (c *FeedsClient) GetFollowSuggestions(ctx context.Context, feedGroupID string, request *GetFollowSuggestionsRequest, opts ...RequestOption) (*StreamResponse[GetFollowSuggestionsResponse], error) {
 	var result GetFollowSuggestionsResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, GetFollowSuggestionsResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_groups/{feed_group_id}/follow_suggestions", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, GetFollowSuggestionsResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_groups/{feed_group_id}/follow_suggestions", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Restores a soft-deleted feed group by its ID. Only clears DeletedAt in the database; no other fields are updated.
-func (c *FeedsClient) RestoreFeedGroup(ctx context.Context, feedGroupID string, request *RestoreFeedGroupRequest) (*StreamResponse[RestoreFeedGroupResponse], error) {
+func (c *FeedsClient) RestoreFeedGroup(ctx context.Context, feedGroupID string, request *RestoreFeedGroupRequest, opts ...RequestOption) (*StreamResponse[RestoreFeedGroupResponse], error) {
 	var result RestoreFeedGroupResponse
 	pathParams := map[string]string{
 		"feed_group_id": feedGroupID,
 	}
-	res, err := MakeRequest[any, RestoreFeedGroupResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/restore", nil, nil, &result, pathParams)
+	res, err := MakeRequest[any, RestoreFeedGroupResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{feed_group_id}/restore", nil, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Delete a feed group by its ID. Can perform a soft delete (default) or hard delete.
-func (c *FeedsClient) DeleteFeedGroup(ctx context.Context, id string, request *DeleteFeedGroupRequest) (*StreamResponse[DeleteFeedGroupResponse], error) {
+func (c *FeedsClient) DeleteFeedGroup(ctx context.Context, id string, request *DeleteFeedGroupRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedGroupResponse], error) {
 	var result DeleteFeedGroupResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, DeleteFeedGroupResponse](c.client, ctx, "DELETE", "/api/v2/feeds/feed_groups/{id}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, DeleteFeedGroupResponse](c.client, ctx, "DELETE", "/api/v2/feeds/feed_groups/{id}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Get a feed group by ID
-func (c *FeedsClient) GetFeedGroup(ctx context.Context, id string, request *GetFeedGroupRequest) (*StreamResponse[GetFeedGroupResponse], error) {
+func (c *FeedsClient) GetFeedGroup(ctx context.Context, id string, request *GetFeedGroupRequest, opts ...RequestOption) (*StreamResponse[GetFeedGroupResponse], error) {
 	var result GetFeedGroupResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, GetFeedGroupResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_groups/{id}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, GetFeedGroupResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_groups/{id}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Get an existing feed group or create a new one if it doesn't exist
-func (c *FeedsClient) GetOrCreateFeedGroup(ctx context.Context, id string, request *GetOrCreateFeedGroupRequest) (*StreamResponse[GetOrCreateFeedGroupResponse], error) {
+func (c *FeedsClient) GetOrCreateFeedGroup(ctx context.Context, id string, request *GetOrCreateFeedGroupRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateFeedGroupResponse], error) {
 	var result GetOrCreateFeedGroupResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[GetOrCreateFeedGroupRequest, GetOrCreateFeedGroupResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{id}", nil, request, &result, pathParams)
+	res, err := MakeRequest[GetOrCreateFeedGroupRequest, GetOrCreateFeedGroupResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_groups/{id}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Update a feed group by ID
-func (c *FeedsClient) UpdateFeedGroup(ctx context.Context, id string, request *UpdateFeedGroupRequest) (*StreamResponse[UpdateFeedGroupResponse], error) {
+func (c *FeedsClient) UpdateFeedGroup(ctx context.Context, id string, request *UpdateFeedGroupRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedGroupResponse], error) {
 	var result UpdateFeedGroupResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[UpdateFeedGroupRequest, UpdateFeedGroupResponse](c.client, ctx, "PUT", "/api/v2/feeds/feed_groups/{id}", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateFeedGroupRequest, UpdateFeedGroupResponse](c.client, ctx, "PUT", "/api/v2/feeds/feed_groups/{id}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // List all feed views for a feed group
-func (c *FeedsClient) ListFeedViews(ctx context.Context, request *ListFeedViewsRequest) (*StreamResponse[ListFeedViewsResponse], error) {
+func (c *FeedsClient) ListFeedViews(ctx context.Context, request *ListFeedViewsRequest, opts ...RequestOption) (*StreamResponse[ListFeedViewsResponse], error) {
 	var result ListFeedViewsResponse
-	res, err := MakeRequest[any, ListFeedViewsResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_views", nil, nil, &result, nil)
+	res, err := MakeRequest[any, ListFeedViewsResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_views", nil, nil, &result, nil, opts...)
 	return res, err
 }
 
 // Create a custom view for a feed group with specific selectors, ranking, or aggregation options
-func (c *FeedsClient) CreateFeedView(ctx context.Context, request *CreateFeedViewRequest) (*StreamResponse[CreateFeedViewResponse], error) {
+func (c *FeedsClient) CreateFeedView(ctx context.Context, request *CreateFeedViewRequest, opts ...RequestOption) (*StreamResponse[CreateFeedViewResponse], error) {
 	var result CreateFeedViewResponse
-	res, err := MakeRequest[CreateFeedViewRequest, CreateFeedViewResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_views", nil, request, &result, nil)
+	res, err := MakeRequest[CreateFeedViewRequest, CreateFeedViewResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_views", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Delete an existing custom feed view
-func (c *FeedsClient) DeleteFeedView(ctx context.Context, id string, request *DeleteFeedViewRequest) (*StreamResponse[DeleteFeedViewResponse], error) {
+func (c *FeedsClient) DeleteFeedView(ctx context.Context, id string, request *DeleteFeedViewRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedViewResponse], error) {
 	var result DeleteFeedViewResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[any, DeleteFeedViewResponse](c.client, ctx, "DELETE", "/api/v2/feeds/feed_views/{id}", nil, nil, &result, pathParams)
+	res, err := MakeRequest[any, DeleteFeedViewResponse](c.client, ctx, "DELETE", "/api/v2/feeds/feed_views/{id}", nil, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Get a feed view by its ID
-func (c *FeedsClient) GetFeedView(ctx context.Context, id string, request *GetFeedViewRequest) (*StreamResponse[GetFeedViewResponse], error) {
+func (c *FeedsClient) GetFeedView(ctx context.Context, id string, request *GetFeedViewRequest, opts ...RequestOption) (*StreamResponse[GetFeedViewResponse], error) {
 	var result GetFeedViewResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[any, GetFeedViewResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_views/{id}", nil, nil, &result, pathParams)
+	res, err := MakeRequest[any, GetFeedViewResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_views/{id}", nil, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Get an existing feed view or create a new one if it doesn't exist
-func (c *FeedsClient) GetOrCreateFeedView(ctx context.Context, id string, request *GetOrCreateFeedViewRequest) (*StreamResponse[GetOrCreateFeedViewResponse], error) {
+func (c *FeedsClient) GetOrCreateFeedView(ctx context.Context, id string, request *GetOrCreateFeedViewRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateFeedViewResponse], error) {
 	var result GetOrCreateFeedViewResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[GetOrCreateFeedViewRequest, GetOrCreateFeedViewResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_views/{id}", nil, request, &result, pathParams)
+	res, err := MakeRequest[GetOrCreateFeedViewRequest, GetOrCreateFeedViewResponse](c.client, ctx, "POST", "/api/v2/feeds/feed_views/{id}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Update an existing custom feed view with new selectors, ranking, or aggregation options
-func (c *FeedsClient) UpdateFeedView(ctx context.Context, id string, request *UpdateFeedViewRequest) (*StreamResponse[UpdateFeedViewResponse], error) {
+func (c *FeedsClient) UpdateFeedView(ctx context.Context, id string, request *UpdateFeedViewRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedViewResponse], error) {
 	var result UpdateFeedViewResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[UpdateFeedViewRequest, UpdateFeedViewResponse](c.client, ctx, "PUT", "/api/v2/feeds/feed_views/{id}", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateFeedViewRequest, UpdateFeedViewResponse](c.client, ctx, "PUT", "/api/v2/feeds/feed_views/{id}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Gets all available feed visibility configurations and their permissions
-func (c *FeedsClient) ListFeedVisibilities(ctx context.Context, request *ListFeedVisibilitiesRequest) (*StreamResponse[ListFeedVisibilitiesResponse], error) {
+func (c *FeedsClient) ListFeedVisibilities(ctx context.Context, request *ListFeedVisibilitiesRequest, opts ...RequestOption) (*StreamResponse[ListFeedVisibilitiesResponse], error) {
 	var result ListFeedVisibilitiesResponse
-	res, err := MakeRequest[any, ListFeedVisibilitiesResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_visibilities", nil, nil, &result, nil)
+	res, err := MakeRequest[any, ListFeedVisibilitiesResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_visibilities", nil, nil, &result, nil, opts...)
 	return res, err
 }
 
 // Gets feed visibility configuration and permissions
-func (c *FeedsClient) GetFeedVisibility(ctx context.Context, name string, request *GetFeedVisibilityRequest) (*StreamResponse[GetFeedVisibilityResponse], error) {
+func (c *FeedsClient) GetFeedVisibility(ctx context.Context, name string, request *GetFeedVisibilityRequest, opts ...RequestOption) (*StreamResponse[GetFeedVisibilityResponse], error) {
 	var result GetFeedVisibilityResponse
 	pathParams := map[string]string{
 		"name": name,
 	}
-	res, err := MakeRequest[any, GetFeedVisibilityResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_visibilities/{name}", nil, nil, &result, pathParams)
+	res, err := MakeRequest[any, GetFeedVisibilityResponse](c.client, ctx, "GET", "/api/v2/feeds/feed_visibilities/{name}", nil, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Updates an existing predefined feed visibility configuration
-func (c *FeedsClient) UpdateFeedVisibility(ctx context.Context, name string, request *UpdateFeedVisibilityRequest) (*StreamResponse[UpdateFeedVisibilityResponse], error) {
+func (c *FeedsClient) UpdateFeedVisibility(ctx context.Context, name string, request *UpdateFeedVisibilityRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedVisibilityResponse], error) {
 	var result UpdateFeedVisibilityResponse
 	pathParams := map[string]string{
 		"name": name,
 	}
-	res, err := MakeRequest[UpdateFeedVisibilityRequest, UpdateFeedVisibilityResponse](c.client, ctx, "PUT", "/api/v2/feeds/feed_visibilities/{name}", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateFeedVisibilityRequest, UpdateFeedVisibilityResponse](c.client, ctx, "PUT", "/api/v2/feeds/feed_visibilities/{name}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Create multiple feeds at once for a given feed group
-func (c *FeedsClient) CreateFeedsBatch(ctx context.Context, request *CreateFeedsBatchRequest) (*StreamResponse[CreateFeedsBatchResponse], error) {
+func (c *FeedsClient) CreateFeedsBatch(ctx context.Context, request *CreateFeedsBatchRequest, opts ...RequestOption) (*StreamResponse[CreateFeedsBatchResponse], error) {
 	var result CreateFeedsBatchResponse
-	res, err := MakeRequest[CreateFeedsBatchRequest, CreateFeedsBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/feeds/batch", nil, request, &result, nil)
+	res, err := MakeRequest[CreateFeedsBatchRequest, CreateFeedsBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/feeds/batch", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Delete multiple feeds by their IDs. All feeds must exist. This endpoint is server-side only.
-func (c *FeedsClient) DeleteFeedsBatch(ctx context.Context, request *DeleteFeedsBatchRequest) (*StreamResponse[DeleteFeedsBatchResponse], error) {
+func (c *FeedsClient) DeleteFeedsBatch(ctx context.Context, request *DeleteFeedsBatchRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedsBatchResponse], error) {
 	var result DeleteFeedsBatchResponse
-	res, err := MakeRequest[DeleteFeedsBatchRequest, DeleteFeedsBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/feeds/delete", nil, request, &result, nil)
+	res, err := MakeRequest[DeleteFeedsBatchRequest, DeleteFeedsBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/feeds/delete", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Retrieves own_follows, own_capabilities, and/or own_membership for multiple feeds in a single request. If fields are not specified, all three fields are returned.
-func (c *FeedsClient) OwnBatch(ctx context.Context, request *OwnBatchRequest) (*StreamResponse[OwnBatchResponse], error) {
+func (c *FeedsClient) OwnBatch(ctx context.Context, request *OwnBatchRequest, opts ...RequestOption) (*StreamResponse[OwnBatchResponse], error) {
 	var result OwnBatchResponse
-	res, err := MakeRequest[OwnBatchRequest, OwnBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/feeds/own/batch", nil, request, &result, nil)
+	res, err := MakeRequest[OwnBatchRequest, OwnBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/feeds/own/batch", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Query feeds with filter query
-func (c *FeedsClient) QueryFeeds(ctx context.Context, request *QueryFeedsRequest) (*StreamResponse[QueryFeedsResponse], error) {
+func (c *FeedsClient) QueryFeeds(ctx context.Context, request *QueryFeedsRequest, opts ...RequestOption) (*StreamResponse[QueryFeedsResponse], error) {
 	var result QueryFeedsResponse
-	res, err := MakeRequest[QueryFeedsRequest, QueryFeedsResponse](c.client, ctx, "POST", "/api/v2/feeds/feeds/query", nil, request, &result, nil)
+	res, err := MakeRequest[QueryFeedsRequest, QueryFeedsResponse](c.client, ctx, "POST", "/api/v2/feeds/feeds/query", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Retrieve current rate limit status for feeds operations.
 // Returns information about limits, usage, and remaining quota for various feed operations.
-func (c *FeedsClient) GetFeedsRateLimits(ctx context.Context, request *GetFeedsRateLimitsRequest) (*StreamResponse[GetFeedsRateLimitsResponse], error) {
+func (c *FeedsClient) GetFeedsRateLimits(ctx context.Context, request *GetFeedsRateLimitsRequest, opts ...RequestOption) (*StreamResponse[GetFeedsRateLimitsResponse], error) {
 	var result GetFeedsRateLimitsResponse
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, GetFeedsRateLimitsResponse](c.client, ctx, "GET", "/api/v2/feeds/feeds/rate_limits", params, nil, &result, nil)
+	res, err := MakeRequest[any, GetFeedsRateLimitsResponse](c.client, ctx, "GET", "/api/v2/feeds/feeds/rate_limits", params, nil, &result, nil, opts...)
 	return res, err
 }
 
 // Updates a follow's custom data, push preference, and follower role. Source owner can update custom data and push preference. Follower role can only be updated via server-side requests.
-func (c *FeedsClient) UpdateFollow(ctx context.Context, request *UpdateFollowRequest) (*StreamResponse[UpdateFollowResponse], error) {
+func (c *FeedsClient) UpdateFollow(ctx context.Context, request *UpdateFollowRequest, opts ...RequestOption) (*StreamResponse[UpdateFollowResponse], error) {
 	var result UpdateFollowResponse
-	res, err := MakeRequest[UpdateFollowRequest, UpdateFollowResponse](c.client, ctx, "PATCH", "/api/v2/feeds/follows", nil, request, &result, nil)
+	res, err := MakeRequest[UpdateFollowRequest, UpdateFollowResponse](c.client, ctx, "PATCH", "/api/v2/feeds/follows", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Creates a follow and broadcasts FollowAddedEvent
-func (c *FeedsClient) Follow(ctx context.Context, request *FollowRequest) (*StreamResponse[SingleFollowResponse], error) {
+func (c *FeedsClient) Follow(ctx context.Context, request *FollowRequest, opts ...RequestOption) (*StreamResponse[SingleFollowResponse], error) {
 	var result SingleFollowResponse
-	res, err := MakeRequest[FollowRequest, SingleFollowResponse](c.client, ctx, "POST", "/api/v2/feeds/follows", nil, request, &result, nil)
+	res, err := MakeRequest[FollowRequest, SingleFollowResponse](c.client, ctx, "POST", "/api/v2/feeds/follows", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Accepts a pending follow request
-func (c *FeedsClient) AcceptFollow(ctx context.Context, request *AcceptFollowRequest) (*StreamResponse[AcceptFollowResponse], error) {
+func (c *FeedsClient) AcceptFollow(ctx context.Context, request *AcceptFollowRequest, opts ...RequestOption) (*StreamResponse[AcceptFollowResponse], error) {
 	var result AcceptFollowResponse
-	res, err := MakeRequest[AcceptFollowRequest, AcceptFollowResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/accept", nil, request, &result, nil)
+	res, err := MakeRequest[AcceptFollowRequest, AcceptFollowResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/accept", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Creates multiple follows at once and broadcasts FollowAddedEvent for each follow
-func (c *FeedsClient) FollowBatch(ctx context.Context, request *FollowBatchRequest) (*StreamResponse[FollowBatchResponse], error) {
+func (c *FeedsClient) FollowBatch(ctx context.Context, request *FollowBatchRequest, opts ...RequestOption) (*StreamResponse[FollowBatchResponse], error) {
 	var result FollowBatchResponse
-	res, err := MakeRequest[FollowBatchRequest, FollowBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/batch", nil, request, &result, nil)
+	res, err := MakeRequest[FollowBatchRequest, FollowBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/batch", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Creates or updates multiple follows at once. Does not return an error if follows already exist. Broadcasts FollowAddedEvent only for newly created follows.
-func (c *FeedsClient) GetOrCreateFollows(ctx context.Context, request *GetOrCreateFollowsRequest) (*StreamResponse[FollowBatchResponse], error) {
+func (c *FeedsClient) GetOrCreateFollows(ctx context.Context, request *GetOrCreateFollowsRequest, opts ...RequestOption) (*StreamResponse[FollowBatchResponse], error) {
 	var result FollowBatchResponse
-	res, err := MakeRequest[GetOrCreateFollowsRequest, FollowBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/batch/upsert", nil, request, &result, nil)
+	res, err := MakeRequest[GetOrCreateFollowsRequest, FollowBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/batch/upsert", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Query follows based on filters with pagination and sorting options
-func (c *FeedsClient) QueryFollows(ctx context.Context, request *QueryFollowsRequest) (*StreamResponse[QueryFollowsResponse], error) {
+func (c *FeedsClient) QueryFollows(ctx context.Context, request *QueryFollowsRequest, opts ...RequestOption) (*StreamResponse[QueryFollowsResponse], error) {
 	var result QueryFollowsResponse
-	res, err := MakeRequest[QueryFollowsRequest, QueryFollowsResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/query", nil, request, &result, nil)
+	res, err := MakeRequest[QueryFollowsRequest, QueryFollowsResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/query", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Rejects a pending follow request
-func (c *FeedsClient) RejectFollow(ctx context.Context, request *RejectFollowRequest) (*StreamResponse[RejectFollowResponse], error) {
+func (c *FeedsClient) RejectFollow(ctx context.Context, request *RejectFollowRequest, opts ...RequestOption) (*StreamResponse[RejectFollowResponse], error) {
 	var result RejectFollowResponse
-	res, err := MakeRequest[RejectFollowRequest, RejectFollowResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/reject", nil, request, &result, nil)
+	res, err := MakeRequest[RejectFollowRequest, RejectFollowResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/reject", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Creates a follow if it does not exist, or returns the existing one. Broadcasts feeds.follow.created (FollowCreatedEvent) only when the follow is newly created.
-func (c *FeedsClient) GetOrCreateFollow(ctx context.Context, request *GetOrCreateFollowRequest) (*StreamResponse[GetOrCreateFollowResponse], error) {
+func (c *FeedsClient) GetOrCreateFollow(ctx context.Context, request *GetOrCreateFollowRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateFollowResponse], error) {
 	var result GetOrCreateFollowResponse
-	res, err := MakeRequest[GetOrCreateFollowRequest, GetOrCreateFollowResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/upsert", nil, request, &result, nil)
+	res, err := MakeRequest[GetOrCreateFollowRequest, GetOrCreateFollowResponse](c.client, ctx, "POST", "/api/v2/feeds/follows/upsert", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Removes a follow and broadcasts FollowRemovedEvent
-func (c *FeedsClient) Unfollow(ctx context.Context, source string, target string, request *UnfollowRequest) (*StreamResponse[UnfollowResponse], error) {
+func (c *FeedsClient) Unfollow(ctx context.Context, source string, target string, request *UnfollowRequest, opts ...RequestOption) (*StreamResponse[UnfollowResponse], error) {
 	var result UnfollowResponse
 	pathParams := map[string]string{
 		"source": source,
 		"target": target,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, UnfollowResponse](c.client, ctx, "DELETE", "/api/v2/feeds/follows/{source}/{target}", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, UnfollowResponse](c.client, ctx, "DELETE", "/api/v2/feeds/follows/{source}/{target}", params, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Create a new membership level with tag-based access controls
-func (c *FeedsClient) CreateMembershipLevel(ctx context.Context, request *CreateMembershipLevelRequest) (*StreamResponse[CreateMembershipLevelResponse], error) {
+func (c *FeedsClient) CreateMembershipLevel(ctx context.Context, request *CreateMembershipLevelRequest, opts ...RequestOption) (*StreamResponse[CreateMembershipLevelResponse], error) {
 	var result CreateMembershipLevelResponse
-	res, err := MakeRequest[CreateMembershipLevelRequest, CreateMembershipLevelResponse](c.client, ctx, "POST", "/api/v2/feeds/membership_levels", nil, request, &result, nil)
+	res, err := MakeRequest[CreateMembershipLevelRequest, CreateMembershipLevelResponse](c.client, ctx, "POST", "/api/v2/feeds/membership_levels", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Query membership levels with filter query
-func (c *FeedsClient) QueryMembershipLevels(ctx context.Context, request *QueryMembershipLevelsRequest) (*StreamResponse[QueryMembershipLevelsResponse], error) {
+func (c *FeedsClient) QueryMembershipLevels(ctx context.Context, request *QueryMembershipLevelsRequest, opts ...RequestOption) (*StreamResponse[QueryMembershipLevelsResponse], error) {
 	var result QueryMembershipLevelsResponse
-	res, err := MakeRequest[QueryMembershipLevelsRequest, QueryMembershipLevelsResponse](c.client, ctx, "POST", "/api/v2/feeds/membership_levels/query", nil, request, &result, nil)
+	res, err := MakeRequest[QueryMembershipLevelsRequest, QueryMembershipLevelsResponse](c.client, ctx, "POST", "/api/v2/feeds/membership_levels/query", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Delete a membership level by its UUID. This operation is irreversible.
-func (c *FeedsClient) DeleteMembershipLevel(ctx context.Context, id string, request *DeleteMembershipLevelRequest) (*StreamResponse[Response], error) {
+func (c *FeedsClient) DeleteMembershipLevel(ctx context.Context, id string, request *DeleteMembershipLevelRequest, opts ...RequestOption) (*StreamResponse[Response], error) {
 	var result Response
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[any, Response](c.client, ctx, "DELETE", "/api/v2/feeds/membership_levels/{id}", nil, nil, &result, pathParams)
+	res, err := MakeRequest[any, Response](c.client, ctx, "DELETE", "/api/v2/feeds/membership_levels/{id}", nil, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Update a membership level with partial updates. Only specified fields will be updated.
-func (c *FeedsClient) UpdateMembershipLevel(ctx context.Context, id string, request *UpdateMembershipLevelRequest) (*StreamResponse[UpdateMembershipLevelResponse], error) {
+func (c *FeedsClient) UpdateMembershipLevel(ctx context.Context, id string, request *UpdateMembershipLevelRequest, opts ...RequestOption) (*StreamResponse[UpdateMembershipLevelResponse], error) {
 	var result UpdateMembershipLevelResponse
 	pathParams := map[string]string{
 		"id": id,
 	}
-	res, err := MakeRequest[UpdateMembershipLevelRequest, UpdateMembershipLevelResponse](c.client, ctx, "PATCH", "/api/v2/feeds/membership_levels/{id}", nil, request, &result, pathParams)
+	res, err := MakeRequest[UpdateMembershipLevelRequest, UpdateMembershipLevelResponse](c.client, ctx, "PATCH", "/api/v2/feeds/membership_levels/{id}", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Queries revision history for activities and comments
-func (c *FeedsClient) QueryRevisionHistory(ctx context.Context, request *QueryRevisionHistoryRequest) (*StreamResponse[QueryRevisionHistoryResponse], error) {
+func (c *FeedsClient) QueryRevisionHistory(ctx context.Context, request *QueryRevisionHistoryRequest, opts ...RequestOption) (*StreamResponse[QueryRevisionHistoryResponse], error) {
 	var result QueryRevisionHistoryResponse
-	res, err := MakeRequest[QueryRevisionHistoryRequest, QueryRevisionHistoryResponse](c.client, ctx, "POST", "/api/v2/feeds/revisions/query", nil, request, &result, nil)
+	res, err := MakeRequest[QueryRevisionHistoryRequest, QueryRevisionHistoryResponse](c.client, ctx, "POST", "/api/v2/feeds/revisions/query", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Retrieve usage statistics for feeds including activity count, follow count, and API request count.
 // Returns data aggregated by day with pagination support via from/to date parameters.
 // This endpoint is server-side only.
-func (c *FeedsClient) QueryFeedsUsageStats(ctx context.Context, request *QueryFeedsUsageStatsRequest) (*StreamResponse[QueryFeedsUsageStatsResponse], error) {
+func (c *FeedsClient) QueryFeedsUsageStats(ctx context.Context, request *QueryFeedsUsageStatsRequest, opts ...RequestOption) (*StreamResponse[QueryFeedsUsageStatsResponse], error) {
 	var result QueryFeedsUsageStatsResponse
-	res, err := MakeRequest[QueryFeedsUsageStatsRequest, QueryFeedsUsageStatsResponse](c.client, ctx, "POST", "/api/v2/feeds/stats/usage", nil, request, &result, nil)
+	res, err := MakeRequest[QueryFeedsUsageStatsRequest, QueryFeedsUsageStatsResponse](c.client, ctx, "POST", "/api/v2/feeds/stats/usage", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Removes multiple follows at once and broadcasts FollowRemovedEvent for each one
-func (c *FeedsClient) UnfollowBatch(ctx context.Context, request *UnfollowBatchRequest) (*StreamResponse[UnfollowBatchResponse], error) {
+func (c *FeedsClient) UnfollowBatch(ctx context.Context, request *UnfollowBatchRequest, opts ...RequestOption) (*StreamResponse[UnfollowBatchResponse], error) {
 	var result UnfollowBatchResponse
-	res, err := MakeRequest[UnfollowBatchRequest, UnfollowBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/unfollow/batch", nil, request, &result, nil)
+	res, err := MakeRequest[UnfollowBatchRequest, UnfollowBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/unfollow/batch", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Removes multiple follows and broadcasts FollowRemovedEvent for each. Does not return an error if follows don't exist.
-func (c *FeedsClient) GetOrCreateUnfollows(ctx context.Context, request *GetOrCreateUnfollowsRequest) (*StreamResponse[UnfollowBatchResponse], error) {
+func (c *FeedsClient) GetOrCreateUnfollows(ctx context.Context, request *GetOrCreateUnfollowsRequest, opts ...RequestOption) (*StreamResponse[UnfollowBatchResponse], error) {
 	var result UnfollowBatchResponse
-	res, err := MakeRequest[GetOrCreateUnfollowsRequest, UnfollowBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/unfollow/batch/upsert", nil, request, &result, nil)
+	res, err := MakeRequest[GetOrCreateUnfollowsRequest, UnfollowBatchResponse](c.client, ctx, "POST", "/api/v2/feeds/unfollow/batch/upsert", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Removes a follow and broadcasts feeds.follow.deleted (FollowDeletedEvent). Does not return an error if the follow does not exist.
-func (c *FeedsClient) GetOrCreateUnfollow(ctx context.Context, request *GetOrCreateUnfollowRequest) (*StreamResponse[GetOrCreateUnfollowResponse], error) {
+func (c *FeedsClient) GetOrCreateUnfollow(ctx context.Context, request *GetOrCreateUnfollowRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateUnfollowResponse], error) {
 	var result GetOrCreateUnfollowResponse
-	res, err := MakeRequest[GetOrCreateUnfollowRequest, GetOrCreateUnfollowResponse](c.client, ctx, "POST", "/api/v2/feeds/unfollow/upsert", nil, request, &result, nil)
+	res, err := MakeRequest[GetOrCreateUnfollowRequest, GetOrCreateUnfollowResponse](c.client, ctx, "POST", "/api/v2/feeds/unfollow/upsert", nil, request, &result, nil, opts...)
 	return res, err
 }
 
 // Delete all feed data for a user including: feeds, activities, follows, comments, feed reactions, bookmark folders, bookmarks, and collections owned by the user
-func (c *FeedsClient) DeleteFeedUserData(ctx context.Context, userID string, request *DeleteFeedUserDataRequest) (*StreamResponse[DeleteFeedUserDataResponse], error) {
+func (c *FeedsClient) DeleteFeedUserData(ctx context.Context, userID string, request *DeleteFeedUserDataRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedUserDataResponse], error) {
 	var result DeleteFeedUserDataResponse
 	pathParams := map[string]string{
 		"user_id": userID,
 	}
-	res, err := MakeRequest[DeleteFeedUserDataRequest, DeleteFeedUserDataResponse](c.client, ctx, "POST", "/api/v2/feeds/users/{user_id}/delete", nil, request, &result, pathParams)
+	res, err := MakeRequest[DeleteFeedUserDataRequest, DeleteFeedUserDataResponse](c.client, ctx, "POST", "/api/v2/feeds/users/{user_id}/delete", nil, request, &result, pathParams, opts...)
 	return res, err
 }
 
 // Export all feed data for a user including: user profile, feeds, activities, follows, comments, feed reactions, bookmark folders, bookmarks, and collections owned by the user
-func (c *FeedsClient) ExportFeedUserData(ctx context.Context, userID string, request *ExportFeedUserDataRequest) (*StreamResponse[ExportFeedUserDataResponse], error) {
+func (c *FeedsClient) ExportFeedUserData(ctx context.Context, userID string, request *ExportFeedUserDataRequest, opts ...RequestOption) (*StreamResponse[ExportFeedUserDataResponse], error) {
 	var result ExportFeedUserDataResponse
 	pathParams := map[string]string{
 		"user_id": userID,
 	}
-	res, err := MakeRequest[any, ExportFeedUserDataResponse](c.client, ctx, "POST", "/api/v2/feeds/users/{user_id}/export", nil, nil, &result, pathParams)
+	res, err := MakeRequest[any, ExportFeedUserDataResponse](c.client, ctx, "POST", "/api/v2/feeds/users/{user_id}/export", nil, nil, &result, pathParams, opts...)
 	return res, err
 }
 
 // Returns the user's most common interest tags ranked by the number of distinct activities they reacted to that carried each tag. Client-side callers may only read their own interests; server-side callers may fetch any user. Results are sorted by descending count, then alphabetically by tag.
-func (c *FeedsClient) GetUserInterests(ctx context.Context, userID string, request *GetUserInterestsRequest) (*StreamResponse[GetUserInterestsResponse], error) {
+func (c *FeedsClient) GetUserInterests(ctx context.Context, userID string, request *GetUserInterestsRequest, opts ...RequestOption) (*StreamResponse[GetUserInterestsResponse], error) {
 	var result GetUserInterestsResponse
 	pathParams := map[string]string{
 		"user_id": userID,
 	}
 	params := extractQueryParams(request)
-	res, err := MakeRequest[any, GetUserInterestsResponse](c.client, ctx, "GET", "/api/v2/feeds/users/{user_id}/interests", params, nil, &result, pathParams)
+	res, err := MakeRequest[any, GetUserInterestsResponse](c.client, ctx, "GET", "/api/v2/feeds/users/{user_id}/interests", params, nil, &result, pathParams, opts...)
 	return res, err
 }
@@ -17,52 +17,52 @@ func NewFeed(feedType string, feedID string, client *FeedsClient) *Feeds {
 	}
 }
 
-func (c *Feeds) Delete(ctx context.Context, request *DeleteFeedRequest) (*StreamResponse[DeleteFeedResponse], error) {
-	return c.client.DeleteFeed(ctx, c.feedType, c.feedID, request)
+func (c *Feeds) Delete(ctx context.Context, request *DeleteFeedRequest, opts ...RequestOption) (*StreamResponse[DeleteFeedResponse], error) {
+	return c.client.DeleteFeed(ctx, c.feedType, c.feedID, request, opts...)
 }
 
-func (c *Feeds) GetOrCreate(ctx context.Context, request *GetOrCreateFeedRequest) (*StreamResponse[GetOrCreateFeedResponse], error) {
-	return c.client.GetOrCreateFeed(ctx, c.feedType, c.feedID, request)
+func (c *Feeds) GetOrCreate(ctx context.Context, request *GetOrCreateFeedRequest, opts ...RequestOption) (*StreamResponse[GetOrCreateFeedResponse], error) {
+	return c.client.GetOrCreateFeed(ctx, c.feedType, c.feedID, request, opts...)
 }
 
-func (c *Feeds) Update(ctx context.Context, request *UpdateFeedRequest) (*StreamResponse[UpdateFeedResponse], error) {
-	return c.client.UpdateFeed(ctx, c.feedType, c.feedID, request)
+func (c *Feeds) Update(ctx context.Context, request *UpdateFeedRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedResponse], error) {
+	return c.client.UpdateFeed(ctx, c.feedType, c.feedID, request, opts...)
 }
 
-func (c *Feeds) MarkActivity(ctx context.Context, request *MarkActivityRequest) (*StreamResponse[Response], error) {
-	return c.client.MarkActivity(ctx, c.feedType, c.feedID, request)
+func (c *Feeds) MarkActivity(ctx context.Context, request *MarkActivityRequest, opts ...RequestOption) (*StreamResponse[Response], error) {
+	return c.client.MarkActivity(ctx, c.feedType, c.feedID, request, opts...)
 }
 
-func (c *Feeds) UnpinActivity(ctx context.Context, activityID string, request *UnpinActivityRequest) (*StreamResponse[UnpinActivityResponse], error) {
-	return c.client.UnpinActivity(ctx, c.feedType, c.feedID, activityID, request)
+func (c *Feeds) UnpinActivity(ctx context.Context, activityID string, request *UnpinActivityRequest, opts ...RequestOption) (*StreamResponse[UnpinActivityResponse], error) {
+	return c.client.UnpinActivity(ctx, c.feedType, c.feedID, activityID, request, opts...)
 }
 
-func (c *Feeds) PinActivity(ctx context.Context, activityID string, request *PinActivityRequest) (*StreamResponse[PinActivityResponse], error) {
-	return c.client.PinActivity(ctx, c.feedType, c.feedID, activityID, request)
+func (c *Feeds) PinActivity(ctx context.Context, activityID string, request *PinActivityRequest, opts ...RequestOption) (*StreamResponse[PinActivityResponse], error) {
+	return c.client.PinActivity(ctx, c.feedType, c.feedID, activityID, request, opts...)
 }
 
-func (c *Feeds) ChangeFeedVisibility(ctx context.Context, request *ChangeFeedVisibilityRequest) (*StreamResponse[ChangeFeedVisibilityResponse], error) {
-	return c.client.ChangeFeedVisibility(ctx, c.feedType, c.feedID, request)
+func (c *Feeds) ChangeFeedVisibility(ctx context.Context, request *ChangeFeedVisibilityRequest, opts ...RequestOption) (*StreamResponse[ChangeFeedVisibilityResponse], error) {
+	return c.client.ChangeFeedVisibility(ctx, c.feedType, c.feedID, request, opts...)
 }
 
-func (c *Feeds) UpdateFeedMembers(ctx context.Context, request *UpdateFeedMembersRequest) (*StreamResponse[UpdateFeedMembersResponse], error) {
-	return c.client.UpdateFeedMembers(ctx, c.feedType, c.feedID, request)
+func (c *Feeds) UpdateFeedMembers(ctx context.Context, request *UpdateFeedMembersRequest, opts ...RequestOption) (*StreamResponse[UpdateFeedMembersResponse], error) {
+	return c.client.UpdateFeedMembers(ctx, c.feedType, c.feedID, request, opts...)
 }
 
-func (c *Feeds) AcceptFeedMemberInvite(ctx context.Context, request *AcceptFeedMemberInviteRequest) (*StreamResponse[AcceptFeedMemberInviteResponse], error) {
-	return c.client.AcceptFeedMemberInvite(ctx, c.feedType, c.feedID, request)
+func (c *Feeds) AcceptFeedMemberInvite(ctx context.Context, request *AcceptFeedMemberInviteRequest, opts ...RequestOption) (*StreamResponse[AcceptFeedMemberInviteResponse], error) {
+	return c.client.AcceptFeedMemberInvite(ctx, c.feedType, c.feedID, request, opts...)
 }
 
-func (c *Feeds) QueryFeedMembers(ctx context.Context, request *QueryFeedMembersRequest) (*StreamResponse[QueryFeedMembersResponse], error) {
-	return c.client.QueryFeedMembers(ctx, c.feedType, c.feedID, request)
+func (c *Feeds) QueryFeedMembers(ctx context.Context, request *QueryFeedMembersRequest, opts ...RequestOption) (*StreamResponse[QueryFeedMembersResponse], error) {
+	return c.client.QueryFeedMembers(ctx, c.feedType, c.feedID, request, opts...)
 }
 
-func (c *Feeds) RejectFeedMemberInvite(ctx context.Context, request *RejectFeedMemberInviteRequest) (*StreamResponse[RejectFeedMemberInviteResponse], error) {
-	return c.client.RejectFeedMemberInvite(ctx, c.feedType, c.feedID, request)
+func (c *Feeds) RejectFeedMemberInvite(ctx context.Context, request *RejectFeedMemberInviteRequest, opts ...RequestOption) (*StreamResponse[RejectFeedMemberInviteResponse], error) {
+	return c.client.RejectFeedMemberInvite(ctx, c.feedType, c.feedID, request, opts...)
 }
 
-func (c *Feeds) QueryPinnedActivities(ctx context.Context, request *QueryPinnedActivitiesRequest) (*StreamResponse[QueryPinnedActivitiesResponse], error) {
-	return c.client.QueryPinnedActivities(ctx, c.feedType, c.feedID, request)
+func (c *Feeds) QueryPinnedActivities(ctx context.Context, request *QueryPinnedActivitiesRequest, opts ...RequestOption) (*StreamResponse[QueryPinnedActivitiesResponse], error) {
+	return c.client.QueryPinnedActivities(ctx, c.feedType, c.feedID, request, opts...)
 }
 
 func (c *FeedsClient) Feed(feedType, feedID string) *Feeds {
@@ -14,4 +14,8 @@ set -ex
 # cd in API repo, generate new spec and then generate code from it
 ( cd $SOURCE_PATH ; make openapi ; ./build/chat-manager openapi generate-client --language go-serverside --spec ./releases/v2/serverside-api.yaml --output $DST_PATH ; ./build/chat-manager openapi generate-webhook-fixtures --output $DST_PATH/tests/fixtures/webhooks --time-format=unix-ns )
 
+# add per-call request options to the new clients, then regenerate the API
+# interfaces and mocks from them
+go generate .
+
 ./lint.sh
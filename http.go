@@ -1,6 +1,7 @@
 package getstream
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -48,6 +49,11 @@ type StreamError struct {
 	Task *TaskErrorDetails `json:"-"`
 	// RateLimit carries the rate-limit window info from response headers.
 	RateLimit *RateLimitInfo `json:"-"`
+	// RequestID identifies the call for Stream support: the request ID the
+	// server reported in its X-Request-Id response header or, failing that,
+	// the correlation ID the SDK sent (see ContextWithRequestID). Empty for
+	// errors raised outside an API call.
+	RequestID string `json:"-"`
 
 	// sentinel selects the category surfaced via Is. cause is the wrapped
 	// underlying error (typically a stack-bearing wrapper).
@@ -63,7 +69,8 @@ func (e *StreamError) Error() string {
 }
 
 // Is reports whether target matches the StreamError's category sentinel.
-// ErrRateLimited additionally matches ErrApiResponse.
+// ErrRateLimited additionally matches ErrApiResponse, and the specific token
+// sentinels match ErrTokenInvalid.
 func (e *StreamError) Is(target error) bool {
 	if e == nil || target == nil {
 		return false
@@ -74,6 +81,12 @@ func (e *StreamError) Is(target error) bool {
 	if e.sentinel == ErrRateLimited && target == ErrApiResponse {
 		return true
 	}
+	if target == ErrTokenInvalid {
+		switch e.sentinel {
+		case ErrTokenMalformed, ErrTokenSignatureInvalid, ErrTokenExpired, ErrTokenRevoked:
+			return true
+		}
+	}
 	return false
 }
 
@@ -99,7 +112,10 @@ func (e *StreamError) Sentinel() error {
 // Response is the base response returned to the client
 type StreamResponse[T any] struct {
 	RateLimitInfo *RateLimitInfo `json:"ratelimit"`
-	Data          T
+	// RequestID is the server's request ID from the X-Request-Id response
+	// header or, failing that, the correlation ID the SDK sent.
+	RequestID string `json:"request_id,omitempty"`
+	Data      T
 }
 
 // parseResponse parses the HTTP response into the provided result.
@@ -127,6 +143,7 @@ func buildAPIError(resp *http.Response, body []byte) *StreamError {
 		RawResponseBody: string(body),
 		ExceptionFields: map[string]string{},
 		RateLimit:       NewRateLimitFromHeaders(resp.Header),
+		RequestID:       resp.Header.Get(RequestIDHeader),
 	}
 
 	if len(body) > 0 {
@@ -163,21 +180,24 @@ func buildAPIError(resp *http.Response, body []byte) *StreamError {
 	return apiErr
 }
 
-// requestURL constructs the full request URL
-func (c *Client) requestURL(path string, values url.Values, pathParams map[string]string) (string, error) {
+// requestURL constructs the full request URL against baseURL
+func (c *Client) requestURL(baseURL, path string, values url.Values, pathParams map[string]string) (string, error) {
 	path = buildPath(path, pathParams)
 
-	u, err := url.Parse(c.baseUrl + path)
+	u, err := url.Parse(baseURL + path)
 	if err != nil {
 		return "", stackWrap(err, "url.Parse")
 	}
 
-	if values == nil {
-		values = make(url.Values)
+	// Copy so the caller's params (reused across retry attempts) never
+	// accumulate api_key.
+	query := make(url.Values, len(values)+1)
+	for k, vs := range values {
+		query[k] = append([]string(nil), vs...)
 	}
 
-	values.Add("api_key", c.apiKey)
-	u.RawQuery = values.Encode()
+	query.Add("api_key", c.apiKey)
+	u.RawQuery = query.Encode()
 	return u.String(), nil
 }
 
@@ -186,10 +206,13 @@ func buildPath(path string, pathParams map[string]string) string {
 	if pathParams == nil {
 		return path
 	}
+	// Escape into a fresh map: the caller's values must stay raw so a retried
+	// attempt doesn't escape them twice.
+	escaped := make(map[string]string, len(pathParams))
 	for k, v := range pathParams {
-		pathParams[k] = url.QueryEscape(v)
+		escaped[k] = url.QueryEscape(v)
 	}
-	return replaceParams(path, pathParams)
+	return replaceParams(path, escaped)
 }
 
 // replaceParams replaces placeholders in the path with the corresponding values from pathParams.
@@ -200,9 +223,9 @@ func replaceParams(path string, pathParams map[string]string) string {
 	return path
 }
 
-// newRequest creates a new HTTP request
-func newRequest[T any](c *Client, ctx context.Context, method, path string, params url.Values, data T, pathParams map[string]string) (*http.Request, error) {
-	u, err := c.requestURL(path, params, pathParams)
+// newRequest creates a new HTTP request to baseURL
+func newRequest[T any](c *Client, ctx context.Context, baseURL, method, path string, params url.Values, data T, pathParams map[string]string) (*http.Request, error) {
+	u, err := c.requestURL(baseURL, path, params, pathParams)
 	if err != nil {
 		return nil, err
 	}
@@ -228,7 +251,10 @@ func newRequest[T any](c *Client, ctx context.Context, method, path string, para
 	case io.Reader:
 		r.Body = io.NopCloser(t)
 	case *UploadFileRequest, *UploadImageRequest, *UploadChannelFileRequest, *UploadChannelImageRequest:
-		return c.createMultipartRequest(r, t)
+		return withUploadProgress(c.createMultipartRequest(r, t))
+	case *UploadFileStreamRequest, *UploadImageStreamRequest, *UploadChannelFileStreamRequest, *UploadChannelImageStreamRequest:
+		u, _ := asStreamUpload(t)
+		return withUploadProgress(c.createStreamingMultipartRequest(r, u))
 	default:
 		b, err := json.Marshal(data)
 		if err != nil {
@@ -393,13 +419,15 @@ func (c *Client) createMultipartRequest(r *http.Request, data any) (*http.Reques
 		return nil, fmt.Errorf("unsupported request type for multipart: %T", data)
 	}
 
-	// Add file field
-	fileWriter, err := writer.CreateFormFile("file", fileName)
+	// Add file field, typed by its content and extension
+	content := bufio.NewReaderSize(fileContent, sniffLen)
+	head, _ := content.Peek(sniffLen)
+	fileWriter, err := createFilePart(writer, fileName, detectUploadContentType(fileName, head))
 	if err != nil {
-		return nil, stackWrap(err, "failed to create form file")
+		return nil, err
 	}
 
-	_, err = io.Copy(fileWriter, fileContent)
+	_, err = io.Copy(fileWriter, content)
 	if err != nil {
 		return nil, stackWrap(err, "failed to copy file content")
 	}
@@ -511,30 +539,101 @@ func EncodeValueToQueryParam(value any) string {
 }
 
 // MakeRequest makes a generic HTTP request, auto-retrying per the client's
-// opt-in RetryConfig (GET/HEAD on 429/transport errors only). Disabled by
-// default: exactly one attempt, errors surface unchanged.
-func MakeRequest[GRequest any, GResponse any](c *Client, ctx context.Context, method, path string, params url.Values, data *GRequest, response *GResponse, pathParams map[string]string) (*StreamResponse[GResponse], error) {
+// opt-in RetryConfig (GET/HEAD and idempotent writes on 429/transport errors
+// only). Disabled by default: exactly one attempt, errors surface unchanged.
+// Each attempt passes through the client's interceptor chain (see
+// WithInterceptors) and sends the same idempotency key, if any. Reads served
+// from the response cache (see WithResponseCache) send nothing, and identical
+// concurrent GETs share one call when WithRequestCoalescing is set.
+//
+// opts apply to this call on top of any set with ContextWithRequestOptions.
+// Calls with their own headers or credentials bypass the response cache and
+// coalescing.
+//
+// Every call sends a correlation ID in its X-Request-Id header (see
+// ContextWithRequestID), logs it as stream.request_id, and reports the
+// request ID as StreamResponse.RequestID or StreamError.RequestID.
+func MakeRequest[GRequest any, GResponse any](c *Client, ctx context.Context, method, path string, params url.Values, data *GRequest, response *GResponse, pathParams map[string]string, opts ...RequestOption) (*StreamResponse[GResponse], error) {
+	if len(opts) > 0 {
+		ctx = ContextWithRequestOptions(ctx, opts...)
+	}
+	ctx, requestID := withRequestID(ctx)
+	ro := requestOptionsFromContext(ctx)
+	if ro.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ro.timeout)
+		defer cancel()
+	}
+	if !ro.shared() {
+		return makeRequest(c, ctx, method, path, params, data, response, pathParams, "")
+	}
+	cached, cacheKey := cachedResponse(c, ctx, method, path, params, pathParams, response)
+	if cached != nil {
+		cached.RequestID = requestID
+		return cached, nil
+	}
+	if c.coalescer != nil && method == http.MethodGet {
+		key := method + " " + buildPath(path, pathParams) + "?" + params.Encode()
+		return coalesce(c, ctx, key, response, func(ctx context.Context) (*StreamResponse[GResponse], error) {
+			return makeRequest(c, ctx, method, path, params, data, response, pathParams, cacheKey)
+		})
+	}
+	return makeRequest(c, ctx, method, path, params, data, response, pathParams, cacheKey)
+}
+
+// makeRequest runs MakeRequest's retry loop. cacheKey is the response cache
+// entry to fill on success, if any.
+func makeRequest[GRequest any, GResponse any](c *Client, ctx context.Context, method, path string, params url.Values, data *GRequest, response *GResponse, pathParams map[string]string, cacheKey string) (*StreamResponse[GResponse], error) {
+	var body any
+	if data != nil {
+		body = data
+		if err := c.validateUpload(ctx, data); err != nil {
+			return nil, err
+		}
+	}
+	header, err := c.requestHeader(requestOptionsFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	requestID, _ := RequestIDFromContext(ctx)
+	retry := c.retryPolicy(ctx)
+	idempotencyKey := c.idempotencyKey(ctx, method)
 	for attempt := 0; ; attempt++ {
 		start := time.Now()
-		result, err := makeRequestOnce(c, ctx, method, path, params, data, response, pathParams)
+		op := newOperation(method, path, params, body, pathParams)
+		op.Attempt = attempt
+		for k, vs := range header {
+			op.Header[k] = append([]string(nil), vs...)
+		}
+		if idempotencyKey != "" {
+			op.Header.Set(IdempotencyKeyHeader, idempotencyKey)
+		}
+		op.Header.Set(RequestIDHeader, requestID)
+		result, err := invokeOnce(c, ctx, op, data, response)
+		stampRequestID(result, err, requestID)
+		c.recordRequest(op, err)
 		if err == nil {
+			updateCache(c, method, path, cacheKey, result)
 			return result, nil
 		}
-		if !c.shouldRetry(err, method, attempt) {
+		if !retry.shouldRetry(err, op, attempt) {
 			// Only transport failures ever reached http.request.failed before
 			// retry existed (4xx/5xx, including 429, log via
 			// http.response.received instead) — preserve that split here.
 			if errors.Is(err, ErrTransport) {
-				c.logRequestFailed(method, path, err, time.Since(start))
+				c.logRequestFailed(ctx, method, path, err, time.Since(start))
 			}
 			return result, err
 		}
-		delay := c.retryDelay(err, attempt)
-		c.logRetryScheduled(method, path, err, attempt+1, delay)
+		delay := retry.retryDelay(err, attempt)
+		c.logRetryScheduled(ctx, method, path, err, attempt+1, delay)
+		if c.metrics != nil {
+			c.metrics.RecordRetry(op.Name(), attempt+1, delay)
+		}
 		select {
 		case <-ctx.Done():
 			ctxErr := wrapTransportError(ctx.Err())
-			c.logRequestFailed(method, path, ctxErr, time.Since(start))
+			c.logRequestFailed(ctx, method, path, ctxErr, time.Since(start))
 			return nil, ctxErr
 		case <-time.After(delay):
 		}
@@ -544,11 +643,35 @@ func MakeRequest[GRequest any, GResponse any](c *Client, ctx context.Context, me
 // makeRequestOnce performs a single HTTP attempt: builds the request, sends
 // it, and parses the response. Callers (MakeRequest) own retry looping and
 // the http.request.failed emission for transport failures.
-func makeRequestOnce[GRequest any, GResponse any](c *Client, ctx context.Context, method, path string, params url.Values, data *GRequest, response *GResponse, pathParams map[string]string) (*StreamResponse[GResponse], error) {
-	r, err := newRequest(c, ctx, method, path, params, data, pathParams)
+func makeRequestOnce[GRequest any, GResponse any](c *Client, ctx context.Context, op *Operation, data *GRequest, response *GResponse) (_ *StreamResponse[GResponse], err error) {
+	method, path := op.Method, op.Path
+	data, err = operationRequest(op, data)
+	if err != nil {
+		return nil, err
+	}
+	if c.dryRun.skips(op) {
+		return nil, dryRun(c, ctx, op, data)
+	}
+	if err := c.throttle(ctx, op); err != nil {
+		return nil, err
+	}
+	release, err := c.acquireCircuit(ctx, op)
 	if err != nil {
 		return nil, err
 	}
+	defer func() { release(err) }()
+	// The request is built against the same base URL its outcome is credited
+	// to, even if a failover happens meanwhile.
+	baseURL := c.activeBaseURL(ctx)
+	defer func() { c.observeEndpoint(ctx, baseURL, err) }()
+
+	r, err := newRequest(c, ctx, baseURL, method, path, op.Query, data, op.PathParams)
+	if err != nil {
+		return nil, err
+	}
+	for k, vs := range op.Header {
+		r.Header[k] = vs
+	}
 
 	// Only re-marshal on opt-in (WithLogBodies) so the default path does zero
 	// extra work. GET/HEAD never carry a body.
@@ -559,22 +682,34 @@ func makeRequestOnce[GRequest any, GResponse any](c *Client, ctx context.Context
 	// r.URL.Query() is the actual built query (includes the api_key requestURL
 	// injects), not the caller's params — params alone would log an empty
 	// query for the ~246 of 316 call sites that pass nil.
-	c.logRequestSent(method, path, r.URL.Query(), reqBody)
+	c.logRequestSent(ctx, method, path, r.URL.Query(), reqBody)
 
 	start := time.Now()
 	resp, err := c.httpClient.Do(r)
+	if r.Body != nil {
+		// http.Client closes the body itself, but a custom HttpClient may
+		// not; a streamed upload's writer only stops once it is closed.
+		r.Body.Close()
+	}
 	if err != nil {
+		op.Duration = time.Since(start)
 		return nil, wrapTransportError(err)
 	}
 	defer resp.Body.Close()
+	op.StatusCode = resp.StatusCode
+	op.RateLimit = NewRateLimitFromHeaders(resp.Header)
+	if c.rateLimiter != nil {
+		c.rateLimiter.observe(op.Name(), op.RateLimit)
+	}
 
 	b, err := io.ReadAll(resp.Body)
+	op.Duration = time.Since(start)
 	if err != nil {
 		return nil, wrapTransportError(err)
 	}
+	op.ResponseSize = len(b)
 
-	duration := time.Since(start)
-	c.logResponseReceived(method, path, resp.StatusCode, len(b), duration, b)
+	c.logResponseReceived(ctx, method, path, resp.StatusCode, len(b), op.Duration, b)
 
 	return parseResponse(c, resp, b, response)
 }
@@ -582,5 +717,5 @@ func makeRequestOnce[GRequest any, GResponse any](c *Client, ctx context.Context
 // addRateLimitInfo adds rate limit information to the result
 func addRateLimitInfo[Gresponse any](headers http.Header, result *Gresponse) (*StreamResponse[Gresponse], error) {
 	rateLimit := NewRateLimitFromHeaders(headers)
-	return &StreamResponse[Gresponse]{RateLimitInfo: rateLimit, Data: *result}, nil
+	return &StreamResponse[Gresponse]{RateLimitInfo: rateLimit, RequestID: headers.Get(RequestIDHeader), Data: *result}, nil
 }
@@ -27,7 +27,7 @@ const getBodyTestJSON = `{"foo":"bar"}`
 func TestNewRequest_JSONBody_SetsGetBodyAndContentLength(t *testing.T) {
 	client, _ := newClient("k", "s", WithBaseUrl("https://api.example.com"))
 
-	req, err := newRequest(client, context.Background(), http.MethodPost, "/v1/x", nil, &getBodyTestRequest{Foo: "bar"}, nil)
+	req, err := newRequest(client, context.Background(), client.BaseUrl(), http.MethodPost, "/v1/x", nil, &getBodyTestRequest{Foo: "bar"}, nil)
 	require.NoError(t, err)
 	require.NotNil(t, req.GetBody, "JSON body must be replayable")
 	require.Equal(t, int64(len(getBodyTestJSON)), req.ContentLength)
@@ -52,7 +52,7 @@ func TestNewRequest_JSONBody_SetsGetBodyAndContentLength(t *testing.T) {
 func TestNewRequest_GetBody_RewindableAfterDrain(t *testing.T) {
 	client, _ := newClient("k", "s", WithBaseUrl("https://api.example.com"))
 
-	req, err := newRequest(client, context.Background(), http.MethodPost, "/v1/x", nil, &getBodyTestRequest{Foo: "bar"}, nil)
+	req, err := newRequest(client, context.Background(), client.BaseUrl(), http.MethodPost, "/v1/x", nil, &getBodyTestRequest{Foo: "bar"}, nil)
 	require.NoError(t, err)
 
 	drained, err := io.ReadAll(req.Body)
@@ -71,7 +71,7 @@ func TestNewRequest_JSONBody_WireBytesUnchanged(t *testing.T) {
 	client, _ := newClient("k", "s", WithBaseUrl("https://api.example.com"))
 	data := map[string]any{"field1": "value1", "field2": 2}
 
-	req, err := newRequest(client, context.Background(), http.MethodPost, "/v1/x", nil, data, nil)
+	req, err := newRequest(client, context.Background(), client.BaseUrl(), http.MethodPost, "/v1/x", nil, data, nil)
 	require.NoError(t, err)
 
 	want, err := json.Marshal(data)
@@ -89,7 +89,7 @@ func TestNewRequest_Multipart_SetsRetryableBody(t *testing.T) {
 	require.NoError(t, os.WriteFile(path, []byte("hello-file-content"), 0o600))
 
 	client, _ := newClient("k", "s", WithBaseUrl("https://api.example.com"))
-	req, err := newRequest(client, context.Background(), http.MethodPost, "/upload", nil, &UploadFileRequest{File: PtrTo(path)}, nil)
+	req, err := newRequest(client, context.Background(), client.BaseUrl(), http.MethodPost, "/upload", nil, &UploadFileRequest{File: PtrTo(path)}, nil)
 	require.NoError(t, err)
 	require.NotNil(t, req.GetBody, "multipart upload must be replayable")
 	require.True(t, strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data"))
@@ -111,7 +111,7 @@ func TestNewRequest_GET_NoBody(t *testing.T) {
 	client, _ := newClient("k", "s", WithBaseUrl("https://api.example.com"))
 	var data any
 
-	req, err := newRequest(client, context.Background(), http.MethodGet, "/v1/x", nil, data, nil)
+	req, err := newRequest(client, context.Background(), client.BaseUrl(), http.MethodGet, "/v1/x", nil, data, nil)
 	require.NoError(t, err)
 	require.Nil(t, req.Body)
 	require.Nil(t, req.GetBody)
@@ -121,7 +121,7 @@ func TestNewRequest_GET_NoBody(t *testing.T) {
 func TestNewRequest_StreamingReader_NoGetBody(t *testing.T) {
 	client, _ := newClient("k", "s", WithBaseUrl("https://api.example.com"))
 
-	req, err := newRequest(client, context.Background(), http.MethodPut, "/v1/x", nil, strings.NewReader("raw"), nil)
+	req, err := newRequest(client, context.Background(), client.BaseUrl(), http.MethodPut, "/v1/x", nil, strings.NewReader("raw"), nil)
 	require.NoError(t, err)
 	require.NotNil(t, req.Body)
 	require.Nil(t, req.GetBody, "arbitrary streaming readers cannot be made rewindable")
@@ -136,12 +136,12 @@ func TestNewRequest_StreamingReader_NoGetBody(t *testing.T) {
 func TestLogRequestSent_PreservesBodyAndGetBody(t *testing.T) {
 	client, _ := newClient("k", "s", WithBaseUrl("https://api.example.com"), WithLogBodies(true))
 	data := &getBodyTestRequest{Foo: "bar"}
-	req, err := newRequest(client, context.Background(), http.MethodPost, "/v1/x", nil, data, nil)
+	req, err := newRequest(client, context.Background(), client.BaseUrl(), http.MethodPost, "/v1/x", nil, data, nil)
 	require.NoError(t, err)
 
 	b, err := json.Marshal(data)
 	require.NoError(t, err)
-	client.logRequestSent(http.MethodPost, "/v1/x", nil, b)
+	client.logRequestSent(context.Background(), http.MethodPost, "/v1/x", nil, b)
 
 	body, err := io.ReadAll(req.Body)
 	require.NoError(t, err)
@@ -116,7 +116,7 @@ func TestRequestURL(t *testing.T) {
 
 		expectedURL := "https://api.example.com/v1/resources?api_key=testKey&param1=value1&param2=value2"
 
-		got, err := client.requestURL(path, values, nil)
+		got, err := client.requestURL(client.BaseUrl(), path, values, nil)
 		if err != nil {
 			t.Fatalf("requestURL returned error: %v", err)
 		}
@@ -135,7 +135,7 @@ func TestRequestURL(t *testing.T) {
 
 		expectedURL := "https://api.example.com/v1/resources/123?api_key=testKey"
 
-		got, err := client.requestURL(path, values, pathParams)
+		got, err := client.requestURL(client.BaseUrl(), path, values, pathParams)
 		if err != nil {
 			t.Fatalf("requestURL returned error: %v", err)
 		}
@@ -149,7 +149,7 @@ func TestRequestURL(t *testing.T) {
 		invalidBaseURL := "://invalid-url"
 		client.baseUrl = invalidBaseURL
 
-		_, err := client.requestURL("/path", nil, nil)
+		_, err := client.requestURL(client.BaseUrl(), "/path", nil, nil)
 		if err == nil {
 			t.Fatalf("Expected error due to invalid baseUrl, got nil")
 		}
@@ -166,7 +166,7 @@ func TestRequestURL(t *testing.T) {
 
 		expectedURL := "https://api.example.com/v1/search?api_key=testKey&query=special+chars+%26%2F%3F"
 
-		got, err := client.requestURL(path, values, nil)
+		got, err := client.requestURL(client.BaseUrl(), path, values, nil)
 		if err != nil {
 			t.Fatalf("requestURL returned error: %v", err)
 		}
@@ -191,7 +191,7 @@ func TestNewRequest(t *testing.T) {
 		var data interface{}
 		pathParams := map[string]string{}
 
-		req, err := newRequest(client, ctx, method, path, params, data, pathParams)
+		req, err := newRequest(client, ctx, client.BaseUrl(), method, path, params, data, pathParams)
 		if err != nil {
 			t.Fatalf("newRequest returned error: %v", err)
 		}
@@ -228,7 +228,7 @@ func TestNewRequest(t *testing.T) {
 		}
 		pathParams := map[string]string{}
 
-		req, err := newRequest(client, ctx, method, path, params, data, pathParams)
+		req, err := newRequest(client, ctx, client.BaseUrl(), method, path, params, data, pathParams)
 		if err != nil {
 			t.Fatalf("newRequest returned error: %v", err)
 		}
@@ -264,7 +264,7 @@ func TestNewRequest(t *testing.T) {
 		var data any
 		pathParams := map[string]string{}
 
-		req, err := newRequest(client, ctx, method, path, params, data, pathParams)
+		req, err := newRequest(client, ctx, client.BaseUrl(), method, path, params, data, pathParams)
 		if err != nil {
 			t.Fatalf("newRequest returned error: %v", err)
 		}
@@ -291,7 +291,7 @@ func TestNewRequest(t *testing.T) {
 			"id": "123",
 		}
 
-		req, err := newRequest(client, ctx, method, path, params, data, pathParams)
+		req, err := newRequest(client, ctx, client.BaseUrl(), method, path, params, data, pathParams)
 		if err != nil {
 			t.Fatalf("newRequest returned error: %v", err)
 		}
@@ -320,7 +320,7 @@ func TestNewRequest(t *testing.T) {
 		ctx := context.Background()
 		unsupportedData := make(chan int)
 
-		req, err := newRequest(client, ctx, http.MethodPost, "/example", nil, unsupportedData, nil)
+		req, err := newRequest(client, ctx, client.BaseUrl(), http.MethodPost, "/example", nil, unsupportedData, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, req)
 		assert.Nil(t, req.Body) // The body should be nil for unsupported types
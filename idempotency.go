@@ -0,0 +1,95 @@
+package getstream
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeyHeader is the request header carrying the idempotency key of
+// a write. All attempts of one logical call send the same key.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotentOperations are the writes that can be resent as-is: upserts keyed
+// by IDs in the payload and set/unset partial updates. PUT and DELETE are
+// idempotent by definition and not listed.
+var idempotentOperations = map[string]bool{
+	"POST /api/v2/users":                                true,
+	"PATCH /api/v2/users":                               true,
+	"POST /api/v2/push_preferences":                     true,
+	"POST /api/v2/push_providers":                       true,
+	"POST /api/v2/push_templates":                       true,
+	"PATCH /api/v2/chat/channels/{type}/{id}":           true,
+	"PATCH /api/v2/chat/channels/{type}/{id}/member":    true,
+	"PATCH /api/v2/chat/threads/{message_id}":           true,
+	"PATCH /api/v2/polls/{poll_id}":                     true,
+	"POST /api/v2/feeds/follows/upsert":                 true,
+	"POST /api/v2/feeds/follows/batch/upsert":           true,
+	"POST /api/v2/feeds/unfollow/upsert":                true,
+	"POST /api/v2/feeds/unfollow/batch/upsert":          true,
+	"POST /api/v2/moderation/action_config":             true,
+	"POST /api/v2/moderation/action_config/bulk":        true,
+	"POST /api/v2/moderation/config":                    true,
+	"POST /api/v2/moderation/feeds_moderation_template": true,
+	"POST /api/v2/moderation/moderation_rule":           true,
+	"POST /api/v2/moderation/setup":                     true,
+}
+
+// isIdempotentWrite reports whether a write may be resent without risking a
+// duplicate: PUT/DELETE, the built-in and configured allow-lists, and creates
+// that carry a client-supplied ID.
+func (cfg RetryConfig) isIdempotentWrite(op *Operation) bool {
+	switch op.Method {
+	case http.MethodPut, http.MethodDelete:
+		return true
+	}
+	name := op.Name()
+	if idempotentOperations[name] {
+		return true
+	}
+	for _, extra := range cfg.IdempotentOperations {
+		if extra == name {
+			return true
+		}
+	}
+	switch req := op.Request.(type) {
+	case *SendMessageRequest:
+		return req.Message.ID != nil && *req.Message.ID != ""
+	case *AddActivityRequest:
+		return req.ID != nil && *req.ID != ""
+	case *UpsertActivitiesRequest:
+		for _, a := range req.Activities {
+			if a.ID == nil || *a.ID == "" {
+				return false
+			}
+		}
+		return len(req.Activities) > 0
+	}
+	return false
+}
+
+// honoursIdempotencyKey reports whether the backend deduplicates op by its
+// Idempotency-Key header, per IdempotencyKeyOperations.
+func (cfg RetryConfig) honoursIdempotencyKey(op *Operation) bool {
+	name := op.Name()
+	for _, keyed := range cfg.IdempotencyKeyOperations {
+		if keyed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// idempotencyKey returns the key for a logical call: the caller's (see
+// RequestIdempotencyKey), a new one for writes when RetryConfig.IdempotencyKeys
+// is set, or "" for none.
+func (c *Client) idempotencyKey(ctx context.Context, method string) string {
+	if key := requestOptionsFromContext(ctx).idempotencyKey; key != "" {
+		return key
+	}
+	if !c.retryPolicy(ctx).IdempotencyKeys || method == http.MethodGet || method == http.MethodHead {
+		return ""
+	}
+	return uuid.NewString()
+}
@@ -0,0 +1,144 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func resetThenOK() []func() (*http.Response, error) {
+	return []func() (*http.Response, error){
+		func() (*http.Response, error) { return nil, syscall.ECONNRESET },
+		canned(200, `{}`, nil),
+	}
+}
+
+func TestIdempotentWriteNotRetriedByDefault(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c := newTestClient(t, fake, WithRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond}))
+
+	_, err := c.UpdateUsers(context.Background(), &UpdateUsersRequest{Users: map[string]UserRequest{"u": {ID: "u"}}})
+	if !errors.Is(err, ErrTransport) || fake.calls != 1 {
+		t.Fatalf("want single failed call, got calls=%d err=%v", fake.calls, err)
+	}
+	if fake.keys()[0] != "" {
+		t.Fatalf("want no idempotency key by default, got %q", fake.keys()[0])
+	}
+}
+
+func TestRetryIdempotentWritesAllowList(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c := newTestClient(t, fake, WithRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond, RetryIdempotentWrites: true}))
+
+	_, err := c.UpdateUsers(context.Background(), &UpdateUsersRequest{Users: map[string]UserRequest{"u": {ID: "u"}}})
+	if err != nil || fake.calls != 2 {
+		t.Fatalf("want upsert retried to success, got calls=%d err=%v", fake.calls, err)
+	}
+}
+
+func TestRetryIdempotentWritesClientSuppliedID(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c := newTestClient(t, fake, WithRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond, RetryIdempotentWrites: true}))
+
+	_, err := c.Chat().SendMessage(context.Background(), "messaging", "general",
+		&SendMessageRequest{Message: MessageRequest{ID: PtrTo("msg-1"), Text: PtrTo("hi")}})
+	if err != nil || fake.calls != 2 {
+		t.Fatalf("want message with ID retried, got calls=%d err=%v", fake.calls, err)
+	}
+
+	fake = &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c = newTestClient(t, fake, WithRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond, RetryIdempotentWrites: true}))
+	_, err = c.Chat().SendMessage(context.Background(), "messaging", "general",
+		&SendMessageRequest{Message: MessageRequest{Text: PtrTo("hi")}})
+	if !errors.Is(err, ErrTransport) || fake.calls != 1 {
+		t.Fatalf("want message without ID sent once, got calls=%d err=%v", fake.calls, err)
+	}
+}
+
+func TestRetryIdempotentWritesExtraOperations(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c := newTestClient(t, fake, WithRetry(RetryConfig{
+		Enabled:               true,
+		MaxBackoff:            time.Millisecond,
+		RetryIdempotentWrites: true,
+		IdempotentOperations:  []string{"POST /api/v2/chat/channels/{type}/{id}/read"},
+	}))
+
+	_, err := c.Chat().MarkRead(context.Background(), "messaging", "general", &MarkReadRequest{})
+	if err != nil || fake.calls != 2 {
+		t.Fatalf("want configured operation retried, got calls=%d err=%v", fake.calls, err)
+	}
+}
+
+func TestIdempotencyKeyReusedAcrossAttempts(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c := newTestClient(t, fake, WithRetry(RetryConfig{
+		Enabled:                  true,
+		MaxBackoff:               time.Millisecond,
+		IdempotencyKeys:          true,
+		IdempotencyKeyOperations: []string{"POST /api/v2/chat/channels/{type}/{id}/message"},
+	}))
+
+	_, err := c.Chat().SendMessage(context.Background(), "messaging", "general",
+		&SendMessageRequest{Message: MessageRequest{Text: PtrTo("hi")}})
+	if err != nil || fake.calls != 2 {
+		t.Fatalf("want keyed write retried, got calls=%d err=%v", fake.calls, err)
+	}
+	if fake.keys()[0] == "" || fake.keys()[0] != fake.keys()[1] {
+		t.Fatalf("want the same non-empty key on both attempts, got %q", fake.keys())
+	}
+
+	first := fake.keys()[0]
+	fake.scriptedRetryClient = scriptedRetryClient{responses: resetThenOK()}
+	fake.headers = nil
+	if _, err := c.Chat().SendMessage(context.Background(), "messaging", "general",
+		&SendMessageRequest{Message: MessageRequest{Text: PtrTo("hi")}}); err != nil {
+		t.Fatal(err)
+	}
+	if fake.keys()[0] == "" || fake.keys()[0] == first {
+		t.Fatalf("want a fresh key per call, got %q after %q", fake.keys()[0], first)
+	}
+}
+
+func TestIdempotencyKeyPerCall(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c := newTestClient(t, fake, WithRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond, IdempotencyKeyOperations: []string{"POST /api/v2/feeds/activities"}}))
+
+	_, err := c.Feeds().AddActivity(context.Background(), &AddActivityRequest{Type: "post", Feeds: []string{"user:1"}},
+		RequestIdempotencyKey("order-42"))
+	if err != nil || fake.calls != 2 {
+		t.Fatalf("want keyed write retried, got calls=%d err=%v", fake.calls, err)
+	}
+	if fake.keys()[0] != "order-42" || fake.keys()[1] != "order-42" {
+		t.Fatalf("want caller key on every attempt, got %q", fake.keys())
+	}
+}
+
+func TestIdempotencyKeyOnlyRetriedWhereHonoured(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c := newTestClient(t, fake, WithRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond, IdempotencyKeys: true}))
+
+	_, err := c.Chat().SendMessage(context.Background(), "messaging", "general",
+		&SendMessageRequest{Message: MessageRequest{Text: PtrTo("hi")}})
+	if !errors.Is(err, ErrTransport) || fake.calls != 1 {
+		t.Fatalf("want keyed write to an unlisted endpoint sent once, got calls=%d err=%v", fake.calls, err)
+	}
+	if fake.keys()[0] == "" {
+		t.Fatal("want the key sent anyway")
+	}
+}
+
+func TestIdempotencyKeyNotSentOnGet(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c := newTestClient(t, fake, WithRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond, IdempotencyKeys: true}))
+
+	if _, err := c.GetApp(context.Background(), &GetAppRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if fake.keys()[0] != "" {
+		t.Fatalf("want no key on GET, got %q", fake.keys()[0])
+	}
+}
@@ -0,0 +1,145 @@
+package getstream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Operation describes a single API call attempt as seen by an Interceptor.
+type Operation struct {
+	// Method is the HTTP method, e.g. "POST".
+	Method string
+	// Path is the unexpanded path template, e.g. "/api/v2/chat/channels/{type}/{id}".
+	Path string
+	// PathParams holds the raw (unescaped) values substituted into Path.
+	PathParams map[string]string
+	// Query is the caller-supplied query string, before the client adds
+	// api_key. It is a copy per attempt and safe to modify.
+	Query url.Values
+	// Request is the typed request body (e.g. *SendMessageRequest), or nil for
+	// calls that send none. It points at the caller's value, so replace it
+	// with a modified copy of the same type rather than mutating it.
+	Request any
+	// Header holds extra headers set on the outgoing HTTP request. SDK-managed
+	// headers (Authorization, X-Stream-Client, ...) are applied first and may
+	// be overridden here.
+	Header http.Header
+	// Attempt is the 0-indexed attempt number within the retry loop.
+	Attempt int
+
+	// StatusCode, ResponseSize, RateLimit and Duration describe the HTTP
+	// response once next has returned, for both successful and API-error
+	// responses. They stay zero when no response was received (e.g. a
+	// transport error), except Duration, which is set whenever the request
+	// reached the transport.
+	StatusCode   int
+	ResponseSize int
+	RateLimit    *RateLimitInfo
+	Duration     time.Duration
+}
+
+// Name returns the stable, low-cardinality name of the operation: the method
+// and path template, e.g. "GET /api/v2/chat/channels/{type}/{id}". Use it to
+// label telemetry instead of the expanded URL.
+func (o *Operation) Name() string {
+	return o.Method + " " + o.Path
+}
+
+// Invoker performs the wrapped attempt. On success the returned value is the
+// *StreamResponse[T] of the generated method being called.
+type Invoker func(ctx context.Context, op *Operation) (any, error)
+
+// Interceptor wraps every API call attempt. It may inspect or modify op,
+// call next (zero or more times), and inspect or replace the response and
+// error. A non-nil response must keep the *StreamResponse[T] type next
+// returned.
+//
+//	audit := func(ctx context.Context, op *getstream.Operation, next getstream.Invoker) (any, error) {
+//		op.Header.Set("X-Request-Source", "billing-worker")
+//		res, err := next(ctx, op)
+//		log.Printf("%s %s attempt=%d err=%v", op.Method, op.Path, op.Attempt, err)
+//		return res, err
+//	}
+type Interceptor func(ctx context.Context, op *Operation, next Invoker) (any, error)
+
+// WithInterceptors appends interceptors to the client's chain. The first
+// interceptor is the outermost; each one runs once per attempt, so a call
+// retried by WithRetry passes through the chain again. Unlike WithHTTPClient,
+// the SDK's default transport tuning is kept.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(c *Client) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// invoke runs op through the interceptor chain, ending in final.
+func (c *Client) invoke(ctx context.Context, op *Operation, final Invoker) (any, error) {
+	next := final
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, op *Operation) (any, error) {
+			return interceptor(ctx, op, inner)
+		}
+	}
+	return next(ctx, op)
+}
+
+// operationRequest returns the request body to send for op: data, or the
+// value an interceptor replaced op.Request with.
+func operationRequest[GRequest any](op *Operation, data *GRequest) (*GRequest, error) {
+	if op.Request == nil {
+		return nil, nil
+	}
+	req, ok := op.Request.(*GRequest)
+	if !ok {
+		return nil, fmt.Errorf("interceptor set Operation.Request to %T, want %T", op.Request, data)
+	}
+	return req, nil
+}
+
+// invokeOnce runs a single attempt of op through the interceptor chain and
+// converts the result back to its typed form.
+func invokeOnce[GRequest any, GResponse any](c *Client, ctx context.Context, op *Operation, data *GRequest, response *GResponse) (*StreamResponse[GResponse], error) {
+	if len(c.interceptors) == 0 {
+		return makeRequestOnce(c, ctx, op, data, response)
+	}
+	out, err := c.invoke(ctx, op, func(ctx context.Context, op *Operation) (any, error) {
+		return makeRequestOnce(c, ctx, op, data, response)
+	})
+	if out == nil {
+		return nil, err
+	}
+	result, ok := out.(*StreamResponse[GResponse])
+	if !ok {
+		return nil, fmt.Errorf("interceptor returned %T, want %T", out, result)
+	}
+	return result, err
+}
+
+// newOperation builds the Operation for a MakeRequest call. params and
+// pathParams are copied so interceptors never modify the caller's values or
+// observe the escaped values used on the wire.
+func newOperation(method, path string, params url.Values, data any, pathParams map[string]string) *Operation {
+	op := &Operation{
+		Method: method,
+		Path:   path,
+		Query:  make(url.Values, len(params)),
+		Header: http.Header{},
+	}
+	for k, vs := range params {
+		op.Query[k] = append([]string(nil), vs...)
+	}
+	if data != nil {
+		op.Request = data
+	}
+	if pathParams != nil {
+		op.PathParams = make(map[string]string, len(pathParams))
+		for k, v := range pathParams {
+			op.PathParams[k] = v
+		}
+	}
+	return op
+}
@@ -0,0 +1,179 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// headerCapturingClient records the outgoing requests and answers 200 {}.
+type headerCapturingClient struct {
+	requests []*http.Request
+}
+
+func (s *headerCapturingClient) Do(r *http.Request) (*http.Response, error) {
+	s.requests = append(s.requests, r)
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{"duration":"1ms"}`))}, nil
+}
+
+func TestInterceptors_OrderAndOperation(t *testing.T) {
+	fake := &headerCapturingClient{}
+	var trace []string
+	var seen *Operation
+	mk := func(name string) Interceptor {
+		return func(ctx context.Context, op *Operation, next Invoker) (any, error) {
+			trace = append(trace, name+">")
+			seen = op
+			res, err := next(ctx, op)
+			trace = append(trace, "<"+name)
+			return res, err
+		}
+	}
+	client, err := NewClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}),
+		WithInterceptors(mk("a")), WithInterceptors(mk("b")))
+	require.NoError(t, err)
+
+	req := &SendMessageRequest{Message: MessageRequest{Text: PtrTo("hi")}}
+	res, err := client.Chat().SendMessage(context.Background(), "messaging", "a b", req)
+	require.NoError(t, err)
+	require.Equal(t, "1ms", res.Data.Duration)
+
+	require.Equal(t, []string{"a>", "b>", "<b", "<a"}, trace)
+	require.Equal(t, http.MethodPost, seen.Method)
+	require.Equal(t, "/api/v2/chat/channels/{type}/{id}/message", seen.Path)
+	require.Equal(t, map[string]string{"type": "messaging", "id": "a b"}, seen.PathParams, "path params must stay unescaped")
+	require.Same(t, req, seen.Request)
+	require.Equal(t, 0, seen.Attempt)
+}
+
+func TestInterceptors_HeaderInjection(t *testing.T) {
+	fake := &headerCapturingClient{}
+	inject := func(ctx context.Context, op *Operation, next Invoker) (any, error) {
+		op.Header.Set("X-Audit", "job-42")
+		return next(ctx, op)
+	}
+	client, err := NewClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}), WithInterceptors(inject))
+	require.NoError(t, err)
+
+	_, err = client.GetApp(context.Background(), &GetAppRequest{})
+	require.NoError(t, err)
+	require.Len(t, fake.requests, 1)
+	require.Equal(t, "job-42", fake.requests[0].Header.Get("X-Audit"))
+	require.NotEmpty(t, fake.requests[0].Header.Get("Authorization"), "SDK headers must still be set")
+}
+
+func TestInterceptors_QueryIsCopied(t *testing.T) {
+	fake := &headerCapturingClient{}
+	addQuery := func(ctx context.Context, op *Operation, next Invoker) (any, error) {
+		op.Query.Set("trace", "1")
+		return next(ctx, op)
+	}
+	client, err := NewClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}), WithInterceptors(addQuery))
+	require.NoError(t, err)
+
+	_, err = client.Chat().SendMessage(context.Background(), "messaging", "general", &SendMessageRequest{Message: MessageRequest{Text: PtrTo("hi")}})
+	require.NoError(t, err, "a call without query params must not panic")
+	params := url.Values{"limit": {"10"}}
+	var out map[string]any
+	_, err = MakeRequest[any, map[string]any](client.Client, context.Background(), http.MethodGet, "/api/v2/x", params, nil, &out, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, "1", fake.requests[0].URL.Query().Get("trace"))
+	require.Equal(t, "1", fake.requests[1].URL.Query().Get("trace"))
+	require.Equal(t, url.Values{"limit": {"10"}}, params, "the caller's params must not change")
+}
+
+func TestInterceptors_ReplaceRequest(t *testing.T) {
+	fake := &headerCapturingClient{}
+	redact := func(ctx context.Context, op *Operation, next Invoker) (any, error) {
+		req := *op.Request.(*SendMessageRequest)
+		req.Message.Text = PtrTo("[redacted]")
+		op.Request = &req
+		return next(ctx, op)
+	}
+	client, err := NewClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}), WithInterceptors(redact))
+	require.NoError(t, err)
+
+	req := &SendMessageRequest{Message: MessageRequest{Text: PtrTo("secret")}}
+	_, err = client.Chat().SendMessage(context.Background(), "messaging", "general", req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(fake.requests[0].Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "[redacted]")
+	require.Equal(t, "secret", *req.Message.Text)
+
+	wrongType := func(ctx context.Context, op *Operation, next Invoker) (any, error) {
+		op.Request = "nope"
+		return next(ctx, op)
+	}
+	client, err = NewClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}), WithInterceptors(wrongType))
+	require.NoError(t, err)
+	_, err = client.Chat().SendMessage(context.Background(), "messaging", "general", req)
+	require.ErrorContains(t, err, "interceptor set Operation.Request to string")
+}
+
+func TestInterceptors_FaultInjectionShortCircuits(t *testing.T) {
+	fake := &headerCapturingClient{}
+	fault := func(ctx context.Context, op *Operation, next Invoker) (any, error) {
+		return nil, wrapTransportError(syscall.ECONNRESET)
+	}
+	client, err := NewClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}), WithInterceptors(fault))
+	require.NoError(t, err)
+
+	_, err = client.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrTransport))
+	require.Empty(t, fake.requests)
+}
+
+func TestInterceptors_RunPerRetryAttempt(t *testing.T) {
+	fake := &oneShotClient{status: 429, body: `{"code":9,"message":"slow down"}`}
+	var attempts []int
+	count := func(ctx context.Context, op *Operation, next Invoker) (any, error) {
+		attempts = append(attempts, op.Attempt)
+		return next(ctx, op)
+	}
+	client, err := NewClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}),
+		WithRetry(RetryConfig{Enabled: true, MaxAttempts: 3, MaxBackoff: 1}), WithInterceptors(count))
+	require.NoError(t, err)
+
+	_, err = client.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrRateLimited))
+	require.Equal(t, []int{0, 1, 2}, attempts)
+}
+
+func TestInterceptors_WrongResponseType(t *testing.T) {
+	bad := func(ctx context.Context, op *Operation, next Invoker) (any, error) {
+		return "not a response", nil
+	}
+	client, err := NewClient("k", "s", WithHTTPClient(&headerCapturingClient{}), WithLogger(&recordingLogger{}), WithInterceptors(bad))
+	require.NoError(t, err)
+
+	_, err = client.GetApp(context.Background(), &GetAppRequest{})
+	require.ErrorContains(t, err, "interceptor returned string")
+}
+
+func TestInterceptors_ResponseMetadata(t *testing.T) {
+	fake := &windowClient{limit: 100, remaining: 7, reset: time.Now().Add(time.Minute)}
+	var seen *Operation
+	capture := func(ctx context.Context, op *Operation, next Invoker) (any, error) {
+		seen = op
+		return next(ctx, op)
+	}
+	client, err := NewClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}), WithInterceptors(capture))
+	require.NoError(t, err)
+
+	_, err = client.Chat().GetChannel(context.Background(), "messaging", "general", &GetChannelRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "GET /api/v2/chat/channels/{type}/{id}", seen.Name())
+	require.Equal(t, http.StatusOK, seen.StatusCode)
+	require.Equal(t, len(`{}`), seen.ResponseSize)
+	require.Equal(t, int64(7), seen.RateLimit.Remaining)
+}
@@ -0,0 +1,431 @@
+// Command apigen generates the ChatAPI, VideoAPI, FeedsAPI, ModerationAPI and
+// CommonAPI interfaces (api.go) and their mocks (mockgetstream/) from the
+// exported methods of the generated API clients, so both stay in sync with
+// chat.go, video.go, feeds-v3.go, moderation.go and common.go.
+//
+// Before that, it adds the trailing opts ...RequestOption parameter to the
+// API calls of the generated clients and handles (see withRequestOptions).
+//
+// generate.sh runs it after regenerating the clients; to run it by hand,
+// from the module root:
+//
+//	go generate .
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	modulePath = "github.com/GetStream/getstream-go/v5"
+	mockPkg    = "mockgetstream"
+	header     = "// Code generated by internal/apigen. DO NOT EDIT.\n\n"
+)
+
+// service is one generated client.
+type service struct {
+	iface  string // interface and mock type name
+	recv   string // receiver type of the client methods
+	source string // file declaring the client methods
+	mock   string // mock file, relative to mockgetstream/
+	doc    string // what the client covers, for doc comments
+}
+
+var services = []service{
+	{iface: "CommonAPI", recv: "Client", source: "common.go", mock: "common.go", doc: "*Client shared by all products; *Stream satisfies it too"},
+	{iface: "ChatAPI", recv: "ChatClient", source: "chat.go", mock: "chat.go", doc: "*ChatClient"},
+	{iface: "VideoAPI", recv: "VideoClient", source: "video.go", mock: "video.go", doc: "*VideoClient"},
+	{iface: "FeedsAPI", recv: "FeedsClient", source: "feeds-v3.go", mock: "feeds.go", doc: "*FeedsClient"},
+	{iface: "ModerationAPI", recv: "ModerationClient", source: "moderation.go", mock: "moderation.go", doc: "*ModerationClient"},
+}
+
+type method struct {
+	name    string
+	doc     []string
+	params  []*ast.Field // after ctx
+	results []ast.Expr
+}
+
+type parsed struct {
+	service
+	methods []method
+	imports map[string]string // package name -> import path, as used by the methods
+}
+
+func main() {
+	files, err := generate(".")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "apigen:", err)
+		os.Exit(1)
+	}
+	for name, src := range files {
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			fmt.Fprintln(os.Stderr, "apigen:", err)
+			os.Exit(1)
+		}
+		if err := os.WriteFile(name, src, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, "apigen:", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// generate returns the generated files, keyed by path, for the module rooted
+// at root.
+func generate(root string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	sources := map[string][]byte{}
+	for _, name := range optionFiles {
+		src, err := os.ReadFile(filepath.Join(root, name))
+		if err != nil {
+			return nil, err
+		}
+		if src, err = withRequestOptions(name, src); err != nil {
+			return nil, fmt.Errorf("add request options: %w", err)
+		}
+		sources[name] = src
+		files[filepath.Join(root, name)] = src
+	}
+
+	var all []parsed
+	for _, s := range services {
+		p, err := parse(s, sources[s.source])
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, p)
+	}
+
+	src, err := format.Source(interfaces(all))
+	if err != nil {
+		return nil, fmt.Errorf("format api.go: %w", err)
+	}
+	files[filepath.Join(root, "api.go")] = src
+	for _, p := range all {
+		src, err := format.Source(mock(p))
+		if err != nil {
+			return nil, fmt.Errorf("format %s: %w", p.mock, err)
+		}
+		files[filepath.Join(root, mockPkg, p.mock)] = src
+	}
+	return files, nil
+}
+
+func parse(s service, src []byte) (parsed, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, s.source, src, parser.ParseComments)
+	if err != nil {
+		return parsed{}, err
+	}
+	fileImports := map[string]string{}
+	for _, imp := range f.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		fileImports[name] = path
+	}
+
+	p := parsed{service: s, imports: map[string]string{}}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || !fn.Name.IsExported() || receiver(fn) != s.recv {
+			continue
+		}
+		m, err := newMethod(fn)
+		if err != nil {
+			return parsed{}, fmt.Errorf("%s: %s.%s: %w", s.source, s.recv, fn.Name.Name, err)
+		}
+		for _, e := range fieldTypes(fn.Type.Params.List, fn.Type.Results.List) {
+			for _, pkg := range packages(e) {
+				path, ok := fileImports[pkg]
+				if !ok {
+					return parsed{}, fmt.Errorf("%s: unknown package %s", s.source, pkg)
+				}
+				p.imports[pkg] = path
+			}
+		}
+		p.methods = append(p.methods, m)
+	}
+	if len(p.methods) == 0 {
+		return parsed{}, fmt.Errorf("%s: no exported methods on %s", s.source, s.recv)
+	}
+	return p, nil
+}
+
+func receiver(fn *ast.FuncDecl) string {
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return ""
+	}
+	id, ok := star.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return id.Name
+}
+
+// newMethod checks fn has the shape of a generated API call,
+// (ctx context.Context, ..., opts ...RequestOption) (*T, error), which the
+// mocks rely on.
+func newMethod(fn *ast.FuncDecl) (method, error) {
+	m := method{name: fn.Name.Name}
+	if fn.Doc != nil {
+		m.doc = strings.Split(strings.TrimSpace(fn.Doc.Text()), "\n")
+	}
+	params := fn.Type.Params.List
+	if len(params) == 0 || len(params[0].Names) != 1 || typeString(params[0].Type, "") != "context.Context" {
+		return m, fmt.Errorf("first parameter must be ctx context.Context")
+	}
+	for i, f := range params {
+		if len(f.Names) == 0 {
+			return m, fmt.Errorf("unnamed parameter")
+		}
+		for _, n := range f.Names {
+			if n.Name == "m" {
+				return m, fmt.Errorf("parameter name m clashes with the mock receiver")
+			}
+		}
+		if e, ok := f.Type.(*ast.Ellipsis); ok && (i != len(params)-1 || typeString(e.Elt, "") != "RequestOption") {
+			return m, fmt.Errorf("only a trailing ...RequestOption parameter is supported")
+		}
+	}
+	m.params = params[1:]
+
+	if fn.Type.Results == nil || len(fn.Type.Results.List) != 2 {
+		return m, fmt.Errorf("want (*T, error) results")
+	}
+	for _, f := range fn.Type.Results.List {
+		if len(f.Names) > 1 {
+			return m, fmt.Errorf("want (*T, error) results")
+		}
+		m.results = append(m.results, f.Type)
+	}
+	if _, ok := m.results[0].(*ast.StarExpr); !ok || typeString(m.results[1], "") != "error" {
+		return m, fmt.Errorf("want (*T, error) results")
+	}
+	return m, nil
+}
+
+func fieldTypes(lists ...[]*ast.Field) []ast.Expr {
+	var out []ast.Expr
+	for _, l := range lists {
+		for _, f := range l {
+			out = append(out, f.Type)
+		}
+	}
+	return out
+}
+
+// packages returns the package qualifiers used in e.
+func packages(e ast.Expr) []string {
+	var out []string
+	ast.Inspect(e, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				out = append(out, id.Name)
+			}
+			return false
+		}
+		return true
+	})
+	return out
+}
+
+// typeString prints e. With a non-empty pkg, exported identifiers of the
+// getstream package are qualified with it.
+func typeString(e ast.Expr, pkg string) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if pkg != "" && t.IsExported() {
+			return pkg + "." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		return typeString(t.X, "") + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X, pkg)
+	case *ast.Ellipsis:
+		return "..." + typeString(t.Elt, pkg)
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "[" + typeString(t.Len, "") + "]" + typeString(t.Elt, pkg)
+		}
+		return "[]" + typeString(t.Elt, pkg)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key, pkg) + "]" + typeString(t.Value, pkg)
+	case *ast.IndexExpr:
+		return typeString(t.X, pkg) + "[" + typeString(t.Index, pkg) + "]"
+	case *ast.IndexListExpr:
+		args := make([]string, len(t.Indices))
+		for i, idx := range t.Indices {
+			args[i] = typeString(idx, pkg)
+		}
+		return typeString(t.X, pkg) + "[" + strings.Join(args, ", ") + "]"
+	case *ast.BasicLit:
+		return t.Value
+	case *ast.InterfaceType:
+		if t.Methods == nil || len(t.Methods.List) == 0 {
+			return "interface{}"
+		}
+	}
+	panic(fmt.Sprintf("apigen: unsupported type expression %T", e))
+}
+
+// signature prints the parameters and results of m, ctx included, and the
+// arguments passing the parameters on.
+func signature(m method, pkg string) (params, args, results string) {
+	ps := []string{"ctx context.Context"}
+	as := []string{"ctx"}
+	for _, f := range m.params {
+		_, variadic := f.Type.(*ast.Ellipsis)
+		for _, n := range f.Names {
+			ps = append(ps, n.Name+" "+typeString(f.Type, pkg))
+			if variadic {
+				as = append(as, n.Name+"...")
+			} else {
+				as = append(as, n.Name)
+			}
+		}
+	}
+	rs := make([]string, len(m.results))
+	for i, r := range m.results {
+		rs[i] = typeString(r, pkg)
+	}
+	return strings.Join(ps, ", "), strings.Join(as, ", "), "(" + strings.Join(rs, ", ") + ")"
+}
+
+func writeImports(b *bytes.Buffer, imports map[string]string, extra ...string) {
+	paths := map[string]bool{}
+	for _, p := range extra {
+		paths[p] = true
+	}
+	for _, p := range imports {
+		paths[p] = true
+	}
+	b.WriteString("import (\n")
+	var std, other []string
+	for _, p := range sortedKeys(paths) {
+		if strings.Contains(strings.SplitN(p, "/", 2)[0], ".") {
+			other = append(other, p)
+		} else {
+			std = append(std, p)
+		}
+	}
+	for _, p := range std {
+		fmt.Fprintf(b, "\t%q\n", p)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		b.WriteString("\n")
+	}
+	for _, p := range other {
+		fmt.Fprintf(b, "\t%q\n", p)
+	}
+	b.WriteString(")\n\n")
+}
+
+func interfaces(all []parsed) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package getstream\n\n")
+	imports := map[string]string{}
+	for _, p := range all {
+		for k, v := range p.imports {
+			imports[k] = v
+		}
+	}
+	writeImports(&b, imports)
+
+	b.WriteString("var (\n")
+	for _, p := range all {
+		fmt.Fprintf(&b, "\t_ %s = (*%s)(nil)\n", p.iface, p.recv)
+		if p.recv == "Client" {
+			fmt.Fprintf(&b, "\t_ %s = (*Stream)(nil)\n", p.iface)
+		}
+	}
+	b.WriteString(")\n")
+
+	for _, p := range all {
+		b.WriteString("\n")
+		writeComment(&b, "", fmt.Sprintf("%s is the set of API calls of %s. Depend on it rather than on the concrete client to substitute %s.%s in tests.", p.iface, p.doc, mockPkg, p.iface))
+		fmt.Fprintf(&b, "type %s interface {\n", p.iface)
+		for i, m := range p.methods {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			for _, line := range m.doc {
+				fmt.Fprintf(&b, "\t// %s\n", line)
+			}
+			params, _, results := signature(m, "")
+			fmt.Fprintf(&b, "\t%s(%s) %s\n", m.name, params, results)
+		}
+		b.WriteString("}\n")
+	}
+	return b.Bytes()
+}
+
+func mock(p parsed) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", mockPkg)
+	writeImports(&b, p.imports, modulePath)
+
+	fmt.Fprintf(&b, "var _ getstream.%s = (*%s)(nil)\n\n", p.iface, p.iface)
+	fmt.Fprintf(&b, "// %s is a mock getstream.%s. Each method records the call and\n", p.iface, p.iface)
+	b.WriteString("// calls the matching Func field if set; otherwise it returns an empty\n")
+	b.WriteString("// response and no error. Set Func fields before use.\n")
+	fmt.Fprintf(&b, "type %s struct {\n", p.iface)
+	b.WriteString("\tRecorder\n\n")
+	for _, m := range p.methods {
+		params, _, results := signature(m, "getstream")
+		fmt.Fprintf(&b, "\t%sFunc func(%s) %s\n", m.name, params, results)
+	}
+	b.WriteString("}\n")
+
+	for _, m := range p.methods {
+		params, args, results := signature(m, "getstream")
+		fmt.Fprintf(&b, "\n// %s records the call and calls %sFunc if set.\n", m.name, m.name)
+		fmt.Fprintf(&b, "func (m *%s) %s(%s) %s {\n", p.iface, m.name, params, results)
+		// The options are recorded as one []getstream.RequestOption argument.
+		fmt.Fprintf(&b, "\tm.record(%s)\n", strings.Replace(strings.TrimSuffix(args, "..."), "ctx", "ctx, "+strconv.Quote(m.name), 1))
+		fmt.Fprintf(&b, "\tif m.%sFunc != nil {\n", m.name)
+		fmt.Fprintf(&b, "\t\treturn m.%sFunc(%s)\n", m.name, args)
+		b.WriteString("\t}\n")
+		fmt.Fprintf(&b, "\treturn new(%s), nil\n", typeString(m.results[0].(*ast.StarExpr).X, "getstream"))
+		b.WriteString("}\n")
+	}
+	return b.Bytes()
+}
+
+// writeComment writes text as a // comment wrapped at 80 columns.
+func writeComment(b *bytes.Buffer, indent, text string) {
+	line := indent + "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 80 && line != indent+"//" {
+			b.WriteString(line + "\n")
+			line = indent + "//"
+		}
+		line += " " + word
+	}
+	b.WriteString(line + "\n")
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedFilesUpToDate fails when the API clients changed without
+// rerunning the generator.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	files, err := generate("../..")
+	require.NoError(t, err)
+	for name, want := range files {
+		got, err := os.ReadFile(name)
+		require.NoError(t, err)
+		require.Equal(t, string(want), string(got), "%s is stale, run go generate in the module root", name)
+	}
+}
+
+func TestWithRequestOptions(t *testing.T) {
+	src := `package getstream
+
+func (c *ChatClient) GetDraft(ctx context.Context, id string, request *GetDraftRequest) (*StreamResponse[GetDraftResponse], error) {
+	var result GetDraftResponse
+	res, err := MakeRequest[any, GetDraftResponse](c.client, ctx, "GET", "/drafts/{id}", nil, nil, &result, nil)
+	return res, err
+}
+
+func (c *Channels) GetDraft(ctx context.Context, request *GetDraftRequest) (*StreamResponse[GetDraftResponse], error) {
+	return c.client.GetDraft(ctx, c.id, request)
+}
+`
+	want := `package getstream
+
+func (c *ChatClient) GetDraft(ctx context.Context, id string, request *GetDraftRequest, opts ...RequestOption) (*StreamResponse[GetDraftResponse], error) {
+	var result GetDraftResponse
+	res, err := MakeRequest[any, GetDraftResponse](c.client, ctx, "GET", "/drafts/{id}", nil, nil, &result, nil, opts...)
+	return res, err
+}
+
+func (c *Channels) GetDraft(ctx context.Context, request *GetDraftRequest, opts ...RequestOption) (*StreamResponse[GetDraftResponse], error) {
+	return c.client.GetDraft(ctx, c.id, request, opts...)
+}
+`
+	got, err := withRequestOptions("chat.go", []byte(src))
+	require.NoError(t, err)
+	require.Equal(t, want, string(got))
+
+	again, err := withRequestOptions("chat.go", got)
+	require.NoError(t, err)
+	require.Equal(t, want, string(again), "the rewrite must be idempotent")
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+)
+
+// optionFiles are the generated files whose API calls take per-call request
+// options: the clients and the Call, Channels and Feeds handles wrapping them.
+var optionFiles = []string{"common.go", "chat.go", "video.go", "feeds-v3.go", "moderation.go", "call.go", "channel.go", "feeds.go"}
+
+// optionsParam is the trailing parameter added to every API call.
+const optionsParam = "opts ...RequestOption"
+
+// withRequestOptions returns src with opts ...RequestOption appended to the
+// parameters of every API call, (ctx context.Context, ...) (*StreamResponse[T],
+// error), and passed on to the MakeRequest or client call it wraps. The
+// OpenAPI generator does not emit the parameter. Calls that already take it
+// are left alone, so the rewrite is idempotent.
+func withRequestOptions(name string, src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	type edit struct {
+		pos  token.Pos
+		text string
+	}
+	var edits []edit
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Body == nil || !isAPICall(fn) {
+			continue
+		}
+		params := fn.Type.Params.List
+		if _, ok := params[len(params)-1].Type.(*ast.Ellipsis); ok {
+			continue
+		}
+		calls := forwardedCalls(fn.Body)
+		if len(calls) == 0 {
+			return nil, fmt.Errorf("%s: %s: no MakeRequest or client call to pass opts to", name, fn.Name.Name)
+		}
+		edits = append(edits, edit{params[len(params)-1].End(), ", " + optionsParam})
+		for _, call := range calls {
+			edits = append(edits, edit{call.Args[len(call.Args)-1].End(), ", opts..."})
+		}
+	}
+	if len(edits) == 0 {
+		return src, nil
+	}
+	sort.Slice(edits, func(i, j int) bool { return edits[i].pos > edits[j].pos })
+	out := append([]byte(nil), src...)
+	for _, e := range edits {
+		off := fset.Position(e.pos).Offset
+		out = append(out[:off], append([]byte(e.text), out[off:]...)...)
+	}
+	return format.Source(out)
+}
+
+// isAPICall reports whether fn has the shape of a generated API call,
+// (ctx context.Context, ...) (*StreamResponse[T], error).
+func isAPICall(fn *ast.FuncDecl) bool {
+	params := fn.Type.Params.List
+	if len(params) == 0 || typeString(params[0].Type, "") != "context.Context" {
+		return false
+	}
+	if fn.Type.Results == nil || len(fn.Type.Results.List) != 2 {
+		return false
+	}
+	star, ok := fn.Type.Results.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	index, ok := star.X.(*ast.IndexExpr)
+	if !ok {
+		return false
+	}
+	id, ok := index.X.(*ast.Ident)
+	return ok && id.Name == "StreamResponse"
+}
+
+// forwardedCalls returns the calls in body that make the request: MakeRequest
+// in the clients, c.client.Method(ctx, ...) in the handles.
+func forwardedCalls(body *ast.BlockStmt) []*ast.CallExpr {
+	var out []*ast.CallExpr
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.IndexListExpr:
+			if id, ok := fun.X.(*ast.Ident); ok && id.Name == "MakeRequest" {
+				out = append(out, call)
+			}
+		case *ast.SelectorExpr:
+			recv, ok := fun.X.(*ast.SelectorExpr)
+			if ok && recv.Sel.Name == "client" && isIdent(call.Args[0], "ctx") {
+				out = append(out, call)
+			}
+		}
+		return true
+	})
+	return out
+}
+
+func isIdent(e ast.Expr, name string) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && id.Name == name
+}
@@ -1,6 +1,8 @@
 package getstream
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"log"
 	"os"
@@ -89,3 +91,74 @@ func (l *DefaultLogger) Error(format string, v ...interface{}) {
 
 // DefaultLoggerInstance is the default logger instance.
 var DefaultLoggerInstance Logger = NewDefaultLogger(os.Stderr, "", log.LstdFlags, LogLevelInfo)
+
+// LogField is a typed key/value attribute on a structured log event.
+type LogField struct {
+	Key   string
+	Value any
+}
+
+// StructuredLogger receives the SDK's log events with typed attributes
+// instead of pre-formatted printf lines. event is the event name (e.g.
+// "http.request.sent") or, for free-form messages, the message itself. ctx is
+// the context of the API call that produced the event, or
+// context.Background() for client-level events.
+//
+// See NewSlogLogger for a log/slog adapter (Go 1.21+).
+type StructuredLogger interface {
+	Log(ctx context.Context, level LogLevel, event string, fields ...LogField)
+}
+
+// WithStructuredLogger routes the client's log events to l with typed
+// attributes. It takes precedence over WithLogger for the SDK's own events.
+// Without WithLogger, Client.Logger() returns a printf adapter that forwards
+// formatted messages to l.
+func WithStructuredLogger(l StructuredLogger) ClientOption {
+	return func(c *Client) {
+		c.structuredLogger = l
+	}
+}
+
+type logFieldsKey struct{}
+
+// ContextWithLogFields returns a copy of ctx carrying fields that are appended
+// to every log event of API calls made with it, e.g. a job or tenant ID.
+// Fields accumulate across nested calls.
+func ContextWithLogFields(ctx context.Context, fields ...LogField) context.Context {
+	existing := LogFieldsFromContext(ctx)
+	merged := make([]LogField, 0, len(existing)+len(fields))
+	merged = append(merged, existing...)
+	merged = append(merged, fields...)
+	return context.WithValue(ctx, logFieldsKey{}, merged)
+}
+
+// LogFieldsFromContext returns the fields attached with ContextWithLogFields.
+func LogFieldsFromContext(ctx context.Context) []LogField {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(logFieldsKey{}).([]LogField)
+	return fields
+}
+
+// structuredPrintfLogger adapts a StructuredLogger to the printf-style Logger
+// interface, emitting each formatted message as a field-less event.
+type structuredPrintfLogger struct {
+	l StructuredLogger
+}
+
+func (s structuredPrintfLogger) Debug(format string, v ...interface{}) {
+	s.l.Log(context.Background(), LogLevelDebug, fmt.Sprintf(format, v...))
+}
+
+func (s structuredPrintfLogger) Info(format string, v ...interface{}) {
+	s.l.Log(context.Background(), LogLevelInfo, fmt.Sprintf(format, v...))
+}
+
+func (s structuredPrintfLogger) Warn(format string, v ...interface{}) {
+	s.l.Log(context.Background(), LogLevelWarn, fmt.Sprintf(format, v...))
+}
+
+func (s structuredPrintfLogger) Error(format string, v ...interface{}) {
+	s.l.Log(context.Background(), LogLevelError, fmt.Sprintf(format, v...))
+}
@@ -0,0 +1,51 @@
+//go:build go1.21
+
+package getstream
+
+import (
+	"context"
+	"log/slog"
+)
+
+// slogLogger adapts a *slog.Logger to StructuredLogger.
+type slogLogger struct {
+	l *slog.Logger
+}
+
+// NewSlogLogger returns a StructuredLogger that writes each event as a slog
+// record with the event name as the message and one attribute per field.
+// LogLevelDebug..LogLevelError map to slog.LevelDebug..slog.LevelError.
+func NewSlogLogger(l *slog.Logger) StructuredLogger {
+	return slogLogger{l: l}
+}
+
+// WithSlogLogger routes the client's log events to l. It is shorthand for
+// WithStructuredLogger(NewSlogLogger(l)).
+func WithSlogLogger(l *slog.Logger) ClientOption {
+	return WithStructuredLogger(NewSlogLogger(l))
+}
+
+func (s slogLogger) Log(ctx context.Context, level LogLevel, event string, fields ...LogField) {
+	lvl := slogLevel(level)
+	if !s.l.Enabled(ctx, lvl) {
+		return
+	}
+	attrs := make([]slog.Attr, len(fields))
+	for i, f := range fields {
+		attrs[i] = slog.Any(f.Key, f.Value)
+	}
+	s.l.LogAttrs(ctx, lvl, event, attrs...)
+}
+
+func slogLevel(level LogLevel) slog.Level {
+	switch level {
+	case LogLevelDebug:
+		return slog.LevelDebug
+	case LogLevelInfo:
+		return slog.LevelInfo
+	case LogLevelWarn:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
@@ -0,0 +1,54 @@
+//go:build go1.21
+
+package getstream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c, err := NewClient("key", "secret", WithHTTPClient(&oneShotClient{status: 200, body: `{}`}), WithSlogLogger(l))
+	require.NoError(t, err)
+
+	var out map[string]any
+	_, err = MakeRequest[map[string]any, map[string]any](c.Client, context.Background(), http.MethodGet, "/api/v2/app", url.Values{"api_key": {"key"}}, nil, &out, nil)
+	require.NoError(t, err)
+
+	records := map[string]map[string]any{}
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var rec map[string]any
+		require.NoError(t, dec.Decode(&rec))
+		records[rec["msg"].(string)] = rec
+	}
+
+	require.Equal(t, "INFO", records["client.initialized"]["level"])
+	require.Equal(t, "getstream-go", records["client.initialized"]["stream.sdk.name"])
+	require.Equal(t, "DEBUG", records["http.request.sent"]["level"])
+	require.Equal(t, "api_key=%3Credacted%3E", records["http.request.sent"]["url.query"])
+	// JSON numbers decode as float64: the attribute was emitted as a number,
+	// not a preformatted string.
+	require.Equal(t, float64(200), records["http.response.received"]["http.response.status_code"])
+}
+
+func TestSlogLogger_RespectsLevel(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	c, err := NewClient("key", "secret", WithHTTPClient(&oneShotClient{status: 200, body: `{}`}), WithSlogLogger(l))
+	require.NoError(t, err)
+
+	_, err = c.GetApp(context.Background(), &GetAppRequest{})
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "client.initialized")
+	require.NotContains(t, buf.String(), "http.request.sent")
+}
@@ -0,0 +1,108 @@
+package getstream
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type structuredEvent struct {
+	level  LogLevel
+	event  string
+	fields map[string]any
+}
+
+type recordingStructuredLogger struct {
+	mu     sync.Mutex
+	events []structuredEvent
+}
+
+func (l *recordingStructuredLogger) Log(ctx context.Context, level LogLevel, event string, fields ...LogField) {
+	m := map[string]any{}
+	for _, f := range fields {
+		m[f.Key] = f.Value
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, structuredEvent{level: level, event: event, fields: m})
+}
+
+func (l *recordingStructuredLogger) find(event string) *structuredEvent {
+	for i := range l.events {
+		if l.events[i].event == event {
+			return &l.events[i]
+		}
+	}
+	return nil
+}
+
+func TestStructuredLogger_TypedFields(t *testing.T) {
+	rec := &recordingStructuredLogger{}
+	c, err := NewClient("key", "secret", WithHTTPClient(&oneShotClient{status: 200, body: `{}`}), WithStructuredLogger(rec))
+	require.NoError(t, err)
+
+	ctx := ContextWithLogFields(context.Background(), LogField{"job.id", "import-7"})
+	var out map[string]any
+	_, err = MakeRequest[map[string]any, map[string]any](c.Client, ctx, http.MethodGet, "/api/v2/app", url.Values{"api_key": {"key"}}, nil, &out, nil)
+	require.NoError(t, err)
+
+	init := rec.find("client.initialized")
+	require.NotNil(t, init)
+	require.Equal(t, LogLevelInfo, init.level)
+	require.Equal(t, "getstream-go", init.fields["stream.sdk.name"])
+	require.Equal(t, true, init.fields["stream.client.gzip_enabled"])
+
+	sent := rec.find("http.request.sent")
+	require.NotNil(t, sent)
+	require.Equal(t, LogLevelDebug, sent.level)
+	require.Equal(t, "api_key=%3Credacted%3E", sent.fields["url.query"])
+	require.Equal(t, "import-7", sent.fields["job.id"])
+
+	got := rec.find("http.response.received")
+	require.NotNil(t, got)
+	require.Equal(t, 200, got.fields["http.response.status_code"])
+	require.Equal(t, "import-7", got.fields["job.id"])
+}
+
+func TestStructuredLogger_TransportFailure(t *testing.T) {
+	rec := &recordingStructuredLogger{}
+	c, err := NewClient("key", "secret", WithHTTPClient(&oneShotClient{err: syscall.ECONNRESET}), WithStructuredLogger(rec))
+	require.NoError(t, err)
+
+	_, err = c.GetApp(context.Background(), &GetAppRequest{})
+	require.Error(t, err)
+
+	failed := rec.find("http.request.failed")
+	require.NotNil(t, failed)
+	require.Equal(t, LogLevelError, failed.level)
+	require.Equal(t, ErrorTypeConnectionReset, failed.fields["error.type"])
+}
+
+func TestStructuredLogger_PrintfAdapter(t *testing.T) {
+	rec := &recordingStructuredLogger{}
+	c, err := NewClient("key", "secret", WithHTTPClient(&oneShotClient{status: 200, body: `{}`}), WithStructuredLogger(rec))
+	require.NoError(t, err)
+
+	c.Logger().Warn("custom %d", 42)
+	w := rec.find("custom 42")
+	require.NotNil(t, w)
+	require.Equal(t, LogLevelWarn, w.level)
+}
+
+func TestLogFields_PrintfRendering(t *testing.T) {
+	ctx := ContextWithLogFields(context.Background(), LogField{"tenant", "acme"})
+	ctx = ContextWithLogFields(ctx, LogField{"job.id", "100%"})
+	rec := &recordingLogger{}
+	c, err := NewClient("key", "secret", WithHTTPClient(&oneShotClient{status: 200, body: `{}`}), WithLogger(rec))
+	require.NoError(t, err)
+
+	var out map[string]any
+	_, err = MakeRequest[map[string]any, map[string]any](c.Client, ctx, http.MethodGet, "/api/v2/app", nil, nil, &out, nil)
+	require.NoError(t, err)
+	require.True(t, has(rec.debug, "tenant=acme job.id=100%"), rec.debug)
+}
@@ -1,8 +1,10 @@
 package getstream
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/url"
 	"strings"
 	"time"
@@ -50,26 +52,90 @@ func redactJSONBody(b []byte) string {
 	return string(out)
 }
 
+// quotedLogFields are rendered with %q on printf loggers because their values
+// are free text.
+var quotedLogFields = map[string]struct{}{"error.message": {}}
+
+// logEvent emits a log event with typed fields plus the call's request ID and
+// any request-scoped fields from ctx. A StructuredLogger receives the fields
+// as-is; the printf Logger receives "event k=v k=v ..." in field order.
+func (c *Client) logEvent(ctx context.Context, level LogLevel, event string, fields ...LogField) {
+	if id, ok := RequestIDFromContext(ctx); ok {
+		fields = append(fields, LogField{"stream.request_id", id})
+	}
+	if ctxFields := LogFieldsFromContext(ctx); len(ctxFields) > 0 {
+		fields = append(fields, ctxFields...)
+	}
+	if c.structuredLogger != nil {
+		c.structuredLogger.Log(ctx, level, event, fields...)
+		return
+	}
+
+	// The line is passed as an argument rather than a format string, since
+	// values may contain '%', and is only rendered if the logger formats
+	// it, i.e. if its level lets the event through.
+	line := logLine{event: event, fields: fields}
+	switch level {
+	case LogLevelDebug:
+		c.logger.Debug("%s", line)
+	case LogLevelInfo:
+		c.logger.Info("%s", line)
+	case LogLevelWarn:
+		c.logger.Warn("%s", line)
+	default:
+		c.logger.Error("%s", line)
+	}
+}
+
+// logLine renders an event for the printf Logger as "event k=v k=v ...".
+type logLine struct {
+	event  string
+	fields []LogField
+}
+
+func (l logLine) String() string {
+	var b strings.Builder
+	b.WriteString(l.event)
+	for _, f := range l.fields {
+		b.WriteByte(' ')
+		b.WriteString(f.Key)
+		b.WriteByte('=')
+		if _, quoted := quotedLogFields[f.Key]; quoted {
+			fmt.Fprintf(&b, "%q", f.Value)
+		} else {
+			fmt.Fprint(&b, f.Value)
+		}
+	}
+	return b.String()
+}
+
 // logRequestSent logs the outgoing request. query must be the built request's
 // actual query (e.g. r.URL.Query()), not the caller-supplied params — the
 // caller's params never carry the api_key that requestURL injects.
-func (c *Client) logRequestSent(method, path string, query url.Values, body []byte) {
+func (c *Client) logRequestSent(ctx context.Context, method, path string, query url.Values, body []byte) {
+	fields := []LogField{
+		{"http.request.method", method},
+		{"url.path", path},
+		{"url.query", redactQuery(query)},
+	}
 	if c.logBodies && body != nil {
-		c.logger.Debug("http.request.sent http.request.method=%s url.path=%s url.query=%s http.request.body=%s",
-			method, path, redactQuery(query), redactJSONBody(body))
-		return
+		fields = append(fields, LogField{"http.request.body", redactJSONBody(body)})
 	}
-	c.logger.Debug("http.request.sent http.request.method=%s url.path=%s url.query=%s", method, path, redactQuery(query))
+	c.logEvent(ctx, LogLevelDebug, "http.request.sent", fields...)
 }
 
-func (c *Client) logResponseReceived(method, path string, statusCode, bodySize int, d time.Duration, body []byte) {
+func (c *Client) logResponseReceived(ctx context.Context, method, path string, statusCode, bodySize int, d time.Duration, body []byte) {
+	fields := []LogField{
+		{"http.request.method", method},
+		{"url.path", path},
+		{"http.response.status_code", statusCode},
+		{"http.response.body.size", bodySize},
+		{"duration_ms", d.Milliseconds()},
+	}
 	if c.logBodies {
-		c.logger.Debug("http.response.received http.request.method=%s url.path=%s http.response.status_code=%d http.response.body.size=%d duration_ms=%d http.response.body=%s",
-			method, path, statusCode, bodySize, d.Milliseconds(), redactJSONBody(body))
-		return
+		fields = append(fields, LogField{"http.response.body", redactJSONBody(body)})
 	}
-	c.logger.Debug("http.response.received http.request.method=%s url.path=%s http.response.status_code=%d http.response.body.size=%d duration_ms=%d",
-		method, path, statusCode, bodySize, d.Milliseconds())
+	c.logEvent(ctx, LogLevelDebug, "http.response.received", fields...)
 }
 
 // safeErrorMessage returns a log-safe message for a transport-layer error.
@@ -97,9 +163,14 @@ func safeErrorMessage(err error) string {
 // ineligible for retry. err may be the raw transport error or the
 // *StreamError wrapping it; classification and message redaction work
 // either way (see safeErrorMessage).
-func (c *Client) logRequestFailed(method, path string, err error, d time.Duration) {
-	c.logger.Error("http.request.failed http.request.method=%s url.path=%s error.type=%s error.message=%q duration_ms=%d",
-		method, path, classifyTransportError(err), safeErrorMessage(err), d.Milliseconds())
+func (c *Client) logRequestFailed(ctx context.Context, method, path string, err error, d time.Duration) {
+	c.logEvent(ctx, LogLevelError, "http.request.failed",
+		LogField{"http.request.method", method},
+		LogField{"url.path", path},
+		LogField{"error.type", classifyTransportError(err)},
+		LogField{"error.message", safeErrorMessage(err)},
+		LogField{"duration_ms", d.Milliseconds()},
+	)
 }
 
 // logRetryScheduled logs a retryable failure at DEBUG before the loop backs
@@ -107,15 +178,20 @@ func (c *Client) logRequestFailed(method, path string, err error, d time.Duratio
 // failed). error.type — the closed transport-only classifier enum — is
 // included only when the failure is a transport error; a retried 429 carries
 // no error.type since rate-limiting isn't a transport failure.
-func (c *Client) logRetryScheduled(method, path string, err error, attempt int, delay time.Duration) {
-	msg := safeErrorMessage(err)
-	if !errors.Is(err, ErrTransport) {
-		c.logger.Debug("http.request.failed http.request.method=%s url.path=%s error.message=%q retry.attempt=%d backoff_ms=%d",
-			method, path, msg, attempt, delay.Milliseconds())
-		return
+func (c *Client) logRetryScheduled(ctx context.Context, method, path string, err error, attempt int, delay time.Duration) {
+	fields := []LogField{
+		{"http.request.method", method},
+		{"url.path", path},
+	}
+	if errors.Is(err, ErrTransport) {
+		var se *StreamError
+		errors.As(err, &se)
+		fields = append(fields, LogField{"error.type", se.ErrorType})
 	}
-	var se *StreamError
-	errors.As(err, &se)
-	c.logger.Debug("http.request.failed http.request.method=%s url.path=%s error.type=%s error.message=%q retry.attempt=%d backoff_ms=%d",
-		method, path, se.ErrorType, msg, attempt, delay.Milliseconds())
+	fields = append(fields,
+		LogField{"error.message", safeErrorMessage(err)},
+		LogField{"retry.attempt", attempt},
+		LogField{"backoff_ms", delay.Milliseconds()},
+	)
+	c.logEvent(ctx, LogLevelDebug, "http.request.failed", fields...)
 }
@@ -85,6 +85,32 @@ func TestClientInitializedEmittedOnce(t *testing.T) {
 	}
 }
 
+// renderCounter counts how often it is rendered.
+type renderCounter struct{ n *int }
+
+func (r renderCounter) String() string {
+	*r.n++
+	return "v"
+}
+
+func TestFilteredEventsNotRendered(t *testing.T) {
+	var out strings.Builder
+	c, err := newClient("k", "s", WithLogger(NewDefaultLogger(&out, "", 0, LogLevelInfo)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	var renders int
+	c.logEvent(context.Background(), LogLevelDebug, "filtered", LogField{"f", renderCounter{&renders}})
+	if renders != 0 || out.Len() != 0 {
+		t.Fatalf("want a filtered event left unrendered, got %d renders, output %q", renders, out.String())
+	}
+	c.logEvent(context.Background(), LogLevelInfo, "kept", LogField{"f", renderCounter{&renders}})
+	if renders != 1 || !strings.Contains(out.String(), "kept f=v") {
+		t.Fatalf("want the event rendered once, got %d renders, output %q", renders, out.String())
+	}
+}
+
 func TestRequestAndResponseEventsOnSuccess(t *testing.T) {
 	rec, err := loggedGET(t, &oneShotClient{status: 200, body: `{}`})
 	if err != nil {
@@ -0,0 +1,91 @@
+package getstream
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Metrics receives per-request measurements from the client. Implementations
+// must be safe for concurrent use and should return quickly: they run inline
+// on the request path. See the promgetstream module for a Prometheus adapter.
+type Metrics interface {
+	// RecordRequest is called once per attempt, after the response (or
+	// failure) is known. Calls held back by dry-run mode (see WithDryRun) are
+	// never sent and are not recorded.
+	RecordRequest(m RequestMetrics)
+	// RecordRetry is called when a failed attempt is scheduled for retry.
+	// attempt is 1-indexed (the attempt that just failed).
+	RecordRetry(operation string, attempt int, delay time.Duration)
+}
+
+// RequestMetrics describes a single API call attempt.
+type RequestMetrics struct {
+	// Operation is the stable operation name (see Operation.Name), e.g.
+	// "POST /api/v2/chat/channels/{type}/{id}/message".
+	Operation string
+	Method    string
+	// Path is the unexpanded path template.
+	Path string
+	// Attempt is the 0-indexed attempt number within the retry loop.
+	Attempt int
+	// StatusCode is the HTTP status, or 0 when no response was received.
+	StatusCode int
+	// StatusClass is "2xx", "3xx", "4xx" or "5xx", or "error" when no response
+	// was received.
+	StatusClass string
+	// Duration is the time from sending the request to reading the full
+	// response body. Zero when the request never reached the transport.
+	Duration time.Duration
+	// ResponseSize is the response body size in bytes.
+	ResponseSize int
+	// ErrorType is the transport classification (ErrorTypeTimeout, ...) when
+	// the attempt failed with ErrTransport, otherwise empty.
+	ErrorType string
+	// RateLimit is the window reported by the response, nil when no response
+	// was received or it carried no rate-limit headers.
+	RateLimit *RateLimitInfo
+	// Err is the attempt's error, nil on success.
+	Err error
+}
+
+// WithMetrics sets the sink for per-request measurements.
+func WithMetrics(m Metrics) ClientOption {
+	return func(c *Client) {
+		c.metrics = m
+	}
+}
+
+// recordRequest reports a finished attempt to the configured Metrics sink.
+func (c *Client) recordRequest(op *Operation, err error) {
+	if c.metrics == nil || errors.Is(err, ErrDryRun) {
+		return
+	}
+	m := RequestMetrics{
+		Operation:    op.Name(),
+		Method:       op.Method,
+		Path:         op.Path,
+		Attempt:      op.Attempt,
+		StatusCode:   op.StatusCode,
+		StatusClass:  statusClass(op.StatusCode),
+		Duration:     op.Duration,
+		ResponseSize: op.ResponseSize,
+		Err:          err,
+	}
+	if op.RateLimit != nil && op.RateLimit.Limit > 0 {
+		m.RateLimit = op.RateLimit
+	}
+	var se *StreamError
+	if errors.Is(err, ErrTransport) && errors.As(err, &se) {
+		m.ErrorType = se.ErrorType
+	}
+	c.metrics.RecordRequest(m)
+}
+
+// statusClass buckets an HTTP status into "2xx".."5xx"; 0 means no response.
+func statusClass(code int) string {
+	if code <= 0 {
+		return "error"
+	}
+	return strconv.Itoa(code/100) + "xx"
+}
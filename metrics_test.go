@@ -0,0 +1,95 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingMetrics struct {
+	mu       sync.Mutex
+	requests []RequestMetrics
+	retries  []int
+}
+
+func (m *recordingMetrics) RecordRequest(r RequestMetrics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, r)
+}
+
+func (m *recordingMetrics) RecordRetry(operation string, attempt int, delay time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retries = append(m.retries, attempt)
+}
+
+func TestMetrics_Success(t *testing.T) {
+	rec := &recordingMetrics{}
+	fake := &windowClient{limit: 100, remaining: 99, reset: time.Now().Add(time.Minute)}
+	client, err := NewClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}), WithMetrics(rec))
+	require.NoError(t, err)
+
+	_, err = client.Chat().GetChannel(context.Background(), "messaging", "general", &GetChannelRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, rec.requests, 1)
+	m := rec.requests[0]
+	require.Equal(t, "GET /api/v2/chat/channels/{type}/{id}", m.Operation)
+	require.Equal(t, 200, m.StatusCode)
+	require.Equal(t, "2xx", m.StatusClass)
+	require.Equal(t, 2, m.ResponseSize)
+	require.Equal(t, int64(99), m.RateLimit.Remaining)
+	require.Empty(t, m.ErrorType)
+	require.NoError(t, m.Err)
+}
+
+func TestMetrics_APIError(t *testing.T) {
+	rec := &recordingMetrics{}
+	client, err := NewClient("k", "s", WithHTTPClient(&oneShotClient{status: 404, body: `{"code":16,"message":"nope"}`}),
+		WithLogger(&recordingLogger{}), WithMetrics(rec))
+	require.NoError(t, err)
+
+	_, err = client.GetApp(context.Background(), &GetAppRequest{})
+	require.Error(t, err)
+	require.Len(t, rec.requests, 1)
+	require.Equal(t, "4xx", rec.requests[0].StatusClass)
+	require.Nil(t, rec.requests[0].RateLimit, "responses without rate-limit headers report none")
+	require.True(t, errors.Is(rec.requests[0].Err, ErrApiResponse))
+}
+
+func TestMetrics_SkipsDryRun(t *testing.T) {
+	rec := &recordingMetrics{}
+	fake := &oneShotClient{status: 200, body: `{}`}
+	client, err := NewClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}), WithMetrics(rec),
+		WithDryRun(DryRunConfig{Enabled: true}))
+	require.NoError(t, err)
+
+	_, err = client.DeleteUsers(context.Background(), &DeleteUsersRequest{UserIds: []string{"u"}})
+	require.True(t, errors.Is(err, ErrDryRun))
+	require.Empty(t, rec.requests, "a call that was never sent is not a request")
+}
+
+func TestMetrics_TransportRetries(t *testing.T) {
+	rec := &recordingMetrics{}
+	client, err := NewClient("k", "s", WithHTTPClient(&oneShotClient{err: syscall.ECONNRESET}),
+		WithLogger(&recordingLogger{}), WithMetrics(rec),
+		WithRetry(RetryConfig{Enabled: true, MaxAttempts: 3, MaxBackoff: 1}))
+	require.NoError(t, err)
+
+	_, err = client.GetApp(context.Background(), &GetAppRequest{})
+	require.True(t, errors.Is(err, ErrTransport))
+
+	require.Len(t, rec.requests, 3)
+	for i, m := range rec.requests {
+		require.Equal(t, i, m.Attempt)
+		require.Equal(t, "error", m.StatusClass)
+		require.Equal(t, ErrorTypeConnectionReset, m.ErrorType)
+	}
+	require.Equal(t, []int{1, 2}, rec.retries)
+}
@@ -0,0 +1,959 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package mockgetstream
+
+import (
+	"context"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var _ getstream.ChatAPI = (*ChatAPI)(nil)
+
+// ChatAPI is a mock getstream.ChatAPI. Each method records the call and
+// calls the matching Func field if set; otherwise it returns an empty
+// response and no error. Set Func fields before use.
+type ChatAPI struct {
+	Recorder
+
+	CreateCampaignFunc             func(ctx context.Context, request *getstream.CreateCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateCampaignResponse], error)
+	QueryCampaignsFunc             func(ctx context.Context, request *getstream.QueryCampaignsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCampaignsResponse], error)
+	DeleteCampaignFunc             func(ctx context.Context, id string, request *getstream.DeleteCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCampaignResponse], error)
+	GetCampaignFunc                func(ctx context.Context, id string, request *getstream.GetCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCampaignResponse], error)
+	UpdateCampaignFunc             func(ctx context.Context, id string, request *getstream.UpdateCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CampaignResponse], error)
+	StartCampaignFunc              func(ctx context.Context, id string, request *getstream.StartCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartCampaignResponse], error)
+	StopCampaignFunc               func(ctx context.Context, id string, request *getstream.StopCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CampaignResponse], error)
+	QueryChannelsFunc              func(ctx context.Context, request *getstream.QueryChannelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryChannelsResponse], error)
+	ChannelBatchUpdateFunc         func(ctx context.Context, request *getstream.ChannelBatchUpdateRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ChannelBatchUpdateResponse], error)
+	DeleteChannelsFunc             func(ctx context.Context, request *getstream.DeleteChannelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteChannelsResponse], error)
+	MarkDeliveredFunc              func(ctx context.Context, request *getstream.MarkDeliveredRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MarkDeliveredResponse], error)
+	GroupedQueryChannelsFunc       func(ctx context.Context, request *getstream.GroupedQueryChannelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GroupedQueryChannelsResponse], error)
+	MarkChannelsReadFunc           func(ctx context.Context, request *getstream.MarkChannelsReadRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MarkReadResponse], error)
+	GetOrCreateDistinctChannelFunc func(ctx context.Context, _type string, request *getstream.GetOrCreateDistinctChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ChannelStateResponse], error)
+	DeleteChannelFunc              func(ctx context.Context, _type string, id string, request *getstream.DeleteChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteChannelResponse], error)
+	GetChannelFunc                 func(ctx context.Context, _type string, id string, request *getstream.GetChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ChannelStateResponse], error)
+	UpdateChannelPartialFunc       func(ctx context.Context, _type string, id string, request *getstream.UpdateChannelPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateChannelPartialResponse], error)
+	UpdateChannelFunc              func(ctx context.Context, _type string, id string, request *getstream.UpdateChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateChannelResponse], error)
+	DeleteDraftFunc                func(ctx context.Context, _type string, id string, request *getstream.DeleteDraftRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetDraftFunc                   func(ctx context.Context, _type string, id string, request *getstream.GetDraftRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetDraftResponse], error)
+	SendEventFunc                  func(ctx context.Context, _type string, id string, request *getstream.SendEventRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.EventResponse], error)
+	DeleteChannelFileFunc          func(ctx context.Context, _type string, id string, request *getstream.DeleteChannelFileRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	UploadChannelFileFunc          func(ctx context.Context, _type string, id string, request *getstream.UploadChannelFileRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UploadChannelFileResponse], error)
+	HideChannelFunc                func(ctx context.Context, _type string, id string, request *getstream.HideChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.HideChannelResponse], error)
+	DeleteChannelImageFunc         func(ctx context.Context, _type string, id string, request *getstream.DeleteChannelImageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	UploadChannelImageFunc         func(ctx context.Context, _type string, id string, request *getstream.UploadChannelImageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UploadChannelResponse], error)
+	UpdateMemberPartialFunc        func(ctx context.Context, _type string, id string, request *getstream.UpdateMemberPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateMemberPartialResponse], error)
+	SendMessageFunc                func(ctx context.Context, _type string, id string, request *getstream.SendMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SendMessageResponse], error)
+	GetManyMessagesFunc            func(ctx context.Context, _type string, id string, request *getstream.GetManyMessagesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetManyMessagesResponse], error)
+	GetOrCreateChannelFunc         func(ctx context.Context, _type string, id string, request *getstream.GetOrCreateChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ChannelStateResponse], error)
+	MarkReadFunc                   func(ctx context.Context, _type string, id string, request *getstream.MarkReadRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MarkReadResponse], error)
+	ShowChannelFunc                func(ctx context.Context, _type string, id string, request *getstream.ShowChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ShowChannelResponse], error)
+	TruncateChannelFunc            func(ctx context.Context, _type string, id string, request *getstream.TruncateChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.TruncateChannelResponse], error)
+	MarkUnreadFunc                 func(ctx context.Context, _type string, id string, request *getstream.MarkUnreadRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	ListChannelTypesFunc           func(ctx context.Context, request *getstream.ListChannelTypesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListChannelTypesResponse], error)
+	CreateChannelTypeFunc          func(ctx context.Context, request *getstream.CreateChannelTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateChannelTypeResponse], error)
+	DeleteChannelTypeFunc          func(ctx context.Context, name string, request *getstream.DeleteChannelTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetChannelTypeFunc             func(ctx context.Context, name string, request *getstream.GetChannelTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetChannelTypeResponse], error)
+	UpdateChannelTypeFunc          func(ctx context.Context, name string, request *getstream.UpdateChannelTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateChannelTypeResponse], error)
+	ListCommandsFunc               func(ctx context.Context, request *getstream.ListCommandsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListCommandsResponse], error)
+	CreateCommandFunc              func(ctx context.Context, request *getstream.CreateCommandRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateCommandResponse], error)
+	DeleteCommandFunc              func(ctx context.Context, name string, request *getstream.DeleteCommandRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCommandResponse], error)
+	GetCommandFunc                 func(ctx context.Context, name string, request *getstream.GetCommandRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCommandResponse], error)
+	UpdateCommandFunc              func(ctx context.Context, name string, request *getstream.UpdateCommandRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCommandResponse], error)
+	QueryDraftsFunc                func(ctx context.Context, request *getstream.QueryDraftsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryDraftsResponse], error)
+	ExportChannelsFunc             func(ctx context.Context, request *getstream.ExportChannelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ExportChannelsResponse], error)
+	QueryMembersFunc               func(ctx context.Context, request *getstream.QueryMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MembersResponse], error)
+	QueryMessageHistoryFunc        func(ctx context.Context, request *getstream.QueryMessageHistoryRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryMessageHistoryResponse], error)
+	DeleteMessageFunc              func(ctx context.Context, id string, request *getstream.DeleteMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteMessageResponse], error)
+	GetMessageFunc                 func(ctx context.Context, id string, request *getstream.GetMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetMessageResponse], error)
+	UpdateMessageFunc              func(ctx context.Context, id string, request *getstream.UpdateMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateMessageResponse], error)
+	UpdateMessagePartialFunc       func(ctx context.Context, id string, request *getstream.UpdateMessagePartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateMessagePartialResponse], error)
+	RunMessageActionFunc           func(ctx context.Context, id string, request *getstream.RunMessageActionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MessageActionResponse], error)
+	CommitMessageFunc              func(ctx context.Context, id string, request *getstream.CommitMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MessageActionResponse], error)
+	EphemeralMessageUpdateFunc     func(ctx context.Context, id string, request *getstream.EphemeralMessageUpdateRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateMessagePartialResponse], error)
+	SendReactionFunc               func(ctx context.Context, id string, request *getstream.SendReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SendReactionResponse], error)
+	DeleteReactionFunc             func(ctx context.Context, id string, _type string, request *getstream.DeleteReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteReactionResponse], error)
+	GetReactionsFunc               func(ctx context.Context, id string, request *getstream.GetReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetReactionsResponse], error)
+	QueryReactionsFunc             func(ctx context.Context, id string, request *getstream.QueryReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryReactionsResponse], error)
+	TranslateMessageFunc           func(ctx context.Context, id string, request *getstream.TranslateMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MessageActionResponse], error)
+	UndeleteMessageFunc            func(ctx context.Context, id string, request *getstream.UndeleteMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UndeleteMessageResponse], error)
+	CastPollVoteFunc               func(ctx context.Context, messageID string, pollID string, request *getstream.CastPollVoteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollVoteResponse], error)
+	DeletePollVoteFunc             func(ctx context.Context, messageID string, pollID string, voteID string, request *getstream.DeletePollVoteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollVoteResponse], error)
+	DeleteReminderFunc             func(ctx context.Context, messageID string, request *getstream.DeleteReminderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteReminderResponse], error)
+	UpdateReminderFunc             func(ctx context.Context, messageID string, request *getstream.UpdateReminderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateReminderResponse], error)
+	CreateReminderFunc             func(ctx context.Context, messageID string, request *getstream.CreateReminderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ReminderResponseData], error)
+	GetRepliesFunc                 func(ctx context.Context, parentID string, request *getstream.GetRepliesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetRepliesResponse], error)
+	QueryMessageFlagsFunc          func(ctx context.Context, request *getstream.QueryMessageFlagsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryMessageFlagsResponse], error)
+	MuteChannelFunc                func(ctx context.Context, request *getstream.MuteChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MuteChannelResponse], error)
+	UnmuteChannelFunc              func(ctx context.Context, request *getstream.UnmuteChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnmuteResponse], error)
+	QueryBannedUsersFunc           func(ctx context.Context, request *getstream.QueryBannedUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryBannedUsersResponse], error)
+	QueryFutureChannelBansFunc     func(ctx context.Context, request *getstream.QueryFutureChannelBansRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFutureChannelBansResponse], error)
+	QueryRemindersFunc             func(ctx context.Context, request *getstream.QueryRemindersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryRemindersResponse], error)
+	GetRetentionPolicyFunc         func(ctx context.Context, request *getstream.GetRetentionPolicyRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetRetentionPolicyResponse], error)
+	SetRetentionPolicyFunc         func(ctx context.Context, request *getstream.SetRetentionPolicyRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SetRetentionPolicyResponse], error)
+	DeleteRetentionPolicyFunc      func(ctx context.Context, request *getstream.DeleteRetentionPolicyRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteRetentionPolicyResponse], error)
+	GetRetentionPolicyRunsFunc     func(ctx context.Context, request *getstream.GetRetentionPolicyRunsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetRetentionPolicyRunsResponse], error)
+	SearchFunc                     func(ctx context.Context, request *getstream.SearchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SearchResponse], error)
+	CreateSegmentFunc              func(ctx context.Context, request *getstream.CreateSegmentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateSegmentResponse], error)
+	QuerySegmentsFunc              func(ctx context.Context, request *getstream.QuerySegmentsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QuerySegmentsResponse], error)
+	DeleteSegmentFunc              func(ctx context.Context, id string, request *getstream.DeleteSegmentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetSegmentFunc                 func(ctx context.Context, id string, request *getstream.GetSegmentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetSegmentResponse], error)
+	UpdateSegmentFunc              func(ctx context.Context, id string, request *getstream.UpdateSegmentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateSegmentResponse], error)
+	AddSegmentTargetsFunc          func(ctx context.Context, id string, request *getstream.AddSegmentTargetsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	DeleteSegmentTargetsFunc       func(ctx context.Context, id string, request *getstream.DeleteSegmentTargetsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	SegmentTargetExistsFunc        func(ctx context.Context, id string, targetID string, request *getstream.SegmentTargetExistsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	QuerySegmentTargetsFunc        func(ctx context.Context, id string, request *getstream.QuerySegmentTargetsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QuerySegmentTargetsResponse], error)
+	QueryTeamUsageStatsFunc        func(ctx context.Context, request *getstream.QueryTeamUsageStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryTeamUsageStatsResponse], error)
+	QueryThreadsFunc               func(ctx context.Context, request *getstream.QueryThreadsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryThreadsResponse], error)
+	GetThreadFunc                  func(ctx context.Context, messageID string, request *getstream.GetThreadRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetThreadResponse], error)
+	UpdateThreadPartialFunc        func(ctx context.Context, messageID string, request *getstream.UpdateThreadPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateThreadPartialResponse], error)
+	UnreadCountsFunc               func(ctx context.Context, request *getstream.UnreadCountsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.WrappedUnreadCountsResponse], error)
+	UnreadCountsBatchFunc          func(ctx context.Context, request *getstream.UnreadCountsBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnreadCountsBatchResponse], error)
+	SendUserCustomEventFunc        func(ctx context.Context, userID string, request *getstream.SendUserCustomEventRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+}
+
+// CreateCampaign records the call and calls CreateCampaignFunc if set.
+func (m *ChatAPI) CreateCampaign(ctx context.Context, request *getstream.CreateCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateCampaignResponse], error) {
+	m.record(ctx, "CreateCampaign", request, opts)
+	if m.CreateCampaignFunc != nil {
+		return m.CreateCampaignFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateCampaignResponse]), nil
+}
+
+// QueryCampaigns records the call and calls QueryCampaignsFunc if set.
+func (m *ChatAPI) QueryCampaigns(ctx context.Context, request *getstream.QueryCampaignsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCampaignsResponse], error) {
+	m.record(ctx, "QueryCampaigns", request, opts)
+	if m.QueryCampaignsFunc != nil {
+		return m.QueryCampaignsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCampaignsResponse]), nil
+}
+
+// DeleteCampaign records the call and calls DeleteCampaignFunc if set.
+func (m *ChatAPI) DeleteCampaign(ctx context.Context, id string, request *getstream.DeleteCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCampaignResponse], error) {
+	m.record(ctx, "DeleteCampaign", id, request, opts)
+	if m.DeleteCampaignFunc != nil {
+		return m.DeleteCampaignFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteCampaignResponse]), nil
+}
+
+// GetCampaign records the call and calls GetCampaignFunc if set.
+func (m *ChatAPI) GetCampaign(ctx context.Context, id string, request *getstream.GetCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCampaignResponse], error) {
+	m.record(ctx, "GetCampaign", id, request, opts)
+	if m.GetCampaignFunc != nil {
+		return m.GetCampaignFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCampaignResponse]), nil
+}
+
+// UpdateCampaign records the call and calls UpdateCampaignFunc if set.
+func (m *ChatAPI) UpdateCampaign(ctx context.Context, id string, request *getstream.UpdateCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CampaignResponse], error) {
+	m.record(ctx, "UpdateCampaign", id, request, opts)
+	if m.UpdateCampaignFunc != nil {
+		return m.UpdateCampaignFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CampaignResponse]), nil
+}
+
+// StartCampaign records the call and calls StartCampaignFunc if set.
+func (m *ChatAPI) StartCampaign(ctx context.Context, id string, request *getstream.StartCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartCampaignResponse], error) {
+	m.record(ctx, "StartCampaign", id, request, opts)
+	if m.StartCampaignFunc != nil {
+		return m.StartCampaignFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StartCampaignResponse]), nil
+}
+
+// StopCampaign records the call and calls StopCampaignFunc if set.
+func (m *ChatAPI) StopCampaign(ctx context.Context, id string, request *getstream.StopCampaignRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CampaignResponse], error) {
+	m.record(ctx, "StopCampaign", id, request, opts)
+	if m.StopCampaignFunc != nil {
+		return m.StopCampaignFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CampaignResponse]), nil
+}
+
+// QueryChannels records the call and calls QueryChannelsFunc if set.
+func (m *ChatAPI) QueryChannels(ctx context.Context, request *getstream.QueryChannelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryChannelsResponse], error) {
+	m.record(ctx, "QueryChannels", request, opts)
+	if m.QueryChannelsFunc != nil {
+		return m.QueryChannelsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryChannelsResponse]), nil
+}
+
+// ChannelBatchUpdate records the call and calls ChannelBatchUpdateFunc if set.
+func (m *ChatAPI) ChannelBatchUpdate(ctx context.Context, request *getstream.ChannelBatchUpdateRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ChannelBatchUpdateResponse], error) {
+	m.record(ctx, "ChannelBatchUpdate", request, opts)
+	if m.ChannelBatchUpdateFunc != nil {
+		return m.ChannelBatchUpdateFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ChannelBatchUpdateResponse]), nil
+}
+
+// DeleteChannels records the call and calls DeleteChannelsFunc if set.
+func (m *ChatAPI) DeleteChannels(ctx context.Context, request *getstream.DeleteChannelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteChannelsResponse], error) {
+	m.record(ctx, "DeleteChannels", request, opts)
+	if m.DeleteChannelsFunc != nil {
+		return m.DeleteChannelsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteChannelsResponse]), nil
+}
+
+// MarkDelivered records the call and calls MarkDeliveredFunc if set.
+func (m *ChatAPI) MarkDelivered(ctx context.Context, request *getstream.MarkDeliveredRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MarkDeliveredResponse], error) {
+	m.record(ctx, "MarkDelivered", request, opts)
+	if m.MarkDeliveredFunc != nil {
+		return m.MarkDeliveredFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.MarkDeliveredResponse]), nil
+}
+
+// GroupedQueryChannels records the call and calls GroupedQueryChannelsFunc if set.
+func (m *ChatAPI) GroupedQueryChannels(ctx context.Context, request *getstream.GroupedQueryChannelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GroupedQueryChannelsResponse], error) {
+	m.record(ctx, "GroupedQueryChannels", request, opts)
+	if m.GroupedQueryChannelsFunc != nil {
+		return m.GroupedQueryChannelsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GroupedQueryChannelsResponse]), nil
+}
+
+// MarkChannelsRead records the call and calls MarkChannelsReadFunc if set.
+func (m *ChatAPI) MarkChannelsRead(ctx context.Context, request *getstream.MarkChannelsReadRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MarkReadResponse], error) {
+	m.record(ctx, "MarkChannelsRead", request, opts)
+	if m.MarkChannelsReadFunc != nil {
+		return m.MarkChannelsReadFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.MarkReadResponse]), nil
+}
+
+// GetOrCreateDistinctChannel records the call and calls GetOrCreateDistinctChannelFunc if set.
+func (m *ChatAPI) GetOrCreateDistinctChannel(ctx context.Context, _type string, request *getstream.GetOrCreateDistinctChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ChannelStateResponse], error) {
+	m.record(ctx, "GetOrCreateDistinctChannel", _type, request, opts)
+	if m.GetOrCreateDistinctChannelFunc != nil {
+		return m.GetOrCreateDistinctChannelFunc(ctx, _type, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ChannelStateResponse]), nil
+}
+
+// DeleteChannel records the call and calls DeleteChannelFunc if set.
+func (m *ChatAPI) DeleteChannel(ctx context.Context, _type string, id string, request *getstream.DeleteChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteChannelResponse], error) {
+	m.record(ctx, "DeleteChannel", _type, id, request, opts)
+	if m.DeleteChannelFunc != nil {
+		return m.DeleteChannelFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteChannelResponse]), nil
+}
+
+// GetChannel records the call and calls GetChannelFunc if set.
+func (m *ChatAPI) GetChannel(ctx context.Context, _type string, id string, request *getstream.GetChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ChannelStateResponse], error) {
+	m.record(ctx, "GetChannel", _type, id, request, opts)
+	if m.GetChannelFunc != nil {
+		return m.GetChannelFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ChannelStateResponse]), nil
+}
+
+// UpdateChannelPartial records the call and calls UpdateChannelPartialFunc if set.
+func (m *ChatAPI) UpdateChannelPartial(ctx context.Context, _type string, id string, request *getstream.UpdateChannelPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateChannelPartialResponse], error) {
+	m.record(ctx, "UpdateChannelPartial", _type, id, request, opts)
+	if m.UpdateChannelPartialFunc != nil {
+		return m.UpdateChannelPartialFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateChannelPartialResponse]), nil
+}
+
+// UpdateChannel records the call and calls UpdateChannelFunc if set.
+func (m *ChatAPI) UpdateChannel(ctx context.Context, _type string, id string, request *getstream.UpdateChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateChannelResponse], error) {
+	m.record(ctx, "UpdateChannel", _type, id, request, opts)
+	if m.UpdateChannelFunc != nil {
+		return m.UpdateChannelFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateChannelResponse]), nil
+}
+
+// DeleteDraft records the call and calls DeleteDraftFunc if set.
+func (m *ChatAPI) DeleteDraft(ctx context.Context, _type string, id string, request *getstream.DeleteDraftRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteDraft", _type, id, request, opts)
+	if m.DeleteDraftFunc != nil {
+		return m.DeleteDraftFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetDraft records the call and calls GetDraftFunc if set.
+func (m *ChatAPI) GetDraft(ctx context.Context, _type string, id string, request *getstream.GetDraftRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetDraftResponse], error) {
+	m.record(ctx, "GetDraft", _type, id, request, opts)
+	if m.GetDraftFunc != nil {
+		return m.GetDraftFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetDraftResponse]), nil
+}
+
+// SendEvent records the call and calls SendEventFunc if set.
+func (m *ChatAPI) SendEvent(ctx context.Context, _type string, id string, request *getstream.SendEventRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.EventResponse], error) {
+	m.record(ctx, "SendEvent", _type, id, request, opts)
+	if m.SendEventFunc != nil {
+		return m.SendEventFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.EventResponse]), nil
+}
+
+// DeleteChannelFile records the call and calls DeleteChannelFileFunc if set.
+func (m *ChatAPI) DeleteChannelFile(ctx context.Context, _type string, id string, request *getstream.DeleteChannelFileRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteChannelFile", _type, id, request, opts)
+	if m.DeleteChannelFileFunc != nil {
+		return m.DeleteChannelFileFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// UploadChannelFile records the call and calls UploadChannelFileFunc if set.
+func (m *ChatAPI) UploadChannelFile(ctx context.Context, _type string, id string, request *getstream.UploadChannelFileRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UploadChannelFileResponse], error) {
+	m.record(ctx, "UploadChannelFile", _type, id, request, opts)
+	if m.UploadChannelFileFunc != nil {
+		return m.UploadChannelFileFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UploadChannelFileResponse]), nil
+}
+
+// HideChannel records the call and calls HideChannelFunc if set.
+func (m *ChatAPI) HideChannel(ctx context.Context, _type string, id string, request *getstream.HideChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.HideChannelResponse], error) {
+	m.record(ctx, "HideChannel", _type, id, request, opts)
+	if m.HideChannelFunc != nil {
+		return m.HideChannelFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.HideChannelResponse]), nil
+}
+
+// DeleteChannelImage records the call and calls DeleteChannelImageFunc if set.
+func (m *ChatAPI) DeleteChannelImage(ctx context.Context, _type string, id string, request *getstream.DeleteChannelImageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteChannelImage", _type, id, request, opts)
+	if m.DeleteChannelImageFunc != nil {
+		return m.DeleteChannelImageFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// UploadChannelImage records the call and calls UploadChannelImageFunc if set.
+func (m *ChatAPI) UploadChannelImage(ctx context.Context, _type string, id string, request *getstream.UploadChannelImageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UploadChannelResponse], error) {
+	m.record(ctx, "UploadChannelImage", _type, id, request, opts)
+	if m.UploadChannelImageFunc != nil {
+		return m.UploadChannelImageFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UploadChannelResponse]), nil
+}
+
+// UpdateMemberPartial records the call and calls UpdateMemberPartialFunc if set.
+func (m *ChatAPI) UpdateMemberPartial(ctx context.Context, _type string, id string, request *getstream.UpdateMemberPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateMemberPartialResponse], error) {
+	m.record(ctx, "UpdateMemberPartial", _type, id, request, opts)
+	if m.UpdateMemberPartialFunc != nil {
+		return m.UpdateMemberPartialFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateMemberPartialResponse]), nil
+}
+
+// SendMessage records the call and calls SendMessageFunc if set.
+func (m *ChatAPI) SendMessage(ctx context.Context, _type string, id string, request *getstream.SendMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SendMessageResponse], error) {
+	m.record(ctx, "SendMessage", _type, id, request, opts)
+	if m.SendMessageFunc != nil {
+		return m.SendMessageFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SendMessageResponse]), nil
+}
+
+// GetManyMessages records the call and calls GetManyMessagesFunc if set.
+func (m *ChatAPI) GetManyMessages(ctx context.Context, _type string, id string, request *getstream.GetManyMessagesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetManyMessagesResponse], error) {
+	m.record(ctx, "GetManyMessages", _type, id, request, opts)
+	if m.GetManyMessagesFunc != nil {
+		return m.GetManyMessagesFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetManyMessagesResponse]), nil
+}
+
+// GetOrCreateChannel records the call and calls GetOrCreateChannelFunc if set.
+func (m *ChatAPI) GetOrCreateChannel(ctx context.Context, _type string, id string, request *getstream.GetOrCreateChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ChannelStateResponse], error) {
+	m.record(ctx, "GetOrCreateChannel", _type, id, request, opts)
+	if m.GetOrCreateChannelFunc != nil {
+		return m.GetOrCreateChannelFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ChannelStateResponse]), nil
+}
+
+// MarkRead records the call and calls MarkReadFunc if set.
+func (m *ChatAPI) MarkRead(ctx context.Context, _type string, id string, request *getstream.MarkReadRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MarkReadResponse], error) {
+	m.record(ctx, "MarkRead", _type, id, request, opts)
+	if m.MarkReadFunc != nil {
+		return m.MarkReadFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.MarkReadResponse]), nil
+}
+
+// ShowChannel records the call and calls ShowChannelFunc if set.
+func (m *ChatAPI) ShowChannel(ctx context.Context, _type string, id string, request *getstream.ShowChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ShowChannelResponse], error) {
+	m.record(ctx, "ShowChannel", _type, id, request, opts)
+	if m.ShowChannelFunc != nil {
+		return m.ShowChannelFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ShowChannelResponse]), nil
+}
+
+// TruncateChannel records the call and calls TruncateChannelFunc if set.
+func (m *ChatAPI) TruncateChannel(ctx context.Context, _type string, id string, request *getstream.TruncateChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.TruncateChannelResponse], error) {
+	m.record(ctx, "TruncateChannel", _type, id, request, opts)
+	if m.TruncateChannelFunc != nil {
+		return m.TruncateChannelFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.TruncateChannelResponse]), nil
+}
+
+// MarkUnread records the call and calls MarkUnreadFunc if set.
+func (m *ChatAPI) MarkUnread(ctx context.Context, _type string, id string, request *getstream.MarkUnreadRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "MarkUnread", _type, id, request, opts)
+	if m.MarkUnreadFunc != nil {
+		return m.MarkUnreadFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// ListChannelTypes records the call and calls ListChannelTypesFunc if set.
+func (m *ChatAPI) ListChannelTypes(ctx context.Context, request *getstream.ListChannelTypesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListChannelTypesResponse], error) {
+	m.record(ctx, "ListChannelTypes", request, opts)
+	if m.ListChannelTypesFunc != nil {
+		return m.ListChannelTypesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListChannelTypesResponse]), nil
+}
+
+// CreateChannelType records the call and calls CreateChannelTypeFunc if set.
+func (m *ChatAPI) CreateChannelType(ctx context.Context, request *getstream.CreateChannelTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateChannelTypeResponse], error) {
+	m.record(ctx, "CreateChannelType", request, opts)
+	if m.CreateChannelTypeFunc != nil {
+		return m.CreateChannelTypeFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateChannelTypeResponse]), nil
+}
+
+// DeleteChannelType records the call and calls DeleteChannelTypeFunc if set.
+func (m *ChatAPI) DeleteChannelType(ctx context.Context, name string, request *getstream.DeleteChannelTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteChannelType", name, request, opts)
+	if m.DeleteChannelTypeFunc != nil {
+		return m.DeleteChannelTypeFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetChannelType records the call and calls GetChannelTypeFunc if set.
+func (m *ChatAPI) GetChannelType(ctx context.Context, name string, request *getstream.GetChannelTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetChannelTypeResponse], error) {
+	m.record(ctx, "GetChannelType", name, request, opts)
+	if m.GetChannelTypeFunc != nil {
+		return m.GetChannelTypeFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetChannelTypeResponse]), nil
+}
+
+// UpdateChannelType records the call and calls UpdateChannelTypeFunc if set.
+func (m *ChatAPI) UpdateChannelType(ctx context.Context, name string, request *getstream.UpdateChannelTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateChannelTypeResponse], error) {
+	m.record(ctx, "UpdateChannelType", name, request, opts)
+	if m.UpdateChannelTypeFunc != nil {
+		return m.UpdateChannelTypeFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateChannelTypeResponse]), nil
+}
+
+// ListCommands records the call and calls ListCommandsFunc if set.
+func (m *ChatAPI) ListCommands(ctx context.Context, request *getstream.ListCommandsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListCommandsResponse], error) {
+	m.record(ctx, "ListCommands", request, opts)
+	if m.ListCommandsFunc != nil {
+		return m.ListCommandsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListCommandsResponse]), nil
+}
+
+// CreateCommand records the call and calls CreateCommandFunc if set.
+func (m *ChatAPI) CreateCommand(ctx context.Context, request *getstream.CreateCommandRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateCommandResponse], error) {
+	m.record(ctx, "CreateCommand", request, opts)
+	if m.CreateCommandFunc != nil {
+		return m.CreateCommandFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateCommandResponse]), nil
+}
+
+// DeleteCommand records the call and calls DeleteCommandFunc if set.
+func (m *ChatAPI) DeleteCommand(ctx context.Context, name string, request *getstream.DeleteCommandRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCommandResponse], error) {
+	m.record(ctx, "DeleteCommand", name, request, opts)
+	if m.DeleteCommandFunc != nil {
+		return m.DeleteCommandFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteCommandResponse]), nil
+}
+
+// GetCommand records the call and calls GetCommandFunc if set.
+func (m *ChatAPI) GetCommand(ctx context.Context, name string, request *getstream.GetCommandRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCommandResponse], error) {
+	m.record(ctx, "GetCommand", name, request, opts)
+	if m.GetCommandFunc != nil {
+		return m.GetCommandFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCommandResponse]), nil
+}
+
+// UpdateCommand records the call and calls UpdateCommandFunc if set.
+func (m *ChatAPI) UpdateCommand(ctx context.Context, name string, request *getstream.UpdateCommandRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCommandResponse], error) {
+	m.record(ctx, "UpdateCommand", name, request, opts)
+	if m.UpdateCommandFunc != nil {
+		return m.UpdateCommandFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateCommandResponse]), nil
+}
+
+// QueryDrafts records the call and calls QueryDraftsFunc if set.
+func (m *ChatAPI) QueryDrafts(ctx context.Context, request *getstream.QueryDraftsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryDraftsResponse], error) {
+	m.record(ctx, "QueryDrafts", request, opts)
+	if m.QueryDraftsFunc != nil {
+		return m.QueryDraftsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryDraftsResponse]), nil
+}
+
+// ExportChannels records the call and calls ExportChannelsFunc if set.
+func (m *ChatAPI) ExportChannels(ctx context.Context, request *getstream.ExportChannelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ExportChannelsResponse], error) {
+	m.record(ctx, "ExportChannels", request, opts)
+	if m.ExportChannelsFunc != nil {
+		return m.ExportChannelsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ExportChannelsResponse]), nil
+}
+
+// QueryMembers records the call and calls QueryMembersFunc if set.
+func (m *ChatAPI) QueryMembers(ctx context.Context, request *getstream.QueryMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MembersResponse], error) {
+	m.record(ctx, "QueryMembers", request, opts)
+	if m.QueryMembersFunc != nil {
+		return m.QueryMembersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.MembersResponse]), nil
+}
+
+// QueryMessageHistory records the call and calls QueryMessageHistoryFunc if set.
+func (m *ChatAPI) QueryMessageHistory(ctx context.Context, request *getstream.QueryMessageHistoryRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryMessageHistoryResponse], error) {
+	m.record(ctx, "QueryMessageHistory", request, opts)
+	if m.QueryMessageHistoryFunc != nil {
+		return m.QueryMessageHistoryFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryMessageHistoryResponse]), nil
+}
+
+// DeleteMessage records the call and calls DeleteMessageFunc if set.
+func (m *ChatAPI) DeleteMessage(ctx context.Context, id string, request *getstream.DeleteMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteMessageResponse], error) {
+	m.record(ctx, "DeleteMessage", id, request, opts)
+	if m.DeleteMessageFunc != nil {
+		return m.DeleteMessageFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteMessageResponse]), nil
+}
+
+// GetMessage records the call and calls GetMessageFunc if set.
+func (m *ChatAPI) GetMessage(ctx context.Context, id string, request *getstream.GetMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetMessageResponse], error) {
+	m.record(ctx, "GetMessage", id, request, opts)
+	if m.GetMessageFunc != nil {
+		return m.GetMessageFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetMessageResponse]), nil
+}
+
+// UpdateMessage records the call and calls UpdateMessageFunc if set.
+func (m *ChatAPI) UpdateMessage(ctx context.Context, id string, request *getstream.UpdateMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateMessageResponse], error) {
+	m.record(ctx, "UpdateMessage", id, request, opts)
+	if m.UpdateMessageFunc != nil {
+		return m.UpdateMessageFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateMessageResponse]), nil
+}
+
+// UpdateMessagePartial records the call and calls UpdateMessagePartialFunc if set.
+func (m *ChatAPI) UpdateMessagePartial(ctx context.Context, id string, request *getstream.UpdateMessagePartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateMessagePartialResponse], error) {
+	m.record(ctx, "UpdateMessagePartial", id, request, opts)
+	if m.UpdateMessagePartialFunc != nil {
+		return m.UpdateMessagePartialFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateMessagePartialResponse]), nil
+}
+
+// RunMessageAction records the call and calls RunMessageActionFunc if set.
+func (m *ChatAPI) RunMessageAction(ctx context.Context, id string, request *getstream.RunMessageActionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MessageActionResponse], error) {
+	m.record(ctx, "RunMessageAction", id, request, opts)
+	if m.RunMessageActionFunc != nil {
+		return m.RunMessageActionFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.MessageActionResponse]), nil
+}
+
+// CommitMessage records the call and calls CommitMessageFunc if set.
+func (m *ChatAPI) CommitMessage(ctx context.Context, id string, request *getstream.CommitMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MessageActionResponse], error) {
+	m.record(ctx, "CommitMessage", id, request, opts)
+	if m.CommitMessageFunc != nil {
+		return m.CommitMessageFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.MessageActionResponse]), nil
+}
+
+// EphemeralMessageUpdate records the call and calls EphemeralMessageUpdateFunc if set.
+func (m *ChatAPI) EphemeralMessageUpdate(ctx context.Context, id string, request *getstream.EphemeralMessageUpdateRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateMessagePartialResponse], error) {
+	m.record(ctx, "EphemeralMessageUpdate", id, request, opts)
+	if m.EphemeralMessageUpdateFunc != nil {
+		return m.EphemeralMessageUpdateFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateMessagePartialResponse]), nil
+}
+
+// SendReaction records the call and calls SendReactionFunc if set.
+func (m *ChatAPI) SendReaction(ctx context.Context, id string, request *getstream.SendReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SendReactionResponse], error) {
+	m.record(ctx, "SendReaction", id, request, opts)
+	if m.SendReactionFunc != nil {
+		return m.SendReactionFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SendReactionResponse]), nil
+}
+
+// DeleteReaction records the call and calls DeleteReactionFunc if set.
+func (m *ChatAPI) DeleteReaction(ctx context.Context, id string, _type string, request *getstream.DeleteReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteReactionResponse], error) {
+	m.record(ctx, "DeleteReaction", id, _type, request, opts)
+	if m.DeleteReactionFunc != nil {
+		return m.DeleteReactionFunc(ctx, id, _type, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteReactionResponse]), nil
+}
+
+// GetReactions records the call and calls GetReactionsFunc if set.
+func (m *ChatAPI) GetReactions(ctx context.Context, id string, request *getstream.GetReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetReactionsResponse], error) {
+	m.record(ctx, "GetReactions", id, request, opts)
+	if m.GetReactionsFunc != nil {
+		return m.GetReactionsFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetReactionsResponse]), nil
+}
+
+// QueryReactions records the call and calls QueryReactionsFunc if set.
+func (m *ChatAPI) QueryReactions(ctx context.Context, id string, request *getstream.QueryReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryReactionsResponse], error) {
+	m.record(ctx, "QueryReactions", id, request, opts)
+	if m.QueryReactionsFunc != nil {
+		return m.QueryReactionsFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryReactionsResponse]), nil
+}
+
+// TranslateMessage records the call and calls TranslateMessageFunc if set.
+func (m *ChatAPI) TranslateMessage(ctx context.Context, id string, request *getstream.TranslateMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MessageActionResponse], error) {
+	m.record(ctx, "TranslateMessage", id, request, opts)
+	if m.TranslateMessageFunc != nil {
+		return m.TranslateMessageFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.MessageActionResponse]), nil
+}
+
+// UndeleteMessage records the call and calls UndeleteMessageFunc if set.
+func (m *ChatAPI) UndeleteMessage(ctx context.Context, id string, request *getstream.UndeleteMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UndeleteMessageResponse], error) {
+	m.record(ctx, "UndeleteMessage", id, request, opts)
+	if m.UndeleteMessageFunc != nil {
+		return m.UndeleteMessageFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UndeleteMessageResponse]), nil
+}
+
+// CastPollVote records the call and calls CastPollVoteFunc if set.
+func (m *ChatAPI) CastPollVote(ctx context.Context, messageID string, pollID string, request *getstream.CastPollVoteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollVoteResponse], error) {
+	m.record(ctx, "CastPollVote", messageID, pollID, request, opts)
+	if m.CastPollVoteFunc != nil {
+		return m.CastPollVoteFunc(ctx, messageID, pollID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollVoteResponse]), nil
+}
+
+// DeletePollVote records the call and calls DeletePollVoteFunc if set.
+func (m *ChatAPI) DeletePollVote(ctx context.Context, messageID string, pollID string, voteID string, request *getstream.DeletePollVoteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollVoteResponse], error) {
+	m.record(ctx, "DeletePollVote", messageID, pollID, voteID, request, opts)
+	if m.DeletePollVoteFunc != nil {
+		return m.DeletePollVoteFunc(ctx, messageID, pollID, voteID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollVoteResponse]), nil
+}
+
+// DeleteReminder records the call and calls DeleteReminderFunc if set.
+func (m *ChatAPI) DeleteReminder(ctx context.Context, messageID string, request *getstream.DeleteReminderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteReminderResponse], error) {
+	m.record(ctx, "DeleteReminder", messageID, request, opts)
+	if m.DeleteReminderFunc != nil {
+		return m.DeleteReminderFunc(ctx, messageID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteReminderResponse]), nil
+}
+
+// UpdateReminder records the call and calls UpdateReminderFunc if set.
+func (m *ChatAPI) UpdateReminder(ctx context.Context, messageID string, request *getstream.UpdateReminderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateReminderResponse], error) {
+	m.record(ctx, "UpdateReminder", messageID, request, opts)
+	if m.UpdateReminderFunc != nil {
+		return m.UpdateReminderFunc(ctx, messageID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateReminderResponse]), nil
+}
+
+// CreateReminder records the call and calls CreateReminderFunc if set.
+func (m *ChatAPI) CreateReminder(ctx context.Context, messageID string, request *getstream.CreateReminderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ReminderResponseData], error) {
+	m.record(ctx, "CreateReminder", messageID, request, opts)
+	if m.CreateReminderFunc != nil {
+		return m.CreateReminderFunc(ctx, messageID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ReminderResponseData]), nil
+}
+
+// GetReplies records the call and calls GetRepliesFunc if set.
+func (m *ChatAPI) GetReplies(ctx context.Context, parentID string, request *getstream.GetRepliesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetRepliesResponse], error) {
+	m.record(ctx, "GetReplies", parentID, request, opts)
+	if m.GetRepliesFunc != nil {
+		return m.GetRepliesFunc(ctx, parentID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetRepliesResponse]), nil
+}
+
+// QueryMessageFlags records the call and calls QueryMessageFlagsFunc if set.
+func (m *ChatAPI) QueryMessageFlags(ctx context.Context, request *getstream.QueryMessageFlagsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryMessageFlagsResponse], error) {
+	m.record(ctx, "QueryMessageFlags", request, opts)
+	if m.QueryMessageFlagsFunc != nil {
+		return m.QueryMessageFlagsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryMessageFlagsResponse]), nil
+}
+
+// MuteChannel records the call and calls MuteChannelFunc if set.
+func (m *ChatAPI) MuteChannel(ctx context.Context, request *getstream.MuteChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MuteChannelResponse], error) {
+	m.record(ctx, "MuteChannel", request, opts)
+	if m.MuteChannelFunc != nil {
+		return m.MuteChannelFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.MuteChannelResponse]), nil
+}
+
+// UnmuteChannel records the call and calls UnmuteChannelFunc if set.
+func (m *ChatAPI) UnmuteChannel(ctx context.Context, request *getstream.UnmuteChannelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnmuteResponse], error) {
+	m.record(ctx, "UnmuteChannel", request, opts)
+	if m.UnmuteChannelFunc != nil {
+		return m.UnmuteChannelFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnmuteResponse]), nil
+}
+
+// QueryBannedUsers records the call and calls QueryBannedUsersFunc if set.
+func (m *ChatAPI) QueryBannedUsers(ctx context.Context, request *getstream.QueryBannedUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryBannedUsersResponse], error) {
+	m.record(ctx, "QueryBannedUsers", request, opts)
+	if m.QueryBannedUsersFunc != nil {
+		return m.QueryBannedUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryBannedUsersResponse]), nil
+}
+
+// QueryFutureChannelBans records the call and calls QueryFutureChannelBansFunc if set.
+func (m *ChatAPI) QueryFutureChannelBans(ctx context.Context, request *getstream.QueryFutureChannelBansRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFutureChannelBansResponse], error) {
+	m.record(ctx, "QueryFutureChannelBans", request, opts)
+	if m.QueryFutureChannelBansFunc != nil {
+		return m.QueryFutureChannelBansFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryFutureChannelBansResponse]), nil
+}
+
+// QueryReminders records the call and calls QueryRemindersFunc if set.
+func (m *ChatAPI) QueryReminders(ctx context.Context, request *getstream.QueryRemindersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryRemindersResponse], error) {
+	m.record(ctx, "QueryReminders", request, opts)
+	if m.QueryRemindersFunc != nil {
+		return m.QueryRemindersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryRemindersResponse]), nil
+}
+
+// GetRetentionPolicy records the call and calls GetRetentionPolicyFunc if set.
+func (m *ChatAPI) GetRetentionPolicy(ctx context.Context, request *getstream.GetRetentionPolicyRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetRetentionPolicyResponse], error) {
+	m.record(ctx, "GetRetentionPolicy", request, opts)
+	if m.GetRetentionPolicyFunc != nil {
+		return m.GetRetentionPolicyFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetRetentionPolicyResponse]), nil
+}
+
+// SetRetentionPolicy records the call and calls SetRetentionPolicyFunc if set.
+func (m *ChatAPI) SetRetentionPolicy(ctx context.Context, request *getstream.SetRetentionPolicyRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SetRetentionPolicyResponse], error) {
+	m.record(ctx, "SetRetentionPolicy", request, opts)
+	if m.SetRetentionPolicyFunc != nil {
+		return m.SetRetentionPolicyFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SetRetentionPolicyResponse]), nil
+}
+
+// DeleteRetentionPolicy records the call and calls DeleteRetentionPolicyFunc if set.
+func (m *ChatAPI) DeleteRetentionPolicy(ctx context.Context, request *getstream.DeleteRetentionPolicyRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteRetentionPolicyResponse], error) {
+	m.record(ctx, "DeleteRetentionPolicy", request, opts)
+	if m.DeleteRetentionPolicyFunc != nil {
+		return m.DeleteRetentionPolicyFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteRetentionPolicyResponse]), nil
+}
+
+// GetRetentionPolicyRuns records the call and calls GetRetentionPolicyRunsFunc if set.
+func (m *ChatAPI) GetRetentionPolicyRuns(ctx context.Context, request *getstream.GetRetentionPolicyRunsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetRetentionPolicyRunsResponse], error) {
+	m.record(ctx, "GetRetentionPolicyRuns", request, opts)
+	if m.GetRetentionPolicyRunsFunc != nil {
+		return m.GetRetentionPolicyRunsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetRetentionPolicyRunsResponse]), nil
+}
+
+// Search records the call and calls SearchFunc if set.
+func (m *ChatAPI) Search(ctx context.Context, request *getstream.SearchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SearchResponse], error) {
+	m.record(ctx, "Search", request, opts)
+	if m.SearchFunc != nil {
+		return m.SearchFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SearchResponse]), nil
+}
+
+// CreateSegment records the call and calls CreateSegmentFunc if set.
+func (m *ChatAPI) CreateSegment(ctx context.Context, request *getstream.CreateSegmentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateSegmentResponse], error) {
+	m.record(ctx, "CreateSegment", request, opts)
+	if m.CreateSegmentFunc != nil {
+		return m.CreateSegmentFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateSegmentResponse]), nil
+}
+
+// QuerySegments records the call and calls QuerySegmentsFunc if set.
+func (m *ChatAPI) QuerySegments(ctx context.Context, request *getstream.QuerySegmentsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QuerySegmentsResponse], error) {
+	m.record(ctx, "QuerySegments", request, opts)
+	if m.QuerySegmentsFunc != nil {
+		return m.QuerySegmentsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QuerySegmentsResponse]), nil
+}
+
+// DeleteSegment records the call and calls DeleteSegmentFunc if set.
+func (m *ChatAPI) DeleteSegment(ctx context.Context, id string, request *getstream.DeleteSegmentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteSegment", id, request, opts)
+	if m.DeleteSegmentFunc != nil {
+		return m.DeleteSegmentFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetSegment records the call and calls GetSegmentFunc if set.
+func (m *ChatAPI) GetSegment(ctx context.Context, id string, request *getstream.GetSegmentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetSegmentResponse], error) {
+	m.record(ctx, "GetSegment", id, request, opts)
+	if m.GetSegmentFunc != nil {
+		return m.GetSegmentFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetSegmentResponse]), nil
+}
+
+// UpdateSegment records the call and calls UpdateSegmentFunc if set.
+func (m *ChatAPI) UpdateSegment(ctx context.Context, id string, request *getstream.UpdateSegmentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateSegmentResponse], error) {
+	m.record(ctx, "UpdateSegment", id, request, opts)
+	if m.UpdateSegmentFunc != nil {
+		return m.UpdateSegmentFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateSegmentResponse]), nil
+}
+
+// AddSegmentTargets records the call and calls AddSegmentTargetsFunc if set.
+func (m *ChatAPI) AddSegmentTargets(ctx context.Context, id string, request *getstream.AddSegmentTargetsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "AddSegmentTargets", id, request, opts)
+	if m.AddSegmentTargetsFunc != nil {
+		return m.AddSegmentTargetsFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// DeleteSegmentTargets records the call and calls DeleteSegmentTargetsFunc if set.
+func (m *ChatAPI) DeleteSegmentTargets(ctx context.Context, id string, request *getstream.DeleteSegmentTargetsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteSegmentTargets", id, request, opts)
+	if m.DeleteSegmentTargetsFunc != nil {
+		return m.DeleteSegmentTargetsFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// SegmentTargetExists records the call and calls SegmentTargetExistsFunc if set.
+func (m *ChatAPI) SegmentTargetExists(ctx context.Context, id string, targetID string, request *getstream.SegmentTargetExistsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "SegmentTargetExists", id, targetID, request, opts)
+	if m.SegmentTargetExistsFunc != nil {
+		return m.SegmentTargetExistsFunc(ctx, id, targetID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// QuerySegmentTargets records the call and calls QuerySegmentTargetsFunc if set.
+func (m *ChatAPI) QuerySegmentTargets(ctx context.Context, id string, request *getstream.QuerySegmentTargetsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QuerySegmentTargetsResponse], error) {
+	m.record(ctx, "QuerySegmentTargets", id, request, opts)
+	if m.QuerySegmentTargetsFunc != nil {
+		return m.QuerySegmentTargetsFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QuerySegmentTargetsResponse]), nil
+}
+
+// QueryTeamUsageStats records the call and calls QueryTeamUsageStatsFunc if set.
+func (m *ChatAPI) QueryTeamUsageStats(ctx context.Context, request *getstream.QueryTeamUsageStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryTeamUsageStatsResponse], error) {
+	m.record(ctx, "QueryTeamUsageStats", request, opts)
+	if m.QueryTeamUsageStatsFunc != nil {
+		return m.QueryTeamUsageStatsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryTeamUsageStatsResponse]), nil
+}
+
+// QueryThreads records the call and calls QueryThreadsFunc if set.
+func (m *ChatAPI) QueryThreads(ctx context.Context, request *getstream.QueryThreadsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryThreadsResponse], error) {
+	m.record(ctx, "QueryThreads", request, opts)
+	if m.QueryThreadsFunc != nil {
+		return m.QueryThreadsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryThreadsResponse]), nil
+}
+
+// GetThread records the call and calls GetThreadFunc if set.
+func (m *ChatAPI) GetThread(ctx context.Context, messageID string, request *getstream.GetThreadRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetThreadResponse], error) {
+	m.record(ctx, "GetThread", messageID, request, opts)
+	if m.GetThreadFunc != nil {
+		return m.GetThreadFunc(ctx, messageID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetThreadResponse]), nil
+}
+
+// UpdateThreadPartial records the call and calls UpdateThreadPartialFunc if set.
+func (m *ChatAPI) UpdateThreadPartial(ctx context.Context, messageID string, request *getstream.UpdateThreadPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateThreadPartialResponse], error) {
+	m.record(ctx, "UpdateThreadPartial", messageID, request, opts)
+	if m.UpdateThreadPartialFunc != nil {
+		return m.UpdateThreadPartialFunc(ctx, messageID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateThreadPartialResponse]), nil
+}
+
+// UnreadCounts records the call and calls UnreadCountsFunc if set.
+func (m *ChatAPI) UnreadCounts(ctx context.Context, request *getstream.UnreadCountsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.WrappedUnreadCountsResponse], error) {
+	m.record(ctx, "UnreadCounts", request, opts)
+	if m.UnreadCountsFunc != nil {
+		return m.UnreadCountsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.WrappedUnreadCountsResponse]), nil
+}
+
+// UnreadCountsBatch records the call and calls UnreadCountsBatchFunc if set.
+func (m *ChatAPI) UnreadCountsBatch(ctx context.Context, request *getstream.UnreadCountsBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnreadCountsBatchResponse], error) {
+	m.record(ctx, "UnreadCountsBatch", request, opts)
+	if m.UnreadCountsBatchFunc != nil {
+		return m.UnreadCountsBatchFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnreadCountsBatchResponse]), nil
+}
+
+// SendUserCustomEvent records the call and calls SendUserCustomEventFunc if set.
+func (m *ChatAPI) SendUserCustomEvent(ctx context.Context, userID string, request *getstream.SendUserCustomEventRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "SendUserCustomEvent", userID, request, opts)
+	if m.SendUserCustomEventFunc != nil {
+		return m.SendUserCustomEventFunc(ctx, userID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
@@ -0,0 +1,889 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package mockgetstream
+
+import (
+	"context"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var _ getstream.CommonAPI = (*CommonAPI)(nil)
+
+// CommonAPI is a mock getstream.CommonAPI. Each method records the call and
+// calls the matching Func field if set; otherwise it returns an empty
+// response and no error. Set Func fields before use.
+type CommonAPI struct {
+	Recorder
+
+	GetAppFunc                            func(ctx context.Context, request *getstream.GetAppRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetApplicationResponse], error)
+	UpdateAppFunc                         func(ctx context.Context, request *getstream.UpdateAppRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	ListBlockListsFunc                    func(ctx context.Context, request *getstream.ListBlockListsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListBlockListResponse], error)
+	CreateBlockListFunc                   func(ctx context.Context, request *getstream.CreateBlockListRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateBlockListResponse], error)
+	ImportBlockListFunc                   func(ctx context.Context, id string, request *getstream.ImportBlockListRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ImportBlockListResponse], error)
+	DeleteBlockListFunc                   func(ctx context.Context, name string, request *getstream.DeleteBlockListRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetBlockListFunc                      func(ctx context.Context, name string, request *getstream.GetBlockListRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetBlockListResponse], error)
+	UpdateBlockListFunc                   func(ctx context.Context, name string, request *getstream.UpdateBlockListRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateBlockListResponse], error)
+	CheckPushFunc                         func(ctx context.Context, request *getstream.CheckPushRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckPushResponse], error)
+	CheckSNSFunc                          func(ctx context.Context, request *getstream.CheckSNSRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckSNSResponse], error)
+	CheckSQSFunc                          func(ctx context.Context, request *getstream.CheckSQSRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckSQSResponse], error)
+	DeleteDeviceFunc                      func(ctx context.Context, request *getstream.DeleteDeviceRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	ListDevicesFunc                       func(ctx context.Context, request *getstream.ListDevicesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListDevicesResponse], error)
+	CreateDeviceFunc                      func(ctx context.Context, request *getstream.CreateDeviceRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	ExportUsersFunc                       func(ctx context.Context, request *getstream.ExportUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ExportUsersResponse], error)
+	ListExternalStorageFunc               func(ctx context.Context, request *getstream.ListExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListExternalStorageResponse], error)
+	CreateExternalStorageFunc             func(ctx context.Context, request *getstream.CreateExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateExternalStorageResponse], error)
+	DeleteExternalStorageFunc             func(ctx context.Context, name string, request *getstream.DeleteExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteExternalStorageResponse], error)
+	UpdateExternalStorageFunc             func(ctx context.Context, name string, request *getstream.UpdateExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateExternalStorageResponse], error)
+	CheckExternalStorageFunc              func(ctx context.Context, name string, request *getstream.CheckExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckExternalStorageResponse], error)
+	CreateGuestFunc                       func(ctx context.Context, request *getstream.CreateGuestRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateGuestResponse], error)
+	CreateImportURLFunc                   func(ctx context.Context, request *getstream.CreateImportURLRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateImportURLResponse], error)
+	ListImportsFunc                       func(ctx context.Context, request *getstream.ListImportsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListImportsResponse], error)
+	CreateImportFunc                      func(ctx context.Context, request *getstream.CreateImportRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateImportResponse], error)
+	ListImportV2TasksFunc                 func(ctx context.Context, request *getstream.ListImportV2TasksRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListImportV2TasksResponse], error)
+	CreateImportV2TaskFunc                func(ctx context.Context, request *getstream.CreateImportV2TaskRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateImportV2TaskResponse], error)
+	DeleteImporterExternalStorageFunc     func(ctx context.Context, request *getstream.DeleteImporterExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteExternalStorageResponse], error)
+	GetImporterExternalStorageFunc        func(ctx context.Context, request *getstream.GetImporterExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetExternalStorageResponse], error)
+	UpsertImporterExternalStorageFunc     func(ctx context.Context, request *getstream.UpsertImporterExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertExternalStorageResponse], error)
+	ValidateImporterExternalStorageFunc   func(ctx context.Context, request *getstream.ValidateImporterExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ValidateExternalStorageResponse], error)
+	DeleteImportV2TaskFunc                func(ctx context.Context, id string, request *getstream.DeleteImportV2TaskRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteImportV2TaskResponse], error)
+	GetImportV2TaskFunc                   func(ctx context.Context, id string, request *getstream.GetImportV2TaskRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetImportV2TaskResponse], error)
+	CancelImportV2TaskFunc                func(ctx context.Context, id string, request *getstream.CancelImportV2TaskRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CancelImportV2TaskResponse], error)
+	GetImportFunc                         func(ctx context.Context, id string, request *getstream.GetImportRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetImportResponse], error)
+	GetOGFunc                             func(ctx context.Context, request *getstream.GetOGRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOGResponse], error)
+	ListPermissionsFunc                   func(ctx context.Context, request *getstream.ListPermissionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListPermissionsResponse], error)
+	GetPermissionFunc                     func(ctx context.Context, id string, request *getstream.GetPermissionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCustomPermissionResponse], error)
+	CreatePollFunc                        func(ctx context.Context, request *getstream.CreatePollRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollResponse], error)
+	UpdatePollFunc                        func(ctx context.Context, request *getstream.UpdatePollRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollResponse], error)
+	QueryPollsFunc                        func(ctx context.Context, request *getstream.QueryPollsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryPollsResponse], error)
+	DeletePollFunc                        func(ctx context.Context, pollID string, request *getstream.DeletePollRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetPollFunc                           func(ctx context.Context, pollID string, request *getstream.GetPollRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollResponse], error)
+	UpdatePollPartialFunc                 func(ctx context.Context, pollID string, request *getstream.UpdatePollPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollResponse], error)
+	CreatePollOptionFunc                  func(ctx context.Context, pollID string, request *getstream.CreatePollOptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollOptionResponse], error)
+	UpdatePollOptionFunc                  func(ctx context.Context, pollID string, request *getstream.UpdatePollOptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollOptionResponse], error)
+	DeletePollOptionFunc                  func(ctx context.Context, pollID string, optionID string, request *getstream.DeletePollOptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetPollOptionFunc                     func(ctx context.Context, pollID string, optionID string, request *getstream.GetPollOptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollOptionResponse], error)
+	QueryPollVotesFunc                    func(ctx context.Context, pollID string, request *getstream.QueryPollVotesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollVotesResponse], error)
+	UpdatePushNotificationPreferencesFunc func(ctx context.Context, request *getstream.UpdatePushNotificationPreferencesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertPushPreferencesResponse], error)
+	ListPushProvidersFunc                 func(ctx context.Context, request *getstream.ListPushProvidersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListPushProvidersResponse], error)
+	UpsertPushProviderFunc                func(ctx context.Context, request *getstream.UpsertPushProviderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertPushProviderResponse], error)
+	DeletePushProviderFunc                func(ctx context.Context, _type string, name string, request *getstream.DeletePushProviderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetPushTemplatesFunc                  func(ctx context.Context, request *getstream.GetPushTemplatesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetPushTemplatesResponse], error)
+	UpsertPushTemplateFunc                func(ctx context.Context, request *getstream.UpsertPushTemplateRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertPushTemplateResponse], error)
+	GetRateLimitsFunc                     func(ctx context.Context, request *getstream.GetRateLimitsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetRateLimitsResponse], error)
+	ListRolesFunc                         func(ctx context.Context, request *getstream.ListRolesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListRolesResponse], error)
+	CreateRoleFunc                        func(ctx context.Context, request *getstream.CreateRoleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateRoleResponse], error)
+	SearchRolesFunc                       func(ctx context.Context, request *getstream.SearchRolesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SearchRolesResponse], error)
+	DeleteRoleFunc                        func(ctx context.Context, name string, request *getstream.DeleteRoleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetTaskFunc                           func(ctx context.Context, id string, request *getstream.GetTaskRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetTaskResponse], error)
+	DeleteFileFunc                        func(ctx context.Context, request *getstream.DeleteFileRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	UploadFileFunc                        func(ctx context.Context, request *getstream.UploadFileRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.FileUploadResponse], error)
+	DeleteImageFunc                       func(ctx context.Context, request *getstream.DeleteImageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	UploadImageFunc                       func(ctx context.Context, request *getstream.UploadImageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ImageUploadResponse], error)
+	ListUserGroupsFunc                    func(ctx context.Context, request *getstream.ListUserGroupsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListUserGroupsResponse], error)
+	CreateUserGroupFunc                   func(ctx context.Context, request *getstream.CreateUserGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateUserGroupResponse], error)
+	SearchUserGroupsFunc                  func(ctx context.Context, request *getstream.SearchUserGroupsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SearchUserGroupsResponse], error)
+	DeleteUserGroupFunc                   func(ctx context.Context, id string, request *getstream.DeleteUserGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetUserGroupFunc                      func(ctx context.Context, id string, request *getstream.GetUserGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetUserGroupResponse], error)
+	UpdateUserGroupFunc                   func(ctx context.Context, id string, request *getstream.UpdateUserGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateUserGroupResponse], error)
+	AddUserGroupMembersFunc               func(ctx context.Context, id string, request *getstream.AddUserGroupMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddUserGroupMembersResponse], error)
+	RemoveUserGroupMembersFunc            func(ctx context.Context, id string, request *getstream.RemoveUserGroupMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RemoveUserGroupMembersResponse], error)
+	QueryUsersFunc                        func(ctx context.Context, request *getstream.QueryUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryUsersResponse], error)
+	UpdateUsersPartialFunc                func(ctx context.Context, request *getstream.UpdateUsersPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateUsersResponse], error)
+	UpdateUsersFunc                       func(ctx context.Context, request *getstream.UpdateUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateUsersResponse], error)
+	GetBlockedUsersFunc                   func(ctx context.Context, request *getstream.GetBlockedUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetBlockedUsersResponse], error)
+	BlockUsersFunc                        func(ctx context.Context, request *getstream.BlockUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BlockUsersResponse], error)
+	DeactivateUsersFunc                   func(ctx context.Context, request *getstream.DeactivateUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeactivateUsersResponse], error)
+	DeleteUsersFunc                       func(ctx context.Context, request *getstream.DeleteUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteUsersResponse], error)
+	GetUserLiveLocationsFunc              func(ctx context.Context, request *getstream.GetUserLiveLocationsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SharedLocationsResponse], error)
+	UpdateLiveLocationFunc                func(ctx context.Context, request *getstream.UpdateLiveLocationRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SharedLocationResponse], error)
+	ReactivateUsersFunc                   func(ctx context.Context, request *getstream.ReactivateUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ReactivateUsersResponse], error)
+	RestoreUsersFunc                      func(ctx context.Context, request *getstream.RestoreUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	UnblockUsersFunc                      func(ctx context.Context, request *getstream.UnblockUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnblockUsersResponse], error)
+	DeactivateUserFunc                    func(ctx context.Context, userID string, request *getstream.DeactivateUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeactivateUserResponse], error)
+	ExportUserFunc                        func(ctx context.Context, userID string, request *getstream.ExportUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ExportUserResponse], error)
+	ReactivateUserFunc                    func(ctx context.Context, userID string, request *getstream.ReactivateUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ReactivateUserResponse], error)
+}
+
+// GetApp records the call and calls GetAppFunc if set.
+func (m *CommonAPI) GetApp(ctx context.Context, request *getstream.GetAppRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetApplicationResponse], error) {
+	m.record(ctx, "GetApp", request, opts)
+	if m.GetAppFunc != nil {
+		return m.GetAppFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetApplicationResponse]), nil
+}
+
+// UpdateApp records the call and calls UpdateAppFunc if set.
+func (m *CommonAPI) UpdateApp(ctx context.Context, request *getstream.UpdateAppRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "UpdateApp", request, opts)
+	if m.UpdateAppFunc != nil {
+		return m.UpdateAppFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// ListBlockLists records the call and calls ListBlockListsFunc if set.
+func (m *CommonAPI) ListBlockLists(ctx context.Context, request *getstream.ListBlockListsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListBlockListResponse], error) {
+	m.record(ctx, "ListBlockLists", request, opts)
+	if m.ListBlockListsFunc != nil {
+		return m.ListBlockListsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListBlockListResponse]), nil
+}
+
+// CreateBlockList records the call and calls CreateBlockListFunc if set.
+func (m *CommonAPI) CreateBlockList(ctx context.Context, request *getstream.CreateBlockListRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateBlockListResponse], error) {
+	m.record(ctx, "CreateBlockList", request, opts)
+	if m.CreateBlockListFunc != nil {
+		return m.CreateBlockListFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateBlockListResponse]), nil
+}
+
+// ImportBlockList records the call and calls ImportBlockListFunc if set.
+func (m *CommonAPI) ImportBlockList(ctx context.Context, id string, request *getstream.ImportBlockListRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ImportBlockListResponse], error) {
+	m.record(ctx, "ImportBlockList", id, request, opts)
+	if m.ImportBlockListFunc != nil {
+		return m.ImportBlockListFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ImportBlockListResponse]), nil
+}
+
+// DeleteBlockList records the call and calls DeleteBlockListFunc if set.
+func (m *CommonAPI) DeleteBlockList(ctx context.Context, name string, request *getstream.DeleteBlockListRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteBlockList", name, request, opts)
+	if m.DeleteBlockListFunc != nil {
+		return m.DeleteBlockListFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetBlockList records the call and calls GetBlockListFunc if set.
+func (m *CommonAPI) GetBlockList(ctx context.Context, name string, request *getstream.GetBlockListRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetBlockListResponse], error) {
+	m.record(ctx, "GetBlockList", name, request, opts)
+	if m.GetBlockListFunc != nil {
+		return m.GetBlockListFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetBlockListResponse]), nil
+}
+
+// UpdateBlockList records the call and calls UpdateBlockListFunc if set.
+func (m *CommonAPI) UpdateBlockList(ctx context.Context, name string, request *getstream.UpdateBlockListRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateBlockListResponse], error) {
+	m.record(ctx, "UpdateBlockList", name, request, opts)
+	if m.UpdateBlockListFunc != nil {
+		return m.UpdateBlockListFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateBlockListResponse]), nil
+}
+
+// CheckPush records the call and calls CheckPushFunc if set.
+func (m *CommonAPI) CheckPush(ctx context.Context, request *getstream.CheckPushRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckPushResponse], error) {
+	m.record(ctx, "CheckPush", request, opts)
+	if m.CheckPushFunc != nil {
+		return m.CheckPushFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CheckPushResponse]), nil
+}
+
+// CheckSNS records the call and calls CheckSNSFunc if set.
+func (m *CommonAPI) CheckSNS(ctx context.Context, request *getstream.CheckSNSRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckSNSResponse], error) {
+	m.record(ctx, "CheckSNS", request, opts)
+	if m.CheckSNSFunc != nil {
+		return m.CheckSNSFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CheckSNSResponse]), nil
+}
+
+// CheckSQS records the call and calls CheckSQSFunc if set.
+func (m *CommonAPI) CheckSQS(ctx context.Context, request *getstream.CheckSQSRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckSQSResponse], error) {
+	m.record(ctx, "CheckSQS", request, opts)
+	if m.CheckSQSFunc != nil {
+		return m.CheckSQSFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CheckSQSResponse]), nil
+}
+
+// DeleteDevice records the call and calls DeleteDeviceFunc if set.
+func (m *CommonAPI) DeleteDevice(ctx context.Context, request *getstream.DeleteDeviceRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteDevice", request, opts)
+	if m.DeleteDeviceFunc != nil {
+		return m.DeleteDeviceFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// ListDevices records the call and calls ListDevicesFunc if set.
+func (m *CommonAPI) ListDevices(ctx context.Context, request *getstream.ListDevicesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListDevicesResponse], error) {
+	m.record(ctx, "ListDevices", request, opts)
+	if m.ListDevicesFunc != nil {
+		return m.ListDevicesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListDevicesResponse]), nil
+}
+
+// CreateDevice records the call and calls CreateDeviceFunc if set.
+func (m *CommonAPI) CreateDevice(ctx context.Context, request *getstream.CreateDeviceRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "CreateDevice", request, opts)
+	if m.CreateDeviceFunc != nil {
+		return m.CreateDeviceFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// ExportUsers records the call and calls ExportUsersFunc if set.
+func (m *CommonAPI) ExportUsers(ctx context.Context, request *getstream.ExportUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ExportUsersResponse], error) {
+	m.record(ctx, "ExportUsers", request, opts)
+	if m.ExportUsersFunc != nil {
+		return m.ExportUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ExportUsersResponse]), nil
+}
+
+// ListExternalStorage records the call and calls ListExternalStorageFunc if set.
+func (m *CommonAPI) ListExternalStorage(ctx context.Context, request *getstream.ListExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListExternalStorageResponse], error) {
+	m.record(ctx, "ListExternalStorage", request, opts)
+	if m.ListExternalStorageFunc != nil {
+		return m.ListExternalStorageFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListExternalStorageResponse]), nil
+}
+
+// CreateExternalStorage records the call and calls CreateExternalStorageFunc if set.
+func (m *CommonAPI) CreateExternalStorage(ctx context.Context, request *getstream.CreateExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateExternalStorageResponse], error) {
+	m.record(ctx, "CreateExternalStorage", request, opts)
+	if m.CreateExternalStorageFunc != nil {
+		return m.CreateExternalStorageFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateExternalStorageResponse]), nil
+}
+
+// DeleteExternalStorage records the call and calls DeleteExternalStorageFunc if set.
+func (m *CommonAPI) DeleteExternalStorage(ctx context.Context, name string, request *getstream.DeleteExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteExternalStorageResponse], error) {
+	m.record(ctx, "DeleteExternalStorage", name, request, opts)
+	if m.DeleteExternalStorageFunc != nil {
+		return m.DeleteExternalStorageFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteExternalStorageResponse]), nil
+}
+
+// UpdateExternalStorage records the call and calls UpdateExternalStorageFunc if set.
+func (m *CommonAPI) UpdateExternalStorage(ctx context.Context, name string, request *getstream.UpdateExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateExternalStorageResponse], error) {
+	m.record(ctx, "UpdateExternalStorage", name, request, opts)
+	if m.UpdateExternalStorageFunc != nil {
+		return m.UpdateExternalStorageFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateExternalStorageResponse]), nil
+}
+
+// CheckExternalStorage records the call and calls CheckExternalStorageFunc if set.
+func (m *CommonAPI) CheckExternalStorage(ctx context.Context, name string, request *getstream.CheckExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckExternalStorageResponse], error) {
+	m.record(ctx, "CheckExternalStorage", name, request, opts)
+	if m.CheckExternalStorageFunc != nil {
+		return m.CheckExternalStorageFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CheckExternalStorageResponse]), nil
+}
+
+// CreateGuest records the call and calls CreateGuestFunc if set.
+func (m *CommonAPI) CreateGuest(ctx context.Context, request *getstream.CreateGuestRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateGuestResponse], error) {
+	m.record(ctx, "CreateGuest", request, opts)
+	if m.CreateGuestFunc != nil {
+		return m.CreateGuestFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateGuestResponse]), nil
+}
+
+// CreateImportURL records the call and calls CreateImportURLFunc if set.
+func (m *CommonAPI) CreateImportURL(ctx context.Context, request *getstream.CreateImportURLRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateImportURLResponse], error) {
+	m.record(ctx, "CreateImportURL", request, opts)
+	if m.CreateImportURLFunc != nil {
+		return m.CreateImportURLFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateImportURLResponse]), nil
+}
+
+// ListImports records the call and calls ListImportsFunc if set.
+func (m *CommonAPI) ListImports(ctx context.Context, request *getstream.ListImportsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListImportsResponse], error) {
+	m.record(ctx, "ListImports", request, opts)
+	if m.ListImportsFunc != nil {
+		return m.ListImportsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListImportsResponse]), nil
+}
+
+// CreateImport records the call and calls CreateImportFunc if set.
+func (m *CommonAPI) CreateImport(ctx context.Context, request *getstream.CreateImportRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateImportResponse], error) {
+	m.record(ctx, "CreateImport", request, opts)
+	if m.CreateImportFunc != nil {
+		return m.CreateImportFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateImportResponse]), nil
+}
+
+// ListImportV2Tasks records the call and calls ListImportV2TasksFunc if set.
+func (m *CommonAPI) ListImportV2Tasks(ctx context.Context, request *getstream.ListImportV2TasksRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListImportV2TasksResponse], error) {
+	m.record(ctx, "ListImportV2Tasks", request, opts)
+	if m.ListImportV2TasksFunc != nil {
+		return m.ListImportV2TasksFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListImportV2TasksResponse]), nil
+}
+
+// CreateImportV2Task records the call and calls CreateImportV2TaskFunc if set.
+func (m *CommonAPI) CreateImportV2Task(ctx context.Context, request *getstream.CreateImportV2TaskRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateImportV2TaskResponse], error) {
+	m.record(ctx, "CreateImportV2Task", request, opts)
+	if m.CreateImportV2TaskFunc != nil {
+		return m.CreateImportV2TaskFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateImportV2TaskResponse]), nil
+}
+
+// DeleteImporterExternalStorage records the call and calls DeleteImporterExternalStorageFunc if set.
+func (m *CommonAPI) DeleteImporterExternalStorage(ctx context.Context, request *getstream.DeleteImporterExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteExternalStorageResponse], error) {
+	m.record(ctx, "DeleteImporterExternalStorage", request, opts)
+	if m.DeleteImporterExternalStorageFunc != nil {
+		return m.DeleteImporterExternalStorageFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteExternalStorageResponse]), nil
+}
+
+// GetImporterExternalStorage records the call and calls GetImporterExternalStorageFunc if set.
+func (m *CommonAPI) GetImporterExternalStorage(ctx context.Context, request *getstream.GetImporterExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetExternalStorageResponse], error) {
+	m.record(ctx, "GetImporterExternalStorage", request, opts)
+	if m.GetImporterExternalStorageFunc != nil {
+		return m.GetImporterExternalStorageFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetExternalStorageResponse]), nil
+}
+
+// UpsertImporterExternalStorage records the call and calls UpsertImporterExternalStorageFunc if set.
+func (m *CommonAPI) UpsertImporterExternalStorage(ctx context.Context, request *getstream.UpsertImporterExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertExternalStorageResponse], error) {
+	m.record(ctx, "UpsertImporterExternalStorage", request, opts)
+	if m.UpsertImporterExternalStorageFunc != nil {
+		return m.UpsertImporterExternalStorageFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertExternalStorageResponse]), nil
+}
+
+// ValidateImporterExternalStorage records the call and calls ValidateImporterExternalStorageFunc if set.
+func (m *CommonAPI) ValidateImporterExternalStorage(ctx context.Context, request *getstream.ValidateImporterExternalStorageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ValidateExternalStorageResponse], error) {
+	m.record(ctx, "ValidateImporterExternalStorage", request, opts)
+	if m.ValidateImporterExternalStorageFunc != nil {
+		return m.ValidateImporterExternalStorageFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ValidateExternalStorageResponse]), nil
+}
+
+// DeleteImportV2Task records the call and calls DeleteImportV2TaskFunc if set.
+func (m *CommonAPI) DeleteImportV2Task(ctx context.Context, id string, request *getstream.DeleteImportV2TaskRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteImportV2TaskResponse], error) {
+	m.record(ctx, "DeleteImportV2Task", id, request, opts)
+	if m.DeleteImportV2TaskFunc != nil {
+		return m.DeleteImportV2TaskFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteImportV2TaskResponse]), nil
+}
+
+// GetImportV2Task records the call and calls GetImportV2TaskFunc if set.
+func (m *CommonAPI) GetImportV2Task(ctx context.Context, id string, request *getstream.GetImportV2TaskRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetImportV2TaskResponse], error) {
+	m.record(ctx, "GetImportV2Task", id, request, opts)
+	if m.GetImportV2TaskFunc != nil {
+		return m.GetImportV2TaskFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetImportV2TaskResponse]), nil
+}
+
+// CancelImportV2Task records the call and calls CancelImportV2TaskFunc if set.
+func (m *CommonAPI) CancelImportV2Task(ctx context.Context, id string, request *getstream.CancelImportV2TaskRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CancelImportV2TaskResponse], error) {
+	m.record(ctx, "CancelImportV2Task", id, request, opts)
+	if m.CancelImportV2TaskFunc != nil {
+		return m.CancelImportV2TaskFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CancelImportV2TaskResponse]), nil
+}
+
+// GetImport records the call and calls GetImportFunc if set.
+func (m *CommonAPI) GetImport(ctx context.Context, id string, request *getstream.GetImportRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetImportResponse], error) {
+	m.record(ctx, "GetImport", id, request, opts)
+	if m.GetImportFunc != nil {
+		return m.GetImportFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetImportResponse]), nil
+}
+
+// GetOG records the call and calls GetOGFunc if set.
+func (m *CommonAPI) GetOG(ctx context.Context, request *getstream.GetOGRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOGResponse], error) {
+	m.record(ctx, "GetOG", request, opts)
+	if m.GetOGFunc != nil {
+		return m.GetOGFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetOGResponse]), nil
+}
+
+// ListPermissions records the call and calls ListPermissionsFunc if set.
+func (m *CommonAPI) ListPermissions(ctx context.Context, request *getstream.ListPermissionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListPermissionsResponse], error) {
+	m.record(ctx, "ListPermissions", request, opts)
+	if m.ListPermissionsFunc != nil {
+		return m.ListPermissionsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListPermissionsResponse]), nil
+}
+
+// GetPermission records the call and calls GetPermissionFunc if set.
+func (m *CommonAPI) GetPermission(ctx context.Context, id string, request *getstream.GetPermissionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCustomPermissionResponse], error) {
+	m.record(ctx, "GetPermission", id, request, opts)
+	if m.GetPermissionFunc != nil {
+		return m.GetPermissionFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCustomPermissionResponse]), nil
+}
+
+// CreatePoll records the call and calls CreatePollFunc if set.
+func (m *CommonAPI) CreatePoll(ctx context.Context, request *getstream.CreatePollRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollResponse], error) {
+	m.record(ctx, "CreatePoll", request, opts)
+	if m.CreatePollFunc != nil {
+		return m.CreatePollFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollResponse]), nil
+}
+
+// UpdatePoll records the call and calls UpdatePollFunc if set.
+func (m *CommonAPI) UpdatePoll(ctx context.Context, request *getstream.UpdatePollRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollResponse], error) {
+	m.record(ctx, "UpdatePoll", request, opts)
+	if m.UpdatePollFunc != nil {
+		return m.UpdatePollFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollResponse]), nil
+}
+
+// QueryPolls records the call and calls QueryPollsFunc if set.
+func (m *CommonAPI) QueryPolls(ctx context.Context, request *getstream.QueryPollsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryPollsResponse], error) {
+	m.record(ctx, "QueryPolls", request, opts)
+	if m.QueryPollsFunc != nil {
+		return m.QueryPollsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryPollsResponse]), nil
+}
+
+// DeletePoll records the call and calls DeletePollFunc if set.
+func (m *CommonAPI) DeletePoll(ctx context.Context, pollID string, request *getstream.DeletePollRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeletePoll", pollID, request, opts)
+	if m.DeletePollFunc != nil {
+		return m.DeletePollFunc(ctx, pollID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetPoll records the call and calls GetPollFunc if set.
+func (m *CommonAPI) GetPoll(ctx context.Context, pollID string, request *getstream.GetPollRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollResponse], error) {
+	m.record(ctx, "GetPoll", pollID, request, opts)
+	if m.GetPollFunc != nil {
+		return m.GetPollFunc(ctx, pollID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollResponse]), nil
+}
+
+// UpdatePollPartial records the call and calls UpdatePollPartialFunc if set.
+func (m *CommonAPI) UpdatePollPartial(ctx context.Context, pollID string, request *getstream.UpdatePollPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollResponse], error) {
+	m.record(ctx, "UpdatePollPartial", pollID, request, opts)
+	if m.UpdatePollPartialFunc != nil {
+		return m.UpdatePollPartialFunc(ctx, pollID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollResponse]), nil
+}
+
+// CreatePollOption records the call and calls CreatePollOptionFunc if set.
+func (m *CommonAPI) CreatePollOption(ctx context.Context, pollID string, request *getstream.CreatePollOptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollOptionResponse], error) {
+	m.record(ctx, "CreatePollOption", pollID, request, opts)
+	if m.CreatePollOptionFunc != nil {
+		return m.CreatePollOptionFunc(ctx, pollID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollOptionResponse]), nil
+}
+
+// UpdatePollOption records the call and calls UpdatePollOptionFunc if set.
+func (m *CommonAPI) UpdatePollOption(ctx context.Context, pollID string, request *getstream.UpdatePollOptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollOptionResponse], error) {
+	m.record(ctx, "UpdatePollOption", pollID, request, opts)
+	if m.UpdatePollOptionFunc != nil {
+		return m.UpdatePollOptionFunc(ctx, pollID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollOptionResponse]), nil
+}
+
+// DeletePollOption records the call and calls DeletePollOptionFunc if set.
+func (m *CommonAPI) DeletePollOption(ctx context.Context, pollID string, optionID string, request *getstream.DeletePollOptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeletePollOption", pollID, optionID, request, opts)
+	if m.DeletePollOptionFunc != nil {
+		return m.DeletePollOptionFunc(ctx, pollID, optionID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetPollOption records the call and calls GetPollOptionFunc if set.
+func (m *CommonAPI) GetPollOption(ctx context.Context, pollID string, optionID string, request *getstream.GetPollOptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollOptionResponse], error) {
+	m.record(ctx, "GetPollOption", pollID, optionID, request, opts)
+	if m.GetPollOptionFunc != nil {
+		return m.GetPollOptionFunc(ctx, pollID, optionID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollOptionResponse]), nil
+}
+
+// QueryPollVotes records the call and calls QueryPollVotesFunc if set.
+func (m *CommonAPI) QueryPollVotes(ctx context.Context, pollID string, request *getstream.QueryPollVotesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollVotesResponse], error) {
+	m.record(ctx, "QueryPollVotes", pollID, request, opts)
+	if m.QueryPollVotesFunc != nil {
+		return m.QueryPollVotesFunc(ctx, pollID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollVotesResponse]), nil
+}
+
+// UpdatePushNotificationPreferences records the call and calls UpdatePushNotificationPreferencesFunc if set.
+func (m *CommonAPI) UpdatePushNotificationPreferences(ctx context.Context, request *getstream.UpdatePushNotificationPreferencesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertPushPreferencesResponse], error) {
+	m.record(ctx, "UpdatePushNotificationPreferences", request, opts)
+	if m.UpdatePushNotificationPreferencesFunc != nil {
+		return m.UpdatePushNotificationPreferencesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertPushPreferencesResponse]), nil
+}
+
+// ListPushProviders records the call and calls ListPushProvidersFunc if set.
+func (m *CommonAPI) ListPushProviders(ctx context.Context, request *getstream.ListPushProvidersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListPushProvidersResponse], error) {
+	m.record(ctx, "ListPushProviders", request, opts)
+	if m.ListPushProvidersFunc != nil {
+		return m.ListPushProvidersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListPushProvidersResponse]), nil
+}
+
+// UpsertPushProvider records the call and calls UpsertPushProviderFunc if set.
+func (m *CommonAPI) UpsertPushProvider(ctx context.Context, request *getstream.UpsertPushProviderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertPushProviderResponse], error) {
+	m.record(ctx, "UpsertPushProvider", request, opts)
+	if m.UpsertPushProviderFunc != nil {
+		return m.UpsertPushProviderFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertPushProviderResponse]), nil
+}
+
+// DeletePushProvider records the call and calls DeletePushProviderFunc if set.
+func (m *CommonAPI) DeletePushProvider(ctx context.Context, _type string, name string, request *getstream.DeletePushProviderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeletePushProvider", _type, name, request, opts)
+	if m.DeletePushProviderFunc != nil {
+		return m.DeletePushProviderFunc(ctx, _type, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetPushTemplates records the call and calls GetPushTemplatesFunc if set.
+func (m *CommonAPI) GetPushTemplates(ctx context.Context, request *getstream.GetPushTemplatesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetPushTemplatesResponse], error) {
+	m.record(ctx, "GetPushTemplates", request, opts)
+	if m.GetPushTemplatesFunc != nil {
+		return m.GetPushTemplatesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetPushTemplatesResponse]), nil
+}
+
+// UpsertPushTemplate records the call and calls UpsertPushTemplateFunc if set.
+func (m *CommonAPI) UpsertPushTemplate(ctx context.Context, request *getstream.UpsertPushTemplateRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertPushTemplateResponse], error) {
+	m.record(ctx, "UpsertPushTemplate", request, opts)
+	if m.UpsertPushTemplateFunc != nil {
+		return m.UpsertPushTemplateFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertPushTemplateResponse]), nil
+}
+
+// GetRateLimits records the call and calls GetRateLimitsFunc if set.
+func (m *CommonAPI) GetRateLimits(ctx context.Context, request *getstream.GetRateLimitsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetRateLimitsResponse], error) {
+	m.record(ctx, "GetRateLimits", request, opts)
+	if m.GetRateLimitsFunc != nil {
+		return m.GetRateLimitsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetRateLimitsResponse]), nil
+}
+
+// ListRoles records the call and calls ListRolesFunc if set.
+func (m *CommonAPI) ListRoles(ctx context.Context, request *getstream.ListRolesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListRolesResponse], error) {
+	m.record(ctx, "ListRoles", request, opts)
+	if m.ListRolesFunc != nil {
+		return m.ListRolesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListRolesResponse]), nil
+}
+
+// CreateRole records the call and calls CreateRoleFunc if set.
+func (m *CommonAPI) CreateRole(ctx context.Context, request *getstream.CreateRoleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateRoleResponse], error) {
+	m.record(ctx, "CreateRole", request, opts)
+	if m.CreateRoleFunc != nil {
+		return m.CreateRoleFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateRoleResponse]), nil
+}
+
+// SearchRoles records the call and calls SearchRolesFunc if set.
+func (m *CommonAPI) SearchRoles(ctx context.Context, request *getstream.SearchRolesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SearchRolesResponse], error) {
+	m.record(ctx, "SearchRoles", request, opts)
+	if m.SearchRolesFunc != nil {
+		return m.SearchRolesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SearchRolesResponse]), nil
+}
+
+// DeleteRole records the call and calls DeleteRoleFunc if set.
+func (m *CommonAPI) DeleteRole(ctx context.Context, name string, request *getstream.DeleteRoleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteRole", name, request, opts)
+	if m.DeleteRoleFunc != nil {
+		return m.DeleteRoleFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetTask records the call and calls GetTaskFunc if set.
+func (m *CommonAPI) GetTask(ctx context.Context, id string, request *getstream.GetTaskRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetTaskResponse], error) {
+	m.record(ctx, "GetTask", id, request, opts)
+	if m.GetTaskFunc != nil {
+		return m.GetTaskFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetTaskResponse]), nil
+}
+
+// DeleteFile records the call and calls DeleteFileFunc if set.
+func (m *CommonAPI) DeleteFile(ctx context.Context, request *getstream.DeleteFileRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteFile", request, opts)
+	if m.DeleteFileFunc != nil {
+		return m.DeleteFileFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// UploadFile records the call and calls UploadFileFunc if set.
+func (m *CommonAPI) UploadFile(ctx context.Context, request *getstream.UploadFileRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.FileUploadResponse], error) {
+	m.record(ctx, "UploadFile", request, opts)
+	if m.UploadFileFunc != nil {
+		return m.UploadFileFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.FileUploadResponse]), nil
+}
+
+// DeleteImage records the call and calls DeleteImageFunc if set.
+func (m *CommonAPI) DeleteImage(ctx context.Context, request *getstream.DeleteImageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteImage", request, opts)
+	if m.DeleteImageFunc != nil {
+		return m.DeleteImageFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// UploadImage records the call and calls UploadImageFunc if set.
+func (m *CommonAPI) UploadImage(ctx context.Context, request *getstream.UploadImageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ImageUploadResponse], error) {
+	m.record(ctx, "UploadImage", request, opts)
+	if m.UploadImageFunc != nil {
+		return m.UploadImageFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ImageUploadResponse]), nil
+}
+
+// ListUserGroups records the call and calls ListUserGroupsFunc if set.
+func (m *CommonAPI) ListUserGroups(ctx context.Context, request *getstream.ListUserGroupsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListUserGroupsResponse], error) {
+	m.record(ctx, "ListUserGroups", request, opts)
+	if m.ListUserGroupsFunc != nil {
+		return m.ListUserGroupsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListUserGroupsResponse]), nil
+}
+
+// CreateUserGroup records the call and calls CreateUserGroupFunc if set.
+func (m *CommonAPI) CreateUserGroup(ctx context.Context, request *getstream.CreateUserGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateUserGroupResponse], error) {
+	m.record(ctx, "CreateUserGroup", request, opts)
+	if m.CreateUserGroupFunc != nil {
+		return m.CreateUserGroupFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateUserGroupResponse]), nil
+}
+
+// SearchUserGroups records the call and calls SearchUserGroupsFunc if set.
+func (m *CommonAPI) SearchUserGroups(ctx context.Context, request *getstream.SearchUserGroupsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SearchUserGroupsResponse], error) {
+	m.record(ctx, "SearchUserGroups", request, opts)
+	if m.SearchUserGroupsFunc != nil {
+		return m.SearchUserGroupsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SearchUserGroupsResponse]), nil
+}
+
+// DeleteUserGroup records the call and calls DeleteUserGroupFunc if set.
+func (m *CommonAPI) DeleteUserGroup(ctx context.Context, id string, request *getstream.DeleteUserGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteUserGroup", id, request, opts)
+	if m.DeleteUserGroupFunc != nil {
+		return m.DeleteUserGroupFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetUserGroup records the call and calls GetUserGroupFunc if set.
+func (m *CommonAPI) GetUserGroup(ctx context.Context, id string, request *getstream.GetUserGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetUserGroupResponse], error) {
+	m.record(ctx, "GetUserGroup", id, request, opts)
+	if m.GetUserGroupFunc != nil {
+		return m.GetUserGroupFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetUserGroupResponse]), nil
+}
+
+// UpdateUserGroup records the call and calls UpdateUserGroupFunc if set.
+func (m *CommonAPI) UpdateUserGroup(ctx context.Context, id string, request *getstream.UpdateUserGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateUserGroupResponse], error) {
+	m.record(ctx, "UpdateUserGroup", id, request, opts)
+	if m.UpdateUserGroupFunc != nil {
+		return m.UpdateUserGroupFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateUserGroupResponse]), nil
+}
+
+// AddUserGroupMembers records the call and calls AddUserGroupMembersFunc if set.
+func (m *CommonAPI) AddUserGroupMembers(ctx context.Context, id string, request *getstream.AddUserGroupMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddUserGroupMembersResponse], error) {
+	m.record(ctx, "AddUserGroupMembers", id, request, opts)
+	if m.AddUserGroupMembersFunc != nil {
+		return m.AddUserGroupMembersFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AddUserGroupMembersResponse]), nil
+}
+
+// RemoveUserGroupMembers records the call and calls RemoveUserGroupMembersFunc if set.
+func (m *CommonAPI) RemoveUserGroupMembers(ctx context.Context, id string, request *getstream.RemoveUserGroupMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RemoveUserGroupMembersResponse], error) {
+	m.record(ctx, "RemoveUserGroupMembers", id, request, opts)
+	if m.RemoveUserGroupMembersFunc != nil {
+		return m.RemoveUserGroupMembersFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.RemoveUserGroupMembersResponse]), nil
+}
+
+// QueryUsers records the call and calls QueryUsersFunc if set.
+func (m *CommonAPI) QueryUsers(ctx context.Context, request *getstream.QueryUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryUsersResponse], error) {
+	m.record(ctx, "QueryUsers", request, opts)
+	if m.QueryUsersFunc != nil {
+		return m.QueryUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryUsersResponse]), nil
+}
+
+// UpdateUsersPartial records the call and calls UpdateUsersPartialFunc if set.
+func (m *CommonAPI) UpdateUsersPartial(ctx context.Context, request *getstream.UpdateUsersPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateUsersResponse], error) {
+	m.record(ctx, "UpdateUsersPartial", request, opts)
+	if m.UpdateUsersPartialFunc != nil {
+		return m.UpdateUsersPartialFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateUsersResponse]), nil
+}
+
+// UpdateUsers records the call and calls UpdateUsersFunc if set.
+func (m *CommonAPI) UpdateUsers(ctx context.Context, request *getstream.UpdateUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateUsersResponse], error) {
+	m.record(ctx, "UpdateUsers", request, opts)
+	if m.UpdateUsersFunc != nil {
+		return m.UpdateUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateUsersResponse]), nil
+}
+
+// GetBlockedUsers records the call and calls GetBlockedUsersFunc if set.
+func (m *CommonAPI) GetBlockedUsers(ctx context.Context, request *getstream.GetBlockedUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetBlockedUsersResponse], error) {
+	m.record(ctx, "GetBlockedUsers", request, opts)
+	if m.GetBlockedUsersFunc != nil {
+		return m.GetBlockedUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetBlockedUsersResponse]), nil
+}
+
+// BlockUsers records the call and calls BlockUsersFunc if set.
+func (m *CommonAPI) BlockUsers(ctx context.Context, request *getstream.BlockUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BlockUsersResponse], error) {
+	m.record(ctx, "BlockUsers", request, opts)
+	if m.BlockUsersFunc != nil {
+		return m.BlockUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.BlockUsersResponse]), nil
+}
+
+// DeactivateUsers records the call and calls DeactivateUsersFunc if set.
+func (m *CommonAPI) DeactivateUsers(ctx context.Context, request *getstream.DeactivateUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeactivateUsersResponse], error) {
+	m.record(ctx, "DeactivateUsers", request, opts)
+	if m.DeactivateUsersFunc != nil {
+		return m.DeactivateUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeactivateUsersResponse]), nil
+}
+
+// DeleteUsers records the call and calls DeleteUsersFunc if set.
+func (m *CommonAPI) DeleteUsers(ctx context.Context, request *getstream.DeleteUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteUsersResponse], error) {
+	m.record(ctx, "DeleteUsers", request, opts)
+	if m.DeleteUsersFunc != nil {
+		return m.DeleteUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteUsersResponse]), nil
+}
+
+// GetUserLiveLocations records the call and calls GetUserLiveLocationsFunc if set.
+func (m *CommonAPI) GetUserLiveLocations(ctx context.Context, request *getstream.GetUserLiveLocationsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SharedLocationsResponse], error) {
+	m.record(ctx, "GetUserLiveLocations", request, opts)
+	if m.GetUserLiveLocationsFunc != nil {
+		return m.GetUserLiveLocationsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SharedLocationsResponse]), nil
+}
+
+// UpdateLiveLocation records the call and calls UpdateLiveLocationFunc if set.
+func (m *CommonAPI) UpdateLiveLocation(ctx context.Context, request *getstream.UpdateLiveLocationRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SharedLocationResponse], error) {
+	m.record(ctx, "UpdateLiveLocation", request, opts)
+	if m.UpdateLiveLocationFunc != nil {
+		return m.UpdateLiveLocationFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SharedLocationResponse]), nil
+}
+
+// ReactivateUsers records the call and calls ReactivateUsersFunc if set.
+func (m *CommonAPI) ReactivateUsers(ctx context.Context, request *getstream.ReactivateUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ReactivateUsersResponse], error) {
+	m.record(ctx, "ReactivateUsers", request, opts)
+	if m.ReactivateUsersFunc != nil {
+		return m.ReactivateUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ReactivateUsersResponse]), nil
+}
+
+// RestoreUsers records the call and calls RestoreUsersFunc if set.
+func (m *CommonAPI) RestoreUsers(ctx context.Context, request *getstream.RestoreUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "RestoreUsers", request, opts)
+	if m.RestoreUsersFunc != nil {
+		return m.RestoreUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// UnblockUsers records the call and calls UnblockUsersFunc if set.
+func (m *CommonAPI) UnblockUsers(ctx context.Context, request *getstream.UnblockUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnblockUsersResponse], error) {
+	m.record(ctx, "UnblockUsers", request, opts)
+	if m.UnblockUsersFunc != nil {
+		return m.UnblockUsersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnblockUsersResponse]), nil
+}
+
+// DeactivateUser records the call and calls DeactivateUserFunc if set.
+func (m *CommonAPI) DeactivateUser(ctx context.Context, userID string, request *getstream.DeactivateUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeactivateUserResponse], error) {
+	m.record(ctx, "DeactivateUser", userID, request, opts)
+	if m.DeactivateUserFunc != nil {
+		return m.DeactivateUserFunc(ctx, userID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeactivateUserResponse]), nil
+}
+
+// ExportUser records the call and calls ExportUserFunc if set.
+func (m *CommonAPI) ExportUser(ctx context.Context, userID string, request *getstream.ExportUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ExportUserResponse], error) {
+	m.record(ctx, "ExportUser", userID, request, opts)
+	if m.ExportUserFunc != nil {
+		return m.ExportUserFunc(ctx, userID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ExportUserResponse]), nil
+}
+
+// ReactivateUser records the call and calls ReactivateUserFunc if set.
+func (m *CommonAPI) ReactivateUser(ctx context.Context, userID string, request *getstream.ReactivateUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ReactivateUserResponse], error) {
+	m.record(ctx, "ReactivateUser", userID, request, opts)
+	if m.ReactivateUserFunc != nil {
+		return m.ReactivateUserFunc(ctx, userID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ReactivateUserResponse]), nil
+}
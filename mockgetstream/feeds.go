@@ -0,0 +1,1079 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package mockgetstream
+
+import (
+	"context"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var _ getstream.FeedsAPI = (*FeedsAPI)(nil)
+
+// FeedsAPI is a mock getstream.FeedsAPI. Each method records the call and
+// calls the matching Func field if set; otherwise it returns an empty
+// response and no error. Set Func fields before use.
+type FeedsAPI struct {
+	Recorder
+
+	AddActivityFunc                  func(ctx context.Context, request *getstream.AddActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddActivityResponse], error)
+	UpsertActivitiesFunc             func(ctx context.Context, request *getstream.UpsertActivitiesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertActivitiesResponse], error)
+	UpdateActivitiesPartialBatchFunc func(ctx context.Context, request *getstream.UpdateActivitiesPartialBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateActivitiesPartialBatchResponse], error)
+	DeleteActivitiesFunc             func(ctx context.Context, request *getstream.DeleteActivitiesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteActivitiesResponse], error)
+	TrackActivityMetricsFunc         func(ctx context.Context, request *getstream.TrackActivityMetricsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.TrackActivityMetricsResponse], error)
+	QueryActivitiesFunc              func(ctx context.Context, request *getstream.QueryActivitiesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryActivitiesResponse], error)
+	BatchQueryActivityReactionsFunc  func(ctx context.Context, request *getstream.BatchQueryActivityReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BatchQueryActivityReactionsResponse], error)
+	DeleteBookmarkFunc               func(ctx context.Context, activityID string, request *getstream.DeleteBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteBookmarkResponse], error)
+	UpdateBookmarkFunc               func(ctx context.Context, activityID string, request *getstream.UpdateBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateBookmarkResponse], error)
+	AddBookmarkFunc                  func(ctx context.Context, activityID string, request *getstream.AddBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddBookmarkResponse], error)
+	ActivityFeedbackFunc             func(ctx context.Context, activityID string, request *getstream.ActivityFeedbackRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ActivityFeedbackResponse], error)
+	CastPollVoteFunc                 func(ctx context.Context, activityID string, pollID string, request *getstream.CastPollVoteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollVoteResponse], error)
+	DeletePollVoteFunc               func(ctx context.Context, activityID string, pollID string, voteID string, request *getstream.DeletePollVoteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollVoteResponse], error)
+	AddActivityReactionFunc          func(ctx context.Context, activityID string, request *getstream.AddActivityReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddReactionResponse], error)
+	QueryActivityReactionsFunc       func(ctx context.Context, activityID string, request *getstream.QueryActivityReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryActivityReactionsResponse], error)
+	DeleteActivityReactionFunc       func(ctx context.Context, activityID string, _type string, request *getstream.DeleteActivityReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteActivityReactionResponse], error)
+	QueryActivitySharesFunc          func(ctx context.Context, activityID string, request *getstream.QueryActivitySharesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryActivitySharesResponse], error)
+	DeleteActivityFunc               func(ctx context.Context, id string, request *getstream.DeleteActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteActivityResponse], error)
+	GetActivityFunc                  func(ctx context.Context, id string, request *getstream.GetActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetActivityResponse], error)
+	UpdateActivityPartialFunc        func(ctx context.Context, id string, request *getstream.UpdateActivityPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateActivityPartialResponse], error)
+	UpdateActivityFunc               func(ctx context.Context, id string, request *getstream.UpdateActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateActivityResponse], error)
+	RestoreActivityFunc              func(ctx context.Context, id string, request *getstream.RestoreActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RestoreActivityResponse], error)
+	TranslateActivityFunc            func(ctx context.Context, id string, request *getstream.TranslateActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.TranslateActivityResponse], error)
+	QueryBookmarkFoldersFunc         func(ctx context.Context, request *getstream.QueryBookmarkFoldersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryBookmarkFoldersResponse], error)
+	DeleteBookmarkFolderFunc         func(ctx context.Context, folderID string, request *getstream.DeleteBookmarkFolderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteBookmarkFolderResponse], error)
+	UpdateBookmarkFolderFunc         func(ctx context.Context, folderID string, request *getstream.UpdateBookmarkFolderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateBookmarkFolderResponse], error)
+	QueryBookmarksFunc               func(ctx context.Context, request *getstream.QueryBookmarksRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryBookmarksResponse], error)
+	DeleteCollectionsFunc            func(ctx context.Context, request *getstream.DeleteCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCollectionsResponse], error)
+	ReadCollectionsFunc              func(ctx context.Context, request *getstream.ReadCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ReadCollectionsResponse], error)
+	UpdateCollectionsFunc            func(ctx context.Context, request *getstream.UpdateCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCollectionsResponse], error)
+	CreateCollectionsFunc            func(ctx context.Context, request *getstream.CreateCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateCollectionsResponse], error)
+	UpsertCollectionsFunc            func(ctx context.Context, request *getstream.UpsertCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertCollectionsResponse], error)
+	QueryCollectionsFunc             func(ctx context.Context, request *getstream.QueryCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCollectionsResponse], error)
+	GetCommentsFunc                  func(ctx context.Context, request *getstream.GetCommentsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCommentsResponse], error)
+	AddCommentFunc                   func(ctx context.Context, request *getstream.AddCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddCommentResponse], error)
+	AddCommentsBatchFunc             func(ctx context.Context, request *getstream.AddCommentsBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddCommentsBatchResponse], error)
+	QueryCommentsFunc                func(ctx context.Context, request *getstream.QueryCommentsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCommentsResponse], error)
+	BatchQueryCommentReactionsFunc   func(ctx context.Context, request *getstream.BatchQueryCommentReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BatchQueryCommentReactionsResponse], error)
+	DeleteCommentBookmarkFunc        func(ctx context.Context, commentID string, request *getstream.DeleteCommentBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCommentBookmarkResponse], error)
+	UpdateCommentBookmarkFunc        func(ctx context.Context, commentID string, request *getstream.UpdateCommentBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCommentBookmarkResponse], error)
+	AddCommentBookmarkFunc           func(ctx context.Context, commentID string, request *getstream.AddCommentBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddCommentBookmarkResponse], error)
+	DeleteCommentFunc                func(ctx context.Context, id string, request *getstream.DeleteCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCommentResponse], error)
+	GetCommentFunc                   func(ctx context.Context, id string, request *getstream.GetCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCommentResponse], error)
+	UpdateCommentFunc                func(ctx context.Context, id string, request *getstream.UpdateCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCommentResponse], error)
+	UpdateCommentPartialFunc         func(ctx context.Context, id string, request *getstream.UpdateCommentPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCommentPartialResponse], error)
+	AddCommentReactionFunc           func(ctx context.Context, id string, request *getstream.AddCommentReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddCommentReactionResponse], error)
+	QueryCommentReactionsFunc        func(ctx context.Context, id string, request *getstream.QueryCommentReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCommentReactionsResponse], error)
+	DeleteCommentReactionFunc        func(ctx context.Context, id string, _type string, request *getstream.DeleteCommentReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCommentReactionResponse], error)
+	GetCommentRepliesFunc            func(ctx context.Context, id string, request *getstream.GetCommentRepliesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCommentRepliesResponse], error)
+	RestoreCommentFunc               func(ctx context.Context, id string, request *getstream.RestoreCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RestoreCommentResponse], error)
+	TranslateCommentFunc             func(ctx context.Context, id string, request *getstream.TranslateCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.TranslateCommentResponse], error)
+	ListFeedGroupsFunc               func(ctx context.Context, request *getstream.ListFeedGroupsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListFeedGroupsResponse], error)
+	CreateFeedGroupFunc              func(ctx context.Context, request *getstream.CreateFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateFeedGroupResponse], error)
+	DeleteFeedFunc                   func(ctx context.Context, feedGroupID string, feedID string, request *getstream.DeleteFeedRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteFeedResponse], error)
+	GetOrCreateFeedFunc              func(ctx context.Context, feedGroupID string, feedID string, request *getstream.GetOrCreateFeedRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateFeedResponse], error)
+	UpdateFeedFunc                   func(ctx context.Context, feedGroupID string, feedID string, request *getstream.UpdateFeedRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFeedResponse], error)
+	MarkActivityFunc                 func(ctx context.Context, feedGroupID string, feedID string, request *getstream.MarkActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	UnpinActivityFunc                func(ctx context.Context, feedGroupID string, feedID string, activityID string, request *getstream.UnpinActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnpinActivityResponse], error)
+	PinActivityFunc                  func(ctx context.Context, feedGroupID string, feedID string, activityID string, request *getstream.PinActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PinActivityResponse], error)
+	ChangeFeedVisibilityFunc         func(ctx context.Context, feedGroupID string, feedID string, request *getstream.ChangeFeedVisibilityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ChangeFeedVisibilityResponse], error)
+	UpdateFeedMembersFunc            func(ctx context.Context, feedGroupID string, feedID string, request *getstream.UpdateFeedMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFeedMembersResponse], error)
+	AcceptFeedMemberInviteFunc       func(ctx context.Context, feedID string, feedGroupID string, request *getstream.AcceptFeedMemberInviteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AcceptFeedMemberInviteResponse], error)
+	QueryFeedMembersFunc             func(ctx context.Context, feedGroupID string, feedID string, request *getstream.QueryFeedMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFeedMembersResponse], error)
+	RejectFeedMemberInviteFunc       func(ctx context.Context, feedGroupID string, feedID string, request *getstream.RejectFeedMemberInviteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RejectFeedMemberInviteResponse], error)
+	QueryPinnedActivitiesFunc        func(ctx context.Context, feedGroupID string, feedID string, request *getstream.QueryPinnedActivitiesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryPinnedActivitiesResponse], error)
+	GetFollowSuggestionsFunc         func(ctx context.Context, feedGroupID string, request *getstream.GetFollowSuggestionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFollowSuggestionsResponse], error)
+	RestoreFeedGroupFunc             func(ctx context.Context, feedGroupID string, request *getstream.RestoreFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RestoreFeedGroupResponse], error)
+	DeleteFeedGroupFunc              func(ctx context.Context, id string, request *getstream.DeleteFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteFeedGroupResponse], error)
+	GetFeedGroupFunc                 func(ctx context.Context, id string, request *getstream.GetFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFeedGroupResponse], error)
+	GetOrCreateFeedGroupFunc         func(ctx context.Context, id string, request *getstream.GetOrCreateFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateFeedGroupResponse], error)
+	UpdateFeedGroupFunc              func(ctx context.Context, id string, request *getstream.UpdateFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFeedGroupResponse], error)
+	ListFeedViewsFunc                func(ctx context.Context, request *getstream.ListFeedViewsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListFeedViewsResponse], error)
+	CreateFeedViewFunc               func(ctx context.Context, request *getstream.CreateFeedViewRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateFeedViewResponse], error)
+	DeleteFeedViewFunc               func(ctx context.Context, id string, request *getstream.DeleteFeedViewRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteFeedViewResponse], error)
+	GetFeedViewFunc                  func(ctx context.Context, id string, request *getstream.GetFeedViewRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFeedViewResponse], error)
+	GetOrCreateFeedViewFunc          func(ctx context.Context, id string, request *getstream.GetOrCreateFeedViewRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateFeedViewResponse], error)
+	UpdateFeedViewFunc               func(ctx context.Context, id string, request *getstream.UpdateFeedViewRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFeedViewResponse], error)
+	ListFeedVisibilitiesFunc         func(ctx context.Context, request *getstream.ListFeedVisibilitiesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListFeedVisibilitiesResponse], error)
+	GetFeedVisibilityFunc            func(ctx context.Context, name string, request *getstream.GetFeedVisibilityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFeedVisibilityResponse], error)
+	UpdateFeedVisibilityFunc         func(ctx context.Context, name string, request *getstream.UpdateFeedVisibilityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFeedVisibilityResponse], error)
+	CreateFeedsBatchFunc             func(ctx context.Context, request *getstream.CreateFeedsBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateFeedsBatchResponse], error)
+	DeleteFeedsBatchFunc             func(ctx context.Context, request *getstream.DeleteFeedsBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteFeedsBatchResponse], error)
+	OwnBatchFunc                     func(ctx context.Context, request *getstream.OwnBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.OwnBatchResponse], error)
+	QueryFeedsFunc                   func(ctx context.Context, request *getstream.QueryFeedsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFeedsResponse], error)
+	GetFeedsRateLimitsFunc           func(ctx context.Context, request *getstream.GetFeedsRateLimitsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFeedsRateLimitsResponse], error)
+	UpdateFollowFunc                 func(ctx context.Context, request *getstream.UpdateFollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFollowResponse], error)
+	FollowFunc                       func(ctx context.Context, request *getstream.FollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SingleFollowResponse], error)
+	AcceptFollowFunc                 func(ctx context.Context, request *getstream.AcceptFollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AcceptFollowResponse], error)
+	FollowBatchFunc                  func(ctx context.Context, request *getstream.FollowBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.FollowBatchResponse], error)
+	GetOrCreateFollowsFunc           func(ctx context.Context, request *getstream.GetOrCreateFollowsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.FollowBatchResponse], error)
+	QueryFollowsFunc                 func(ctx context.Context, request *getstream.QueryFollowsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFollowsResponse], error)
+	RejectFollowFunc                 func(ctx context.Context, request *getstream.RejectFollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RejectFollowResponse], error)
+	GetOrCreateFollowFunc            func(ctx context.Context, request *getstream.GetOrCreateFollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateFollowResponse], error)
+	UnfollowFunc                     func(ctx context.Context, source string, target string, request *getstream.UnfollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnfollowResponse], error)
+	CreateMembershipLevelFunc        func(ctx context.Context, request *getstream.CreateMembershipLevelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateMembershipLevelResponse], error)
+	QueryMembershipLevelsFunc        func(ctx context.Context, request *getstream.QueryMembershipLevelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryMembershipLevelsResponse], error)
+	DeleteMembershipLevelFunc        func(ctx context.Context, id string, request *getstream.DeleteMembershipLevelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	UpdateMembershipLevelFunc        func(ctx context.Context, id string, request *getstream.UpdateMembershipLevelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateMembershipLevelResponse], error)
+	QueryRevisionHistoryFunc         func(ctx context.Context, request *getstream.QueryRevisionHistoryRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryRevisionHistoryResponse], error)
+	QueryFeedsUsageStatsFunc         func(ctx context.Context, request *getstream.QueryFeedsUsageStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFeedsUsageStatsResponse], error)
+	UnfollowBatchFunc                func(ctx context.Context, request *getstream.UnfollowBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnfollowBatchResponse], error)
+	GetOrCreateUnfollowsFunc         func(ctx context.Context, request *getstream.GetOrCreateUnfollowsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnfollowBatchResponse], error)
+	GetOrCreateUnfollowFunc          func(ctx context.Context, request *getstream.GetOrCreateUnfollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateUnfollowResponse], error)
+	DeleteFeedUserDataFunc           func(ctx context.Context, userID string, request *getstream.DeleteFeedUserDataRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteFeedUserDataResponse], error)
+	ExportFeedUserDataFunc           func(ctx context.Context, userID string, request *getstream.ExportFeedUserDataRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ExportFeedUserDataResponse], error)
+	GetUserInterestsFunc             func(ctx context.Context, userID string, request *getstream.GetUserInterestsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetUserInterestsResponse], error)
+}
+
+// AddActivity records the call and calls AddActivityFunc if set.
+func (m *FeedsAPI) AddActivity(ctx context.Context, request *getstream.AddActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddActivityResponse], error) {
+	m.record(ctx, "AddActivity", request, opts)
+	if m.AddActivityFunc != nil {
+		return m.AddActivityFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AddActivityResponse]), nil
+}
+
+// UpsertActivities records the call and calls UpsertActivitiesFunc if set.
+func (m *FeedsAPI) UpsertActivities(ctx context.Context, request *getstream.UpsertActivitiesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertActivitiesResponse], error) {
+	m.record(ctx, "UpsertActivities", request, opts)
+	if m.UpsertActivitiesFunc != nil {
+		return m.UpsertActivitiesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertActivitiesResponse]), nil
+}
+
+// UpdateActivitiesPartialBatch records the call and calls UpdateActivitiesPartialBatchFunc if set.
+func (m *FeedsAPI) UpdateActivitiesPartialBatch(ctx context.Context, request *getstream.UpdateActivitiesPartialBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateActivitiesPartialBatchResponse], error) {
+	m.record(ctx, "UpdateActivitiesPartialBatch", request, opts)
+	if m.UpdateActivitiesPartialBatchFunc != nil {
+		return m.UpdateActivitiesPartialBatchFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateActivitiesPartialBatchResponse]), nil
+}
+
+// DeleteActivities records the call and calls DeleteActivitiesFunc if set.
+func (m *FeedsAPI) DeleteActivities(ctx context.Context, request *getstream.DeleteActivitiesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteActivitiesResponse], error) {
+	m.record(ctx, "DeleteActivities", request, opts)
+	if m.DeleteActivitiesFunc != nil {
+		return m.DeleteActivitiesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteActivitiesResponse]), nil
+}
+
+// TrackActivityMetrics records the call and calls TrackActivityMetricsFunc if set.
+func (m *FeedsAPI) TrackActivityMetrics(ctx context.Context, request *getstream.TrackActivityMetricsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.TrackActivityMetricsResponse], error) {
+	m.record(ctx, "TrackActivityMetrics", request, opts)
+	if m.TrackActivityMetricsFunc != nil {
+		return m.TrackActivityMetricsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.TrackActivityMetricsResponse]), nil
+}
+
+// QueryActivities records the call and calls QueryActivitiesFunc if set.
+func (m *FeedsAPI) QueryActivities(ctx context.Context, request *getstream.QueryActivitiesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryActivitiesResponse], error) {
+	m.record(ctx, "QueryActivities", request, opts)
+	if m.QueryActivitiesFunc != nil {
+		return m.QueryActivitiesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryActivitiesResponse]), nil
+}
+
+// BatchQueryActivityReactions records the call and calls BatchQueryActivityReactionsFunc if set.
+func (m *FeedsAPI) BatchQueryActivityReactions(ctx context.Context, request *getstream.BatchQueryActivityReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BatchQueryActivityReactionsResponse], error) {
+	m.record(ctx, "BatchQueryActivityReactions", request, opts)
+	if m.BatchQueryActivityReactionsFunc != nil {
+		return m.BatchQueryActivityReactionsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.BatchQueryActivityReactionsResponse]), nil
+}
+
+// DeleteBookmark records the call and calls DeleteBookmarkFunc if set.
+func (m *FeedsAPI) DeleteBookmark(ctx context.Context, activityID string, request *getstream.DeleteBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteBookmarkResponse], error) {
+	m.record(ctx, "DeleteBookmark", activityID, request, opts)
+	if m.DeleteBookmarkFunc != nil {
+		return m.DeleteBookmarkFunc(ctx, activityID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteBookmarkResponse]), nil
+}
+
+// UpdateBookmark records the call and calls UpdateBookmarkFunc if set.
+func (m *FeedsAPI) UpdateBookmark(ctx context.Context, activityID string, request *getstream.UpdateBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateBookmarkResponse], error) {
+	m.record(ctx, "UpdateBookmark", activityID, request, opts)
+	if m.UpdateBookmarkFunc != nil {
+		return m.UpdateBookmarkFunc(ctx, activityID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateBookmarkResponse]), nil
+}
+
+// AddBookmark records the call and calls AddBookmarkFunc if set.
+func (m *FeedsAPI) AddBookmark(ctx context.Context, activityID string, request *getstream.AddBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddBookmarkResponse], error) {
+	m.record(ctx, "AddBookmark", activityID, request, opts)
+	if m.AddBookmarkFunc != nil {
+		return m.AddBookmarkFunc(ctx, activityID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AddBookmarkResponse]), nil
+}
+
+// ActivityFeedback records the call and calls ActivityFeedbackFunc if set.
+func (m *FeedsAPI) ActivityFeedback(ctx context.Context, activityID string, request *getstream.ActivityFeedbackRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ActivityFeedbackResponse], error) {
+	m.record(ctx, "ActivityFeedback", activityID, request, opts)
+	if m.ActivityFeedbackFunc != nil {
+		return m.ActivityFeedbackFunc(ctx, activityID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ActivityFeedbackResponse]), nil
+}
+
+// CastPollVote records the call and calls CastPollVoteFunc if set.
+func (m *FeedsAPI) CastPollVote(ctx context.Context, activityID string, pollID string, request *getstream.CastPollVoteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollVoteResponse], error) {
+	m.record(ctx, "CastPollVote", activityID, pollID, request, opts)
+	if m.CastPollVoteFunc != nil {
+		return m.CastPollVoteFunc(ctx, activityID, pollID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollVoteResponse]), nil
+}
+
+// DeletePollVote records the call and calls DeletePollVoteFunc if set.
+func (m *FeedsAPI) DeletePollVote(ctx context.Context, activityID string, pollID string, voteID string, request *getstream.DeletePollVoteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PollVoteResponse], error) {
+	m.record(ctx, "DeletePollVote", activityID, pollID, voteID, request, opts)
+	if m.DeletePollVoteFunc != nil {
+		return m.DeletePollVoteFunc(ctx, activityID, pollID, voteID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PollVoteResponse]), nil
+}
+
+// AddActivityReaction records the call and calls AddActivityReactionFunc if set.
+func (m *FeedsAPI) AddActivityReaction(ctx context.Context, activityID string, request *getstream.AddActivityReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddReactionResponse], error) {
+	m.record(ctx, "AddActivityReaction", activityID, request, opts)
+	if m.AddActivityReactionFunc != nil {
+		return m.AddActivityReactionFunc(ctx, activityID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AddReactionResponse]), nil
+}
+
+// QueryActivityReactions records the call and calls QueryActivityReactionsFunc if set.
+func (m *FeedsAPI) QueryActivityReactions(ctx context.Context, activityID string, request *getstream.QueryActivityReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryActivityReactionsResponse], error) {
+	m.record(ctx, "QueryActivityReactions", activityID, request, opts)
+	if m.QueryActivityReactionsFunc != nil {
+		return m.QueryActivityReactionsFunc(ctx, activityID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryActivityReactionsResponse]), nil
+}
+
+// DeleteActivityReaction records the call and calls DeleteActivityReactionFunc if set.
+func (m *FeedsAPI) DeleteActivityReaction(ctx context.Context, activityID string, _type string, request *getstream.DeleteActivityReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteActivityReactionResponse], error) {
+	m.record(ctx, "DeleteActivityReaction", activityID, _type, request, opts)
+	if m.DeleteActivityReactionFunc != nil {
+		return m.DeleteActivityReactionFunc(ctx, activityID, _type, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteActivityReactionResponse]), nil
+}
+
+// QueryActivityShares records the call and calls QueryActivitySharesFunc if set.
+func (m *FeedsAPI) QueryActivityShares(ctx context.Context, activityID string, request *getstream.QueryActivitySharesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryActivitySharesResponse], error) {
+	m.record(ctx, "QueryActivityShares", activityID, request, opts)
+	if m.QueryActivitySharesFunc != nil {
+		return m.QueryActivitySharesFunc(ctx, activityID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryActivitySharesResponse]), nil
+}
+
+// DeleteActivity records the call and calls DeleteActivityFunc if set.
+func (m *FeedsAPI) DeleteActivity(ctx context.Context, id string, request *getstream.DeleteActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteActivityResponse], error) {
+	m.record(ctx, "DeleteActivity", id, request, opts)
+	if m.DeleteActivityFunc != nil {
+		return m.DeleteActivityFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteActivityResponse]), nil
+}
+
+// GetActivity records the call and calls GetActivityFunc if set.
+func (m *FeedsAPI) GetActivity(ctx context.Context, id string, request *getstream.GetActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetActivityResponse], error) {
+	m.record(ctx, "GetActivity", id, request, opts)
+	if m.GetActivityFunc != nil {
+		return m.GetActivityFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetActivityResponse]), nil
+}
+
+// UpdateActivityPartial records the call and calls UpdateActivityPartialFunc if set.
+func (m *FeedsAPI) UpdateActivityPartial(ctx context.Context, id string, request *getstream.UpdateActivityPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateActivityPartialResponse], error) {
+	m.record(ctx, "UpdateActivityPartial", id, request, opts)
+	if m.UpdateActivityPartialFunc != nil {
+		return m.UpdateActivityPartialFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateActivityPartialResponse]), nil
+}
+
+// UpdateActivity records the call and calls UpdateActivityFunc if set.
+func (m *FeedsAPI) UpdateActivity(ctx context.Context, id string, request *getstream.UpdateActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateActivityResponse], error) {
+	m.record(ctx, "UpdateActivity", id, request, opts)
+	if m.UpdateActivityFunc != nil {
+		return m.UpdateActivityFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateActivityResponse]), nil
+}
+
+// RestoreActivity records the call and calls RestoreActivityFunc if set.
+func (m *FeedsAPI) RestoreActivity(ctx context.Context, id string, request *getstream.RestoreActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RestoreActivityResponse], error) {
+	m.record(ctx, "RestoreActivity", id, request, opts)
+	if m.RestoreActivityFunc != nil {
+		return m.RestoreActivityFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.RestoreActivityResponse]), nil
+}
+
+// TranslateActivity records the call and calls TranslateActivityFunc if set.
+func (m *FeedsAPI) TranslateActivity(ctx context.Context, id string, request *getstream.TranslateActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.TranslateActivityResponse], error) {
+	m.record(ctx, "TranslateActivity", id, request, opts)
+	if m.TranslateActivityFunc != nil {
+		return m.TranslateActivityFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.TranslateActivityResponse]), nil
+}
+
+// QueryBookmarkFolders records the call and calls QueryBookmarkFoldersFunc if set.
+func (m *FeedsAPI) QueryBookmarkFolders(ctx context.Context, request *getstream.QueryBookmarkFoldersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryBookmarkFoldersResponse], error) {
+	m.record(ctx, "QueryBookmarkFolders", request, opts)
+	if m.QueryBookmarkFoldersFunc != nil {
+		return m.QueryBookmarkFoldersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryBookmarkFoldersResponse]), nil
+}
+
+// DeleteBookmarkFolder records the call and calls DeleteBookmarkFolderFunc if set.
+func (m *FeedsAPI) DeleteBookmarkFolder(ctx context.Context, folderID string, request *getstream.DeleteBookmarkFolderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteBookmarkFolderResponse], error) {
+	m.record(ctx, "DeleteBookmarkFolder", folderID, request, opts)
+	if m.DeleteBookmarkFolderFunc != nil {
+		return m.DeleteBookmarkFolderFunc(ctx, folderID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteBookmarkFolderResponse]), nil
+}
+
+// UpdateBookmarkFolder records the call and calls UpdateBookmarkFolderFunc if set.
+func (m *FeedsAPI) UpdateBookmarkFolder(ctx context.Context, folderID string, request *getstream.UpdateBookmarkFolderRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateBookmarkFolderResponse], error) {
+	m.record(ctx, "UpdateBookmarkFolder", folderID, request, opts)
+	if m.UpdateBookmarkFolderFunc != nil {
+		return m.UpdateBookmarkFolderFunc(ctx, folderID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateBookmarkFolderResponse]), nil
+}
+
+// QueryBookmarks records the call and calls QueryBookmarksFunc if set.
+func (m *FeedsAPI) QueryBookmarks(ctx context.Context, request *getstream.QueryBookmarksRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryBookmarksResponse], error) {
+	m.record(ctx, "QueryBookmarks", request, opts)
+	if m.QueryBookmarksFunc != nil {
+		return m.QueryBookmarksFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryBookmarksResponse]), nil
+}
+
+// DeleteCollections records the call and calls DeleteCollectionsFunc if set.
+func (m *FeedsAPI) DeleteCollections(ctx context.Context, request *getstream.DeleteCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCollectionsResponse], error) {
+	m.record(ctx, "DeleteCollections", request, opts)
+	if m.DeleteCollectionsFunc != nil {
+		return m.DeleteCollectionsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteCollectionsResponse]), nil
+}
+
+// ReadCollections records the call and calls ReadCollectionsFunc if set.
+func (m *FeedsAPI) ReadCollections(ctx context.Context, request *getstream.ReadCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ReadCollectionsResponse], error) {
+	m.record(ctx, "ReadCollections", request, opts)
+	if m.ReadCollectionsFunc != nil {
+		return m.ReadCollectionsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ReadCollectionsResponse]), nil
+}
+
+// UpdateCollections records the call and calls UpdateCollectionsFunc if set.
+func (m *FeedsAPI) UpdateCollections(ctx context.Context, request *getstream.UpdateCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCollectionsResponse], error) {
+	m.record(ctx, "UpdateCollections", request, opts)
+	if m.UpdateCollectionsFunc != nil {
+		return m.UpdateCollectionsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateCollectionsResponse]), nil
+}
+
+// CreateCollections records the call and calls CreateCollectionsFunc if set.
+func (m *FeedsAPI) CreateCollections(ctx context.Context, request *getstream.CreateCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateCollectionsResponse], error) {
+	m.record(ctx, "CreateCollections", request, opts)
+	if m.CreateCollectionsFunc != nil {
+		return m.CreateCollectionsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateCollectionsResponse]), nil
+}
+
+// UpsertCollections records the call and calls UpsertCollectionsFunc if set.
+func (m *FeedsAPI) UpsertCollections(ctx context.Context, request *getstream.UpsertCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertCollectionsResponse], error) {
+	m.record(ctx, "UpsertCollections", request, opts)
+	if m.UpsertCollectionsFunc != nil {
+		return m.UpsertCollectionsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertCollectionsResponse]), nil
+}
+
+// QueryCollections records the call and calls QueryCollectionsFunc if set.
+func (m *FeedsAPI) QueryCollections(ctx context.Context, request *getstream.QueryCollectionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCollectionsResponse], error) {
+	m.record(ctx, "QueryCollections", request, opts)
+	if m.QueryCollectionsFunc != nil {
+		return m.QueryCollectionsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCollectionsResponse]), nil
+}
+
+// GetComments records the call and calls GetCommentsFunc if set.
+func (m *FeedsAPI) GetComments(ctx context.Context, request *getstream.GetCommentsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCommentsResponse], error) {
+	m.record(ctx, "GetComments", request, opts)
+	if m.GetCommentsFunc != nil {
+		return m.GetCommentsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCommentsResponse]), nil
+}
+
+// AddComment records the call and calls AddCommentFunc if set.
+func (m *FeedsAPI) AddComment(ctx context.Context, request *getstream.AddCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddCommentResponse], error) {
+	m.record(ctx, "AddComment", request, opts)
+	if m.AddCommentFunc != nil {
+		return m.AddCommentFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AddCommentResponse]), nil
+}
+
+// AddCommentsBatch records the call and calls AddCommentsBatchFunc if set.
+func (m *FeedsAPI) AddCommentsBatch(ctx context.Context, request *getstream.AddCommentsBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddCommentsBatchResponse], error) {
+	m.record(ctx, "AddCommentsBatch", request, opts)
+	if m.AddCommentsBatchFunc != nil {
+		return m.AddCommentsBatchFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AddCommentsBatchResponse]), nil
+}
+
+// QueryComments records the call and calls QueryCommentsFunc if set.
+func (m *FeedsAPI) QueryComments(ctx context.Context, request *getstream.QueryCommentsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCommentsResponse], error) {
+	m.record(ctx, "QueryComments", request, opts)
+	if m.QueryCommentsFunc != nil {
+		return m.QueryCommentsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCommentsResponse]), nil
+}
+
+// BatchQueryCommentReactions records the call and calls BatchQueryCommentReactionsFunc if set.
+func (m *FeedsAPI) BatchQueryCommentReactions(ctx context.Context, request *getstream.BatchQueryCommentReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BatchQueryCommentReactionsResponse], error) {
+	m.record(ctx, "BatchQueryCommentReactions", request, opts)
+	if m.BatchQueryCommentReactionsFunc != nil {
+		return m.BatchQueryCommentReactionsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.BatchQueryCommentReactionsResponse]), nil
+}
+
+// DeleteCommentBookmark records the call and calls DeleteCommentBookmarkFunc if set.
+func (m *FeedsAPI) DeleteCommentBookmark(ctx context.Context, commentID string, request *getstream.DeleteCommentBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCommentBookmarkResponse], error) {
+	m.record(ctx, "DeleteCommentBookmark", commentID, request, opts)
+	if m.DeleteCommentBookmarkFunc != nil {
+		return m.DeleteCommentBookmarkFunc(ctx, commentID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteCommentBookmarkResponse]), nil
+}
+
+// UpdateCommentBookmark records the call and calls UpdateCommentBookmarkFunc if set.
+func (m *FeedsAPI) UpdateCommentBookmark(ctx context.Context, commentID string, request *getstream.UpdateCommentBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCommentBookmarkResponse], error) {
+	m.record(ctx, "UpdateCommentBookmark", commentID, request, opts)
+	if m.UpdateCommentBookmarkFunc != nil {
+		return m.UpdateCommentBookmarkFunc(ctx, commentID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateCommentBookmarkResponse]), nil
+}
+
+// AddCommentBookmark records the call and calls AddCommentBookmarkFunc if set.
+func (m *FeedsAPI) AddCommentBookmark(ctx context.Context, commentID string, request *getstream.AddCommentBookmarkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddCommentBookmarkResponse], error) {
+	m.record(ctx, "AddCommentBookmark", commentID, request, opts)
+	if m.AddCommentBookmarkFunc != nil {
+		return m.AddCommentBookmarkFunc(ctx, commentID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AddCommentBookmarkResponse]), nil
+}
+
+// DeleteComment records the call and calls DeleteCommentFunc if set.
+func (m *FeedsAPI) DeleteComment(ctx context.Context, id string, request *getstream.DeleteCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCommentResponse], error) {
+	m.record(ctx, "DeleteComment", id, request, opts)
+	if m.DeleteCommentFunc != nil {
+		return m.DeleteCommentFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteCommentResponse]), nil
+}
+
+// GetComment records the call and calls GetCommentFunc if set.
+func (m *FeedsAPI) GetComment(ctx context.Context, id string, request *getstream.GetCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCommentResponse], error) {
+	m.record(ctx, "GetComment", id, request, opts)
+	if m.GetCommentFunc != nil {
+		return m.GetCommentFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCommentResponse]), nil
+}
+
+// UpdateComment records the call and calls UpdateCommentFunc if set.
+func (m *FeedsAPI) UpdateComment(ctx context.Context, id string, request *getstream.UpdateCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCommentResponse], error) {
+	m.record(ctx, "UpdateComment", id, request, opts)
+	if m.UpdateCommentFunc != nil {
+		return m.UpdateCommentFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateCommentResponse]), nil
+}
+
+// UpdateCommentPartial records the call and calls UpdateCommentPartialFunc if set.
+func (m *FeedsAPI) UpdateCommentPartial(ctx context.Context, id string, request *getstream.UpdateCommentPartialRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCommentPartialResponse], error) {
+	m.record(ctx, "UpdateCommentPartial", id, request, opts)
+	if m.UpdateCommentPartialFunc != nil {
+		return m.UpdateCommentPartialFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateCommentPartialResponse]), nil
+}
+
+// AddCommentReaction records the call and calls AddCommentReactionFunc if set.
+func (m *FeedsAPI) AddCommentReaction(ctx context.Context, id string, request *getstream.AddCommentReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AddCommentReactionResponse], error) {
+	m.record(ctx, "AddCommentReaction", id, request, opts)
+	if m.AddCommentReactionFunc != nil {
+		return m.AddCommentReactionFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AddCommentReactionResponse]), nil
+}
+
+// QueryCommentReactions records the call and calls QueryCommentReactionsFunc if set.
+func (m *FeedsAPI) QueryCommentReactions(ctx context.Context, id string, request *getstream.QueryCommentReactionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCommentReactionsResponse], error) {
+	m.record(ctx, "QueryCommentReactions", id, request, opts)
+	if m.QueryCommentReactionsFunc != nil {
+		return m.QueryCommentReactionsFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCommentReactionsResponse]), nil
+}
+
+// DeleteCommentReaction records the call and calls DeleteCommentReactionFunc if set.
+func (m *FeedsAPI) DeleteCommentReaction(ctx context.Context, id string, _type string, request *getstream.DeleteCommentReactionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCommentReactionResponse], error) {
+	m.record(ctx, "DeleteCommentReaction", id, _type, request, opts)
+	if m.DeleteCommentReactionFunc != nil {
+		return m.DeleteCommentReactionFunc(ctx, id, _type, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteCommentReactionResponse]), nil
+}
+
+// GetCommentReplies records the call and calls GetCommentRepliesFunc if set.
+func (m *FeedsAPI) GetCommentReplies(ctx context.Context, id string, request *getstream.GetCommentRepliesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCommentRepliesResponse], error) {
+	m.record(ctx, "GetCommentReplies", id, request, opts)
+	if m.GetCommentRepliesFunc != nil {
+		return m.GetCommentRepliesFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCommentRepliesResponse]), nil
+}
+
+// RestoreComment records the call and calls RestoreCommentFunc if set.
+func (m *FeedsAPI) RestoreComment(ctx context.Context, id string, request *getstream.RestoreCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RestoreCommentResponse], error) {
+	m.record(ctx, "RestoreComment", id, request, opts)
+	if m.RestoreCommentFunc != nil {
+		return m.RestoreCommentFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.RestoreCommentResponse]), nil
+}
+
+// TranslateComment records the call and calls TranslateCommentFunc if set.
+func (m *FeedsAPI) TranslateComment(ctx context.Context, id string, request *getstream.TranslateCommentRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.TranslateCommentResponse], error) {
+	m.record(ctx, "TranslateComment", id, request, opts)
+	if m.TranslateCommentFunc != nil {
+		return m.TranslateCommentFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.TranslateCommentResponse]), nil
+}
+
+// ListFeedGroups records the call and calls ListFeedGroupsFunc if set.
+func (m *FeedsAPI) ListFeedGroups(ctx context.Context, request *getstream.ListFeedGroupsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListFeedGroupsResponse], error) {
+	m.record(ctx, "ListFeedGroups", request, opts)
+	if m.ListFeedGroupsFunc != nil {
+		return m.ListFeedGroupsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListFeedGroupsResponse]), nil
+}
+
+// CreateFeedGroup records the call and calls CreateFeedGroupFunc if set.
+func (m *FeedsAPI) CreateFeedGroup(ctx context.Context, request *getstream.CreateFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateFeedGroupResponse], error) {
+	m.record(ctx, "CreateFeedGroup", request, opts)
+	if m.CreateFeedGroupFunc != nil {
+		return m.CreateFeedGroupFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateFeedGroupResponse]), nil
+}
+
+// DeleteFeed records the call and calls DeleteFeedFunc if set.
+func (m *FeedsAPI) DeleteFeed(ctx context.Context, feedGroupID string, feedID string, request *getstream.DeleteFeedRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteFeedResponse], error) {
+	m.record(ctx, "DeleteFeed", feedGroupID, feedID, request, opts)
+	if m.DeleteFeedFunc != nil {
+		return m.DeleteFeedFunc(ctx, feedGroupID, feedID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteFeedResponse]), nil
+}
+
+// GetOrCreateFeed records the call and calls GetOrCreateFeedFunc if set.
+func (m *FeedsAPI) GetOrCreateFeed(ctx context.Context, feedGroupID string, feedID string, request *getstream.GetOrCreateFeedRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateFeedResponse], error) {
+	m.record(ctx, "GetOrCreateFeed", feedGroupID, feedID, request, opts)
+	if m.GetOrCreateFeedFunc != nil {
+		return m.GetOrCreateFeedFunc(ctx, feedGroupID, feedID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetOrCreateFeedResponse]), nil
+}
+
+// UpdateFeed records the call and calls UpdateFeedFunc if set.
+func (m *FeedsAPI) UpdateFeed(ctx context.Context, feedGroupID string, feedID string, request *getstream.UpdateFeedRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFeedResponse], error) {
+	m.record(ctx, "UpdateFeed", feedGroupID, feedID, request, opts)
+	if m.UpdateFeedFunc != nil {
+		return m.UpdateFeedFunc(ctx, feedGroupID, feedID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateFeedResponse]), nil
+}
+
+// MarkActivity records the call and calls MarkActivityFunc if set.
+func (m *FeedsAPI) MarkActivity(ctx context.Context, feedGroupID string, feedID string, request *getstream.MarkActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "MarkActivity", feedGroupID, feedID, request, opts)
+	if m.MarkActivityFunc != nil {
+		return m.MarkActivityFunc(ctx, feedGroupID, feedID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// UnpinActivity records the call and calls UnpinActivityFunc if set.
+func (m *FeedsAPI) UnpinActivity(ctx context.Context, feedGroupID string, feedID string, activityID string, request *getstream.UnpinActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnpinActivityResponse], error) {
+	m.record(ctx, "UnpinActivity", feedGroupID, feedID, activityID, request, opts)
+	if m.UnpinActivityFunc != nil {
+		return m.UnpinActivityFunc(ctx, feedGroupID, feedID, activityID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnpinActivityResponse]), nil
+}
+
+// PinActivity records the call and calls PinActivityFunc if set.
+func (m *FeedsAPI) PinActivity(ctx context.Context, feedGroupID string, feedID string, activityID string, request *getstream.PinActivityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PinActivityResponse], error) {
+	m.record(ctx, "PinActivity", feedGroupID, feedID, activityID, request, opts)
+	if m.PinActivityFunc != nil {
+		return m.PinActivityFunc(ctx, feedGroupID, feedID, activityID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PinActivityResponse]), nil
+}
+
+// ChangeFeedVisibility records the call and calls ChangeFeedVisibilityFunc if set.
+func (m *FeedsAPI) ChangeFeedVisibility(ctx context.Context, feedGroupID string, feedID string, request *getstream.ChangeFeedVisibilityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ChangeFeedVisibilityResponse], error) {
+	m.record(ctx, "ChangeFeedVisibility", feedGroupID, feedID, request, opts)
+	if m.ChangeFeedVisibilityFunc != nil {
+		return m.ChangeFeedVisibilityFunc(ctx, feedGroupID, feedID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ChangeFeedVisibilityResponse]), nil
+}
+
+// UpdateFeedMembers records the call and calls UpdateFeedMembersFunc if set.
+func (m *FeedsAPI) UpdateFeedMembers(ctx context.Context, feedGroupID string, feedID string, request *getstream.UpdateFeedMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFeedMembersResponse], error) {
+	m.record(ctx, "UpdateFeedMembers", feedGroupID, feedID, request, opts)
+	if m.UpdateFeedMembersFunc != nil {
+		return m.UpdateFeedMembersFunc(ctx, feedGroupID, feedID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateFeedMembersResponse]), nil
+}
+
+// AcceptFeedMemberInvite records the call and calls AcceptFeedMemberInviteFunc if set.
+func (m *FeedsAPI) AcceptFeedMemberInvite(ctx context.Context, feedID string, feedGroupID string, request *getstream.AcceptFeedMemberInviteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AcceptFeedMemberInviteResponse], error) {
+	m.record(ctx, "AcceptFeedMemberInvite", feedID, feedGroupID, request, opts)
+	if m.AcceptFeedMemberInviteFunc != nil {
+		return m.AcceptFeedMemberInviteFunc(ctx, feedID, feedGroupID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AcceptFeedMemberInviteResponse]), nil
+}
+
+// QueryFeedMembers records the call and calls QueryFeedMembersFunc if set.
+func (m *FeedsAPI) QueryFeedMembers(ctx context.Context, feedGroupID string, feedID string, request *getstream.QueryFeedMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFeedMembersResponse], error) {
+	m.record(ctx, "QueryFeedMembers", feedGroupID, feedID, request, opts)
+	if m.QueryFeedMembersFunc != nil {
+		return m.QueryFeedMembersFunc(ctx, feedGroupID, feedID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryFeedMembersResponse]), nil
+}
+
+// RejectFeedMemberInvite records the call and calls RejectFeedMemberInviteFunc if set.
+func (m *FeedsAPI) RejectFeedMemberInvite(ctx context.Context, feedGroupID string, feedID string, request *getstream.RejectFeedMemberInviteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RejectFeedMemberInviteResponse], error) {
+	m.record(ctx, "RejectFeedMemberInvite", feedGroupID, feedID, request, opts)
+	if m.RejectFeedMemberInviteFunc != nil {
+		return m.RejectFeedMemberInviteFunc(ctx, feedGroupID, feedID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.RejectFeedMemberInviteResponse]), nil
+}
+
+// QueryPinnedActivities records the call and calls QueryPinnedActivitiesFunc if set.
+func (m *FeedsAPI) QueryPinnedActivities(ctx context.Context, feedGroupID string, feedID string, request *getstream.QueryPinnedActivitiesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryPinnedActivitiesResponse], error) {
+	m.record(ctx, "QueryPinnedActivities", feedGroupID, feedID, request, opts)
+	if m.QueryPinnedActivitiesFunc != nil {
+		return m.QueryPinnedActivitiesFunc(ctx, feedGroupID, feedID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryPinnedActivitiesResponse]), nil
+}
+
+// GetFollowSuggestions records the call and calls GetFollowSuggestionsFunc if set.
+func (m *FeedsAPI) GetFollowSuggestions(ctx context.Context, feedGroupID string, request *getstream.GetFollowSuggestionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFollowSuggestionsResponse], error) {
+	m.record(ctx, "GetFollowSuggestions", feedGroupID, request, opts)
+	if m.GetFollowSuggestionsFunc != nil {
+		return m.GetFollowSuggestionsFunc(ctx, feedGroupID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetFollowSuggestionsResponse]), nil
+}
+
+// RestoreFeedGroup records the call and calls RestoreFeedGroupFunc if set.
+func (m *FeedsAPI) RestoreFeedGroup(ctx context.Context, feedGroupID string, request *getstream.RestoreFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RestoreFeedGroupResponse], error) {
+	m.record(ctx, "RestoreFeedGroup", feedGroupID, request, opts)
+	if m.RestoreFeedGroupFunc != nil {
+		return m.RestoreFeedGroupFunc(ctx, feedGroupID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.RestoreFeedGroupResponse]), nil
+}
+
+// DeleteFeedGroup records the call and calls DeleteFeedGroupFunc if set.
+func (m *FeedsAPI) DeleteFeedGroup(ctx context.Context, id string, request *getstream.DeleteFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteFeedGroupResponse], error) {
+	m.record(ctx, "DeleteFeedGroup", id, request, opts)
+	if m.DeleteFeedGroupFunc != nil {
+		return m.DeleteFeedGroupFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteFeedGroupResponse]), nil
+}
+
+// GetFeedGroup records the call and calls GetFeedGroupFunc if set.
+func (m *FeedsAPI) GetFeedGroup(ctx context.Context, id string, request *getstream.GetFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFeedGroupResponse], error) {
+	m.record(ctx, "GetFeedGroup", id, request, opts)
+	if m.GetFeedGroupFunc != nil {
+		return m.GetFeedGroupFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetFeedGroupResponse]), nil
+}
+
+// GetOrCreateFeedGroup records the call and calls GetOrCreateFeedGroupFunc if set.
+func (m *FeedsAPI) GetOrCreateFeedGroup(ctx context.Context, id string, request *getstream.GetOrCreateFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateFeedGroupResponse], error) {
+	m.record(ctx, "GetOrCreateFeedGroup", id, request, opts)
+	if m.GetOrCreateFeedGroupFunc != nil {
+		return m.GetOrCreateFeedGroupFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetOrCreateFeedGroupResponse]), nil
+}
+
+// UpdateFeedGroup records the call and calls UpdateFeedGroupFunc if set.
+func (m *FeedsAPI) UpdateFeedGroup(ctx context.Context, id string, request *getstream.UpdateFeedGroupRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFeedGroupResponse], error) {
+	m.record(ctx, "UpdateFeedGroup", id, request, opts)
+	if m.UpdateFeedGroupFunc != nil {
+		return m.UpdateFeedGroupFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateFeedGroupResponse]), nil
+}
+
+// ListFeedViews records the call and calls ListFeedViewsFunc if set.
+func (m *FeedsAPI) ListFeedViews(ctx context.Context, request *getstream.ListFeedViewsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListFeedViewsResponse], error) {
+	m.record(ctx, "ListFeedViews", request, opts)
+	if m.ListFeedViewsFunc != nil {
+		return m.ListFeedViewsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListFeedViewsResponse]), nil
+}
+
+// CreateFeedView records the call and calls CreateFeedViewFunc if set.
+func (m *FeedsAPI) CreateFeedView(ctx context.Context, request *getstream.CreateFeedViewRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateFeedViewResponse], error) {
+	m.record(ctx, "CreateFeedView", request, opts)
+	if m.CreateFeedViewFunc != nil {
+		return m.CreateFeedViewFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateFeedViewResponse]), nil
+}
+
+// DeleteFeedView records the call and calls DeleteFeedViewFunc if set.
+func (m *FeedsAPI) DeleteFeedView(ctx context.Context, id string, request *getstream.DeleteFeedViewRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteFeedViewResponse], error) {
+	m.record(ctx, "DeleteFeedView", id, request, opts)
+	if m.DeleteFeedViewFunc != nil {
+		return m.DeleteFeedViewFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteFeedViewResponse]), nil
+}
+
+// GetFeedView records the call and calls GetFeedViewFunc if set.
+func (m *FeedsAPI) GetFeedView(ctx context.Context, id string, request *getstream.GetFeedViewRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFeedViewResponse], error) {
+	m.record(ctx, "GetFeedView", id, request, opts)
+	if m.GetFeedViewFunc != nil {
+		return m.GetFeedViewFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetFeedViewResponse]), nil
+}
+
+// GetOrCreateFeedView records the call and calls GetOrCreateFeedViewFunc if set.
+func (m *FeedsAPI) GetOrCreateFeedView(ctx context.Context, id string, request *getstream.GetOrCreateFeedViewRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateFeedViewResponse], error) {
+	m.record(ctx, "GetOrCreateFeedView", id, request, opts)
+	if m.GetOrCreateFeedViewFunc != nil {
+		return m.GetOrCreateFeedViewFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetOrCreateFeedViewResponse]), nil
+}
+
+// UpdateFeedView records the call and calls UpdateFeedViewFunc if set.
+func (m *FeedsAPI) UpdateFeedView(ctx context.Context, id string, request *getstream.UpdateFeedViewRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFeedViewResponse], error) {
+	m.record(ctx, "UpdateFeedView", id, request, opts)
+	if m.UpdateFeedViewFunc != nil {
+		return m.UpdateFeedViewFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateFeedViewResponse]), nil
+}
+
+// ListFeedVisibilities records the call and calls ListFeedVisibilitiesFunc if set.
+func (m *FeedsAPI) ListFeedVisibilities(ctx context.Context, request *getstream.ListFeedVisibilitiesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListFeedVisibilitiesResponse], error) {
+	m.record(ctx, "ListFeedVisibilities", request, opts)
+	if m.ListFeedVisibilitiesFunc != nil {
+		return m.ListFeedVisibilitiesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListFeedVisibilitiesResponse]), nil
+}
+
+// GetFeedVisibility records the call and calls GetFeedVisibilityFunc if set.
+func (m *FeedsAPI) GetFeedVisibility(ctx context.Context, name string, request *getstream.GetFeedVisibilityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFeedVisibilityResponse], error) {
+	m.record(ctx, "GetFeedVisibility", name, request, opts)
+	if m.GetFeedVisibilityFunc != nil {
+		return m.GetFeedVisibilityFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetFeedVisibilityResponse]), nil
+}
+
+// UpdateFeedVisibility records the call and calls UpdateFeedVisibilityFunc if set.
+func (m *FeedsAPI) UpdateFeedVisibility(ctx context.Context, name string, request *getstream.UpdateFeedVisibilityRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFeedVisibilityResponse], error) {
+	m.record(ctx, "UpdateFeedVisibility", name, request, opts)
+	if m.UpdateFeedVisibilityFunc != nil {
+		return m.UpdateFeedVisibilityFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateFeedVisibilityResponse]), nil
+}
+
+// CreateFeedsBatch records the call and calls CreateFeedsBatchFunc if set.
+func (m *FeedsAPI) CreateFeedsBatch(ctx context.Context, request *getstream.CreateFeedsBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateFeedsBatchResponse], error) {
+	m.record(ctx, "CreateFeedsBatch", request, opts)
+	if m.CreateFeedsBatchFunc != nil {
+		return m.CreateFeedsBatchFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateFeedsBatchResponse]), nil
+}
+
+// DeleteFeedsBatch records the call and calls DeleteFeedsBatchFunc if set.
+func (m *FeedsAPI) DeleteFeedsBatch(ctx context.Context, request *getstream.DeleteFeedsBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteFeedsBatchResponse], error) {
+	m.record(ctx, "DeleteFeedsBatch", request, opts)
+	if m.DeleteFeedsBatchFunc != nil {
+		return m.DeleteFeedsBatchFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteFeedsBatchResponse]), nil
+}
+
+// OwnBatch records the call and calls OwnBatchFunc if set.
+func (m *FeedsAPI) OwnBatch(ctx context.Context, request *getstream.OwnBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.OwnBatchResponse], error) {
+	m.record(ctx, "OwnBatch", request, opts)
+	if m.OwnBatchFunc != nil {
+		return m.OwnBatchFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.OwnBatchResponse]), nil
+}
+
+// QueryFeeds records the call and calls QueryFeedsFunc if set.
+func (m *FeedsAPI) QueryFeeds(ctx context.Context, request *getstream.QueryFeedsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFeedsResponse], error) {
+	m.record(ctx, "QueryFeeds", request, opts)
+	if m.QueryFeedsFunc != nil {
+		return m.QueryFeedsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryFeedsResponse]), nil
+}
+
+// GetFeedsRateLimits records the call and calls GetFeedsRateLimitsFunc if set.
+func (m *FeedsAPI) GetFeedsRateLimits(ctx context.Context, request *getstream.GetFeedsRateLimitsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFeedsRateLimitsResponse], error) {
+	m.record(ctx, "GetFeedsRateLimits", request, opts)
+	if m.GetFeedsRateLimitsFunc != nil {
+		return m.GetFeedsRateLimitsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetFeedsRateLimitsResponse]), nil
+}
+
+// UpdateFollow records the call and calls UpdateFollowFunc if set.
+func (m *FeedsAPI) UpdateFollow(ctx context.Context, request *getstream.UpdateFollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateFollowResponse], error) {
+	m.record(ctx, "UpdateFollow", request, opts)
+	if m.UpdateFollowFunc != nil {
+		return m.UpdateFollowFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateFollowResponse]), nil
+}
+
+// Follow records the call and calls FollowFunc if set.
+func (m *FeedsAPI) Follow(ctx context.Context, request *getstream.FollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SingleFollowResponse], error) {
+	m.record(ctx, "Follow", request, opts)
+	if m.FollowFunc != nil {
+		return m.FollowFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SingleFollowResponse]), nil
+}
+
+// AcceptFollow records the call and calls AcceptFollowFunc if set.
+func (m *FeedsAPI) AcceptFollow(ctx context.Context, request *getstream.AcceptFollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AcceptFollowResponse], error) {
+	m.record(ctx, "AcceptFollow", request, opts)
+	if m.AcceptFollowFunc != nil {
+		return m.AcceptFollowFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AcceptFollowResponse]), nil
+}
+
+// FollowBatch records the call and calls FollowBatchFunc if set.
+func (m *FeedsAPI) FollowBatch(ctx context.Context, request *getstream.FollowBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.FollowBatchResponse], error) {
+	m.record(ctx, "FollowBatch", request, opts)
+	if m.FollowBatchFunc != nil {
+		return m.FollowBatchFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.FollowBatchResponse]), nil
+}
+
+// GetOrCreateFollows records the call and calls GetOrCreateFollowsFunc if set.
+func (m *FeedsAPI) GetOrCreateFollows(ctx context.Context, request *getstream.GetOrCreateFollowsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.FollowBatchResponse], error) {
+	m.record(ctx, "GetOrCreateFollows", request, opts)
+	if m.GetOrCreateFollowsFunc != nil {
+		return m.GetOrCreateFollowsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.FollowBatchResponse]), nil
+}
+
+// QueryFollows records the call and calls QueryFollowsFunc if set.
+func (m *FeedsAPI) QueryFollows(ctx context.Context, request *getstream.QueryFollowsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFollowsResponse], error) {
+	m.record(ctx, "QueryFollows", request, opts)
+	if m.QueryFollowsFunc != nil {
+		return m.QueryFollowsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryFollowsResponse]), nil
+}
+
+// RejectFollow records the call and calls RejectFollowFunc if set.
+func (m *FeedsAPI) RejectFollow(ctx context.Context, request *getstream.RejectFollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RejectFollowResponse], error) {
+	m.record(ctx, "RejectFollow", request, opts)
+	if m.RejectFollowFunc != nil {
+		return m.RejectFollowFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.RejectFollowResponse]), nil
+}
+
+// GetOrCreateFollow records the call and calls GetOrCreateFollowFunc if set.
+func (m *FeedsAPI) GetOrCreateFollow(ctx context.Context, request *getstream.GetOrCreateFollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateFollowResponse], error) {
+	m.record(ctx, "GetOrCreateFollow", request, opts)
+	if m.GetOrCreateFollowFunc != nil {
+		return m.GetOrCreateFollowFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetOrCreateFollowResponse]), nil
+}
+
+// Unfollow records the call and calls UnfollowFunc if set.
+func (m *FeedsAPI) Unfollow(ctx context.Context, source string, target string, request *getstream.UnfollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnfollowResponse], error) {
+	m.record(ctx, "Unfollow", source, target, request, opts)
+	if m.UnfollowFunc != nil {
+		return m.UnfollowFunc(ctx, source, target, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnfollowResponse]), nil
+}
+
+// CreateMembershipLevel records the call and calls CreateMembershipLevelFunc if set.
+func (m *FeedsAPI) CreateMembershipLevel(ctx context.Context, request *getstream.CreateMembershipLevelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateMembershipLevelResponse], error) {
+	m.record(ctx, "CreateMembershipLevel", request, opts)
+	if m.CreateMembershipLevelFunc != nil {
+		return m.CreateMembershipLevelFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateMembershipLevelResponse]), nil
+}
+
+// QueryMembershipLevels records the call and calls QueryMembershipLevelsFunc if set.
+func (m *FeedsAPI) QueryMembershipLevels(ctx context.Context, request *getstream.QueryMembershipLevelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryMembershipLevelsResponse], error) {
+	m.record(ctx, "QueryMembershipLevels", request, opts)
+	if m.QueryMembershipLevelsFunc != nil {
+		return m.QueryMembershipLevelsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryMembershipLevelsResponse]), nil
+}
+
+// DeleteMembershipLevel records the call and calls DeleteMembershipLevelFunc if set.
+func (m *FeedsAPI) DeleteMembershipLevel(ctx context.Context, id string, request *getstream.DeleteMembershipLevelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteMembershipLevel", id, request, opts)
+	if m.DeleteMembershipLevelFunc != nil {
+		return m.DeleteMembershipLevelFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// UpdateMembershipLevel records the call and calls UpdateMembershipLevelFunc if set.
+func (m *FeedsAPI) UpdateMembershipLevel(ctx context.Context, id string, request *getstream.UpdateMembershipLevelRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateMembershipLevelResponse], error) {
+	m.record(ctx, "UpdateMembershipLevel", id, request, opts)
+	if m.UpdateMembershipLevelFunc != nil {
+		return m.UpdateMembershipLevelFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateMembershipLevelResponse]), nil
+}
+
+// QueryRevisionHistory records the call and calls QueryRevisionHistoryFunc if set.
+func (m *FeedsAPI) QueryRevisionHistory(ctx context.Context, request *getstream.QueryRevisionHistoryRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryRevisionHistoryResponse], error) {
+	m.record(ctx, "QueryRevisionHistory", request, opts)
+	if m.QueryRevisionHistoryFunc != nil {
+		return m.QueryRevisionHistoryFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryRevisionHistoryResponse]), nil
+}
+
+// QueryFeedsUsageStats records the call and calls QueryFeedsUsageStatsFunc if set.
+func (m *FeedsAPI) QueryFeedsUsageStats(ctx context.Context, request *getstream.QueryFeedsUsageStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFeedsUsageStatsResponse], error) {
+	m.record(ctx, "QueryFeedsUsageStats", request, opts)
+	if m.QueryFeedsUsageStatsFunc != nil {
+		return m.QueryFeedsUsageStatsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryFeedsUsageStatsResponse]), nil
+}
+
+// UnfollowBatch records the call and calls UnfollowBatchFunc if set.
+func (m *FeedsAPI) UnfollowBatch(ctx context.Context, request *getstream.UnfollowBatchRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnfollowBatchResponse], error) {
+	m.record(ctx, "UnfollowBatch", request, opts)
+	if m.UnfollowBatchFunc != nil {
+		return m.UnfollowBatchFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnfollowBatchResponse]), nil
+}
+
+// GetOrCreateUnfollows records the call and calls GetOrCreateUnfollowsFunc if set.
+func (m *FeedsAPI) GetOrCreateUnfollows(ctx context.Context, request *getstream.GetOrCreateUnfollowsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnfollowBatchResponse], error) {
+	m.record(ctx, "GetOrCreateUnfollows", request, opts)
+	if m.GetOrCreateUnfollowsFunc != nil {
+		return m.GetOrCreateUnfollowsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnfollowBatchResponse]), nil
+}
+
+// GetOrCreateUnfollow records the call and calls GetOrCreateUnfollowFunc if set.
+func (m *FeedsAPI) GetOrCreateUnfollow(ctx context.Context, request *getstream.GetOrCreateUnfollowRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateUnfollowResponse], error) {
+	m.record(ctx, "GetOrCreateUnfollow", request, opts)
+	if m.GetOrCreateUnfollowFunc != nil {
+		return m.GetOrCreateUnfollowFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetOrCreateUnfollowResponse]), nil
+}
+
+// DeleteFeedUserData records the call and calls DeleteFeedUserDataFunc if set.
+func (m *FeedsAPI) DeleteFeedUserData(ctx context.Context, userID string, request *getstream.DeleteFeedUserDataRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteFeedUserDataResponse], error) {
+	m.record(ctx, "DeleteFeedUserData", userID, request, opts)
+	if m.DeleteFeedUserDataFunc != nil {
+		return m.DeleteFeedUserDataFunc(ctx, userID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteFeedUserDataResponse]), nil
+}
+
+// ExportFeedUserData records the call and calls ExportFeedUserDataFunc if set.
+func (m *FeedsAPI) ExportFeedUserData(ctx context.Context, userID string, request *getstream.ExportFeedUserDataRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ExportFeedUserDataResponse], error) {
+	m.record(ctx, "ExportFeedUserData", userID, request, opts)
+	if m.ExportFeedUserDataFunc != nil {
+		return m.ExportFeedUserDataFunc(ctx, userID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ExportFeedUserDataResponse]), nil
+}
+
+// GetUserInterests records the call and calls GetUserInterestsFunc if set.
+func (m *FeedsAPI) GetUserInterests(ctx context.Context, userID string, request *getstream.GetUserInterestsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetUserInterestsResponse], error) {
+	m.record(ctx, "GetUserInterests", userID, request, opts)
+	if m.GetUserInterestsFunc != nil {
+		return m.GetUserInterestsFunc(ctx, userID, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetUserInterestsResponse]), nil
+}
@@ -0,0 +1,77 @@
+// Package mockgetstream provides mocks of the getstream API interfaces
+// (getstream.ChatAPI, VideoAPI, FeedsAPI, ModerationAPI and CommonAPI) for
+// unit tests of code that calls Stream without a network or server:
+//
+//	chat := &mockgetstream.ChatAPI{
+//		SendMessageFunc: func(ctx context.Context, _type, id string, req *getstream.SendMessageRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SendMessageResponse], error) {
+//			return &getstream.StreamResponse[getstream.SendMessageResponse]{}, nil
+//		},
+//	}
+//	svc := NewNotifier(chat) // takes a getstream.ChatAPI; client.Chat() in production
+//	...
+//	calls := chat.CallsTo("SendMessage")
+//
+// A method whose Func field is nil returns an empty response and no error.
+// Every call is recorded, whether or not it is programmed; its request
+// options are recorded as the last argument. Mocks are safe
+// for concurrent use as long as Func fields are not changed while calls are
+// in flight.
+//
+// The mocks are generated with the interfaces by internal/apigen; only this
+// file is written by hand. For tests that need real request and response
+// handling, see the streamtest package.
+package mockgetstream
+
+import (
+	"context"
+	"sync"
+)
+
+// Call is one recorded method call.
+type Call struct {
+	// Method is the method name, e.g. "SendMessage".
+	Method string
+	// Ctx is the context the method was called with.
+	Ctx context.Context
+	// Args are the remaining arguments, in order.
+	Args []any
+}
+
+// Recorder records the calls made to a mock. It is embedded in every mock.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(ctx context.Context, method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Ctx: ctx, Args: args})
+}
+
+// Calls returns all recorded calls, oldest first.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls to method, oldest first.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Reset forgets all recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
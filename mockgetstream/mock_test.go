@@ -0,0 +1,68 @@
+package mockgetstream
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GetStream/getstream-go/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type ctxKey struct{}
+
+// welcome is application code that depends on the interfaces.
+func welcome(ctx context.Context, common getstream.CommonAPI, chat getstream.ChatAPI, userID string) error {
+	if _, err := common.UpdateUsers(ctx, &getstream.UpdateUsersRequest{Users: map[string]getstream.UserRequest{userID: {ID: userID}}}); err != nil {
+		return err
+	}
+	_, err := chat.SendMessage(ctx, "messaging", "welcome-"+userID, &getstream.SendMessageRequest{
+		Message: getstream.MessageRequest{Text: getstream.PtrTo("Welcome!"), UserID: getstream.PtrTo("bot")},
+	})
+	return err
+}
+
+func TestMocks_RecordAndProgram(t *testing.T) {
+	common := &CommonAPI{}
+	chat := &ChatAPI{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "v")
+
+	require.NoError(t, welcome(ctx, common, chat, "john"))
+
+	require.Len(t, common.Calls(), 1)
+	call := common.CallsTo("UpdateUsers")[0]
+	require.Equal(t, "v", call.Ctx.Value(ctxKey{}))
+	require.Contains(t, call.Args[0].(*getstream.UpdateUsersRequest).Users, "john")
+
+	sent := chat.CallsTo("SendMessage")
+	require.Len(t, sent, 1)
+	require.Equal(t, []any{"messaging", "welcome-john"}, sent[0].Args[:2])
+	require.Equal(t, "Welcome!", *sent[0].Args[2].(*getstream.SendMessageRequest).Message.Text)
+
+	boom := errors.New("boom")
+	chat.SendMessageFunc = func(ctx context.Context, _type, id string, req *getstream.SendMessageRequest, _ ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SendMessageResponse], error) {
+		return nil, boom
+	}
+	require.ErrorIs(t, welcome(ctx, common, chat, "jane"), boom)
+	require.Len(t, chat.CallsTo("SendMessage"), 2)
+
+	common.Reset()
+	require.Empty(t, common.Calls())
+}
+
+func TestMocks_DefaultResponse(t *testing.T) {
+	var api getstream.ModerationAPI = &ModerationAPI{}
+	resp, err := api.Ban(context.Background(), &getstream.BanRequest{TargetUserID: "john"})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	video := &VideoAPI{
+		GetCallFunc: func(ctx context.Context, _type, id string, req *getstream.GetCallRequest, _ ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallResponse], error) {
+			return &getstream.StreamResponse[getstream.GetCallResponse]{Data: getstream.GetCallResponse{Call: getstream.CallResponse{ID: id}}}, nil
+		},
+	}
+	got, err := video.GetCall(context.Background(), "default", "call-1", &getstream.GetCallRequest{}, getstream.RequestActingUser("john"))
+	require.NoError(t, err)
+	require.Equal(t, "call-1", got.Data.Call.ID)
+	require.Len(t, video.CallsTo("GetCall")[0].Args[3], 1, "request options are recorded as the last argument")
+}
@@ -0,0 +1,559 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package mockgetstream
+
+import (
+	"context"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var _ getstream.ModerationAPI = (*ModerationAPI)(nil)
+
+// ModerationAPI is a mock getstream.ModerationAPI. Each method records the call and
+// calls the matching Func field if set; otherwise it returns an empty
+// response and no error. Set Func fields before use.
+type ModerationAPI struct {
+	Recorder
+
+	GetActionConfigFunc          func(ctx context.Context, request *getstream.GetActionConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetActionConfigResponse], error)
+	UpsertActionConfigFunc       func(ctx context.Context, request *getstream.UpsertActionConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertActionConfigResponse], error)
+	BulkUpsertActionConfigFunc   func(ctx context.Context, request *getstream.BulkUpsertActionConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BulkUpsertActionConfigResponse], error)
+	BulkDeleteActionConfigFunc   func(ctx context.Context, request *getstream.BulkDeleteActionConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BulkDeleteActionConfigResponse], error)
+	DeleteActionConfigFunc       func(ctx context.Context, id string, request *getstream.DeleteActionConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteActionConfigResponse], error)
+	InsertActionLogFunc          func(ctx context.Context, request *getstream.InsertActionLogRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.InsertActionLogResponse], error)
+	AnalyzeFunc                  func(ctx context.Context, request *getstream.AnalyzeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AnalyzeResponse], error)
+	AppealFunc                   func(ctx context.Context, request *getstream.AppealRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AppealResponse], error)
+	GetAppealFunc                func(ctx context.Context, id string, request *getstream.GetAppealRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetAppealResponse], error)
+	QueryAppealsFunc             func(ctx context.Context, request *getstream.QueryAppealsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryAppealsResponse], error)
+	BulkActionAppealsFunc        func(ctx context.Context, request *getstream.BulkActionAppealsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BulkActionAppealsResponse], error)
+	BanFunc                      func(ctx context.Context, request *getstream.BanRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ModerationBanResponse], error)
+	BulkImageModerationFunc      func(ctx context.Context, request *getstream.BulkImageModerationRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BulkImageModerationResponse], error)
+	BypassFunc                   func(ctx context.Context, request *getstream.BypassRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BypassResponse], error)
+	CheckFunc                    func(ctx context.Context, request *getstream.CheckRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckResponse], error)
+	CheckS3AccessFunc            func(ctx context.Context, request *getstream.CheckS3AccessRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckS3AccessResponse], error)
+	UpsertConfigFunc             func(ctx context.Context, request *getstream.UpsertConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertConfigResponse], error)
+	DeleteConfigFunc             func(ctx context.Context, key string, request *getstream.DeleteConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteModerationConfigResponse], error)
+	GetConfigFunc                func(ctx context.Context, key string, request *getstream.GetConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetConfigResponse], error)
+	QueryModerationConfigsFunc   func(ctx context.Context, request *getstream.QueryModerationConfigsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryModerationConfigsResponse], error)
+	CustomCheckFunc              func(ctx context.Context, request *getstream.CustomCheckRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CustomCheckResponse], error)
+	V2DeleteTemplateFunc         func(ctx context.Context, request *getstream.V2DeleteTemplateRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteModerationTemplateResponse], error)
+	V2QueryTemplatesFunc         func(ctx context.Context, request *getstream.V2QueryTemplatesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFeedModerationTemplatesResponse], error)
+	V2UpsertTemplateFunc         func(ctx context.Context, request *getstream.V2UpsertTemplateRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertModerationTemplateResponse], error)
+	FlagFunc                     func(ctx context.Context, request *getstream.FlagRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.FlagItemResponse], error)
+	GetFlagCountFunc             func(ctx context.Context, request *getstream.GetFlagCountRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFlagCountResponse], error)
+	QueryModerationFlagsFunc     func(ctx context.Context, request *getstream.QueryModerationFlagsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryModerationFlagsResponse], error)
+	LabelsFunc                   func(ctx context.Context, request *getstream.LabelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.LabelsResponse], error)
+	QueryLabelResultsFunc        func(ctx context.Context, request *getstream.QueryLabelResultsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryLabelResultsResponse], error)
+	QueryModerationLogsFunc      func(ctx context.Context, request *getstream.QueryModerationLogsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryModerationLogsResponse], error)
+	UpsertModerationRuleFunc     func(ctx context.Context, request *getstream.UpsertModerationRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertModerationRuleResponse], error)
+	DeleteModerationRuleFunc     func(ctx context.Context, id string, request *getstream.DeleteModerationRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteModerationRuleResponse], error)
+	GetModerationRuleFunc        func(ctx context.Context, id string, request *getstream.GetModerationRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetModerationRuleResponse], error)
+	QueryModerationRulesFunc     func(ctx context.Context, request *getstream.QueryModerationRulesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryModerationRulesResponse], error)
+	MuteFunc                     func(ctx context.Context, request *getstream.MuteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MuteResponse], error)
+	GetPolicyTestRunFunc         func(ctx context.Context, id string, request *getstream.GetPolicyTestRunRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PolicyTestRunResponse], error)
+	ListPolicyTestSetsFunc       func(ctx context.Context, request *getstream.ListPolicyTestSetsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PolicyTestSetListResponse], error)
+	CreatePolicyTestSetFunc      func(ctx context.Context, request *getstream.CreatePolicyTestSetRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PolicyTestSetResponse], error)
+	DeletePolicyTestSetFunc      func(ctx context.Context, id string, request *getstream.DeletePolicyTestSetRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetPolicyTestSetFunc         func(ctx context.Context, id string, request *getstream.GetPolicyTestSetRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PolicyTestSetResponse], error)
+	StartPolicyTestRunFunc       func(ctx context.Context, id string, request *getstream.StartPolicyTestRunRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PolicyTestRunResponse], error)
+	ListQueuesFunc               func(ctx context.Context, request *getstream.ListQueuesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListQueuesResponse], error)
+	CreateQueueFunc              func(ctx context.Context, request *getstream.CreateQueueRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueueResponse], error)
+	GetQueueFunc                 func(ctx context.Context, id string, request *getstream.GetQueueRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueueResponse], error)
+	UpdateQueueFunc              func(ctx context.Context, id string, request *getstream.UpdateQueueRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueueResponse], error)
+	DeleteQueueFunc              func(ctx context.Context, id string, request *getstream.DeleteQueueRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueueResponse], error)
+	QueryReviewQueueFunc         func(ctx context.Context, request *getstream.QueryReviewQueueRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryReviewQueueResponse], error)
+	GetReviewQueueItemFunc       func(ctx context.Context, id string, request *getstream.GetReviewQueueItemRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetReviewQueueItemResponse], error)
+	GetSetupSessionFunc          func(ctx context.Context, request *getstream.GetSetupSessionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetSetupSessionResponse], error)
+	UpsertSetupSessionFunc       func(ctx context.Context, request *getstream.UpsertSetupSessionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertSetupSessionResponse], error)
+	SubmitActionFunc             func(ctx context.Context, request *getstream.SubmitActionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SubmitActionResponse], error)
+	SubmitModerationFeedbackFunc func(ctx context.Context, request *getstream.SubmitModerationFeedbackRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SubmitModerationFeedbackResponse], error)
+	UnbanFunc                    func(ctx context.Context, request *getstream.UnbanRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnbanResponse], error)
+	UnmuteFunc                   func(ctx context.Context, request *getstream.UnmuteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnmuteResponse], error)
+}
+
+// GetActionConfig records the call and calls GetActionConfigFunc if set.
+func (m *ModerationAPI) GetActionConfig(ctx context.Context, request *getstream.GetActionConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetActionConfigResponse], error) {
+	m.record(ctx, "GetActionConfig", request, opts)
+	if m.GetActionConfigFunc != nil {
+		return m.GetActionConfigFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetActionConfigResponse]), nil
+}
+
+// UpsertActionConfig records the call and calls UpsertActionConfigFunc if set.
+func (m *ModerationAPI) UpsertActionConfig(ctx context.Context, request *getstream.UpsertActionConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertActionConfigResponse], error) {
+	m.record(ctx, "UpsertActionConfig", request, opts)
+	if m.UpsertActionConfigFunc != nil {
+		return m.UpsertActionConfigFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertActionConfigResponse]), nil
+}
+
+// BulkUpsertActionConfig records the call and calls BulkUpsertActionConfigFunc if set.
+func (m *ModerationAPI) BulkUpsertActionConfig(ctx context.Context, request *getstream.BulkUpsertActionConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BulkUpsertActionConfigResponse], error) {
+	m.record(ctx, "BulkUpsertActionConfig", request, opts)
+	if m.BulkUpsertActionConfigFunc != nil {
+		return m.BulkUpsertActionConfigFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.BulkUpsertActionConfigResponse]), nil
+}
+
+// BulkDeleteActionConfig records the call and calls BulkDeleteActionConfigFunc if set.
+func (m *ModerationAPI) BulkDeleteActionConfig(ctx context.Context, request *getstream.BulkDeleteActionConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BulkDeleteActionConfigResponse], error) {
+	m.record(ctx, "BulkDeleteActionConfig", request, opts)
+	if m.BulkDeleteActionConfigFunc != nil {
+		return m.BulkDeleteActionConfigFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.BulkDeleteActionConfigResponse]), nil
+}
+
+// DeleteActionConfig records the call and calls DeleteActionConfigFunc if set.
+func (m *ModerationAPI) DeleteActionConfig(ctx context.Context, id string, request *getstream.DeleteActionConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteActionConfigResponse], error) {
+	m.record(ctx, "DeleteActionConfig", id, request, opts)
+	if m.DeleteActionConfigFunc != nil {
+		return m.DeleteActionConfigFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteActionConfigResponse]), nil
+}
+
+// InsertActionLog records the call and calls InsertActionLogFunc if set.
+func (m *ModerationAPI) InsertActionLog(ctx context.Context, request *getstream.InsertActionLogRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.InsertActionLogResponse], error) {
+	m.record(ctx, "InsertActionLog", request, opts)
+	if m.InsertActionLogFunc != nil {
+		return m.InsertActionLogFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.InsertActionLogResponse]), nil
+}
+
+// Analyze records the call and calls AnalyzeFunc if set.
+func (m *ModerationAPI) Analyze(ctx context.Context, request *getstream.AnalyzeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AnalyzeResponse], error) {
+	m.record(ctx, "Analyze", request, opts)
+	if m.AnalyzeFunc != nil {
+		return m.AnalyzeFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AnalyzeResponse]), nil
+}
+
+// Appeal records the call and calls AppealFunc if set.
+func (m *ModerationAPI) Appeal(ctx context.Context, request *getstream.AppealRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.AppealResponse], error) {
+	m.record(ctx, "Appeal", request, opts)
+	if m.AppealFunc != nil {
+		return m.AppealFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.AppealResponse]), nil
+}
+
+// GetAppeal records the call and calls GetAppealFunc if set.
+func (m *ModerationAPI) GetAppeal(ctx context.Context, id string, request *getstream.GetAppealRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetAppealResponse], error) {
+	m.record(ctx, "GetAppeal", id, request, opts)
+	if m.GetAppealFunc != nil {
+		return m.GetAppealFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetAppealResponse]), nil
+}
+
+// QueryAppeals records the call and calls QueryAppealsFunc if set.
+func (m *ModerationAPI) QueryAppeals(ctx context.Context, request *getstream.QueryAppealsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryAppealsResponse], error) {
+	m.record(ctx, "QueryAppeals", request, opts)
+	if m.QueryAppealsFunc != nil {
+		return m.QueryAppealsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryAppealsResponse]), nil
+}
+
+// BulkActionAppeals records the call and calls BulkActionAppealsFunc if set.
+func (m *ModerationAPI) BulkActionAppeals(ctx context.Context, request *getstream.BulkActionAppealsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BulkActionAppealsResponse], error) {
+	m.record(ctx, "BulkActionAppeals", request, opts)
+	if m.BulkActionAppealsFunc != nil {
+		return m.BulkActionAppealsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.BulkActionAppealsResponse]), nil
+}
+
+// Ban records the call and calls BanFunc if set.
+func (m *ModerationAPI) Ban(ctx context.Context, request *getstream.BanRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ModerationBanResponse], error) {
+	m.record(ctx, "Ban", request, opts)
+	if m.BanFunc != nil {
+		return m.BanFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ModerationBanResponse]), nil
+}
+
+// BulkImageModeration records the call and calls BulkImageModerationFunc if set.
+func (m *ModerationAPI) BulkImageModeration(ctx context.Context, request *getstream.BulkImageModerationRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BulkImageModerationResponse], error) {
+	m.record(ctx, "BulkImageModeration", request, opts)
+	if m.BulkImageModerationFunc != nil {
+		return m.BulkImageModerationFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.BulkImageModerationResponse]), nil
+}
+
+// Bypass records the call and calls BypassFunc if set.
+func (m *ModerationAPI) Bypass(ctx context.Context, request *getstream.BypassRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BypassResponse], error) {
+	m.record(ctx, "Bypass", request, opts)
+	if m.BypassFunc != nil {
+		return m.BypassFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.BypassResponse]), nil
+}
+
+// Check records the call and calls CheckFunc if set.
+func (m *ModerationAPI) Check(ctx context.Context, request *getstream.CheckRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckResponse], error) {
+	m.record(ctx, "Check", request, opts)
+	if m.CheckFunc != nil {
+		return m.CheckFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CheckResponse]), nil
+}
+
+// CheckS3Access records the call and calls CheckS3AccessFunc if set.
+func (m *ModerationAPI) CheckS3Access(ctx context.Context, request *getstream.CheckS3AccessRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CheckS3AccessResponse], error) {
+	m.record(ctx, "CheckS3Access", request, opts)
+	if m.CheckS3AccessFunc != nil {
+		return m.CheckS3AccessFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CheckS3AccessResponse]), nil
+}
+
+// UpsertConfig records the call and calls UpsertConfigFunc if set.
+func (m *ModerationAPI) UpsertConfig(ctx context.Context, request *getstream.UpsertConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertConfigResponse], error) {
+	m.record(ctx, "UpsertConfig", request, opts)
+	if m.UpsertConfigFunc != nil {
+		return m.UpsertConfigFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertConfigResponse]), nil
+}
+
+// DeleteConfig records the call and calls DeleteConfigFunc if set.
+func (m *ModerationAPI) DeleteConfig(ctx context.Context, key string, request *getstream.DeleteConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteModerationConfigResponse], error) {
+	m.record(ctx, "DeleteConfig", key, request, opts)
+	if m.DeleteConfigFunc != nil {
+		return m.DeleteConfigFunc(ctx, key, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteModerationConfigResponse]), nil
+}
+
+// GetConfig records the call and calls GetConfigFunc if set.
+func (m *ModerationAPI) GetConfig(ctx context.Context, key string, request *getstream.GetConfigRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetConfigResponse], error) {
+	m.record(ctx, "GetConfig", key, request, opts)
+	if m.GetConfigFunc != nil {
+		return m.GetConfigFunc(ctx, key, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetConfigResponse]), nil
+}
+
+// QueryModerationConfigs records the call and calls QueryModerationConfigsFunc if set.
+func (m *ModerationAPI) QueryModerationConfigs(ctx context.Context, request *getstream.QueryModerationConfigsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryModerationConfigsResponse], error) {
+	m.record(ctx, "QueryModerationConfigs", request, opts)
+	if m.QueryModerationConfigsFunc != nil {
+		return m.QueryModerationConfigsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryModerationConfigsResponse]), nil
+}
+
+// CustomCheck records the call and calls CustomCheckFunc if set.
+func (m *ModerationAPI) CustomCheck(ctx context.Context, request *getstream.CustomCheckRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CustomCheckResponse], error) {
+	m.record(ctx, "CustomCheck", request, opts)
+	if m.CustomCheckFunc != nil {
+		return m.CustomCheckFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CustomCheckResponse]), nil
+}
+
+// V2DeleteTemplate records the call and calls V2DeleteTemplateFunc if set.
+func (m *ModerationAPI) V2DeleteTemplate(ctx context.Context, request *getstream.V2DeleteTemplateRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteModerationTemplateResponse], error) {
+	m.record(ctx, "V2DeleteTemplate", request, opts)
+	if m.V2DeleteTemplateFunc != nil {
+		return m.V2DeleteTemplateFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteModerationTemplateResponse]), nil
+}
+
+// V2QueryTemplates records the call and calls V2QueryTemplatesFunc if set.
+func (m *ModerationAPI) V2QueryTemplates(ctx context.Context, request *getstream.V2QueryTemplatesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryFeedModerationTemplatesResponse], error) {
+	m.record(ctx, "V2QueryTemplates", request, opts)
+	if m.V2QueryTemplatesFunc != nil {
+		return m.V2QueryTemplatesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryFeedModerationTemplatesResponse]), nil
+}
+
+// V2UpsertTemplate records the call and calls V2UpsertTemplateFunc if set.
+func (m *ModerationAPI) V2UpsertTemplate(ctx context.Context, request *getstream.V2UpsertTemplateRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertModerationTemplateResponse], error) {
+	m.record(ctx, "V2UpsertTemplate", request, opts)
+	if m.V2UpsertTemplateFunc != nil {
+		return m.V2UpsertTemplateFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertModerationTemplateResponse]), nil
+}
+
+// Flag records the call and calls FlagFunc if set.
+func (m *ModerationAPI) Flag(ctx context.Context, request *getstream.FlagRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.FlagItemResponse], error) {
+	m.record(ctx, "Flag", request, opts)
+	if m.FlagFunc != nil {
+		return m.FlagFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.FlagItemResponse]), nil
+}
+
+// GetFlagCount records the call and calls GetFlagCountFunc if set.
+func (m *ModerationAPI) GetFlagCount(ctx context.Context, request *getstream.GetFlagCountRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetFlagCountResponse], error) {
+	m.record(ctx, "GetFlagCount", request, opts)
+	if m.GetFlagCountFunc != nil {
+		return m.GetFlagCountFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetFlagCountResponse]), nil
+}
+
+// QueryModerationFlags records the call and calls QueryModerationFlagsFunc if set.
+func (m *ModerationAPI) QueryModerationFlags(ctx context.Context, request *getstream.QueryModerationFlagsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryModerationFlagsResponse], error) {
+	m.record(ctx, "QueryModerationFlags", request, opts)
+	if m.QueryModerationFlagsFunc != nil {
+		return m.QueryModerationFlagsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryModerationFlagsResponse]), nil
+}
+
+// Labels records the call and calls LabelsFunc if set.
+func (m *ModerationAPI) Labels(ctx context.Context, request *getstream.LabelsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.LabelsResponse], error) {
+	m.record(ctx, "Labels", request, opts)
+	if m.LabelsFunc != nil {
+		return m.LabelsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.LabelsResponse]), nil
+}
+
+// QueryLabelResults records the call and calls QueryLabelResultsFunc if set.
+func (m *ModerationAPI) QueryLabelResults(ctx context.Context, request *getstream.QueryLabelResultsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryLabelResultsResponse], error) {
+	m.record(ctx, "QueryLabelResults", request, opts)
+	if m.QueryLabelResultsFunc != nil {
+		return m.QueryLabelResultsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryLabelResultsResponse]), nil
+}
+
+// QueryModerationLogs records the call and calls QueryModerationLogsFunc if set.
+func (m *ModerationAPI) QueryModerationLogs(ctx context.Context, request *getstream.QueryModerationLogsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryModerationLogsResponse], error) {
+	m.record(ctx, "QueryModerationLogs", request, opts)
+	if m.QueryModerationLogsFunc != nil {
+		return m.QueryModerationLogsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryModerationLogsResponse]), nil
+}
+
+// UpsertModerationRule records the call and calls UpsertModerationRuleFunc if set.
+func (m *ModerationAPI) UpsertModerationRule(ctx context.Context, request *getstream.UpsertModerationRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertModerationRuleResponse], error) {
+	m.record(ctx, "UpsertModerationRule", request, opts)
+	if m.UpsertModerationRuleFunc != nil {
+		return m.UpsertModerationRuleFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertModerationRuleResponse]), nil
+}
+
+// DeleteModerationRule records the call and calls DeleteModerationRuleFunc if set.
+func (m *ModerationAPI) DeleteModerationRule(ctx context.Context, id string, request *getstream.DeleteModerationRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteModerationRuleResponse], error) {
+	m.record(ctx, "DeleteModerationRule", id, request, opts)
+	if m.DeleteModerationRuleFunc != nil {
+		return m.DeleteModerationRuleFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteModerationRuleResponse]), nil
+}
+
+// GetModerationRule records the call and calls GetModerationRuleFunc if set.
+func (m *ModerationAPI) GetModerationRule(ctx context.Context, id string, request *getstream.GetModerationRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetModerationRuleResponse], error) {
+	m.record(ctx, "GetModerationRule", id, request, opts)
+	if m.GetModerationRuleFunc != nil {
+		return m.GetModerationRuleFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetModerationRuleResponse]), nil
+}
+
+// QueryModerationRules records the call and calls QueryModerationRulesFunc if set.
+func (m *ModerationAPI) QueryModerationRules(ctx context.Context, request *getstream.QueryModerationRulesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryModerationRulesResponse], error) {
+	m.record(ctx, "QueryModerationRules", request, opts)
+	if m.QueryModerationRulesFunc != nil {
+		return m.QueryModerationRulesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryModerationRulesResponse]), nil
+}
+
+// Mute records the call and calls MuteFunc if set.
+func (m *ModerationAPI) Mute(ctx context.Context, request *getstream.MuteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MuteResponse], error) {
+	m.record(ctx, "Mute", request, opts)
+	if m.MuteFunc != nil {
+		return m.MuteFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.MuteResponse]), nil
+}
+
+// GetPolicyTestRun records the call and calls GetPolicyTestRunFunc if set.
+func (m *ModerationAPI) GetPolicyTestRun(ctx context.Context, id string, request *getstream.GetPolicyTestRunRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PolicyTestRunResponse], error) {
+	m.record(ctx, "GetPolicyTestRun", id, request, opts)
+	if m.GetPolicyTestRunFunc != nil {
+		return m.GetPolicyTestRunFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PolicyTestRunResponse]), nil
+}
+
+// ListPolicyTestSets records the call and calls ListPolicyTestSetsFunc if set.
+func (m *ModerationAPI) ListPolicyTestSets(ctx context.Context, request *getstream.ListPolicyTestSetsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PolicyTestSetListResponse], error) {
+	m.record(ctx, "ListPolicyTestSets", request, opts)
+	if m.ListPolicyTestSetsFunc != nil {
+		return m.ListPolicyTestSetsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PolicyTestSetListResponse]), nil
+}
+
+// CreatePolicyTestSet records the call and calls CreatePolicyTestSetFunc if set.
+func (m *ModerationAPI) CreatePolicyTestSet(ctx context.Context, request *getstream.CreatePolicyTestSetRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PolicyTestSetResponse], error) {
+	m.record(ctx, "CreatePolicyTestSet", request, opts)
+	if m.CreatePolicyTestSetFunc != nil {
+		return m.CreatePolicyTestSetFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PolicyTestSetResponse]), nil
+}
+
+// DeletePolicyTestSet records the call and calls DeletePolicyTestSetFunc if set.
+func (m *ModerationAPI) DeletePolicyTestSet(ctx context.Context, id string, request *getstream.DeletePolicyTestSetRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeletePolicyTestSet", id, request, opts)
+	if m.DeletePolicyTestSetFunc != nil {
+		return m.DeletePolicyTestSetFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetPolicyTestSet records the call and calls GetPolicyTestSetFunc if set.
+func (m *ModerationAPI) GetPolicyTestSet(ctx context.Context, id string, request *getstream.GetPolicyTestSetRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PolicyTestSetResponse], error) {
+	m.record(ctx, "GetPolicyTestSet", id, request, opts)
+	if m.GetPolicyTestSetFunc != nil {
+		return m.GetPolicyTestSetFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PolicyTestSetResponse]), nil
+}
+
+// StartPolicyTestRun records the call and calls StartPolicyTestRunFunc if set.
+func (m *ModerationAPI) StartPolicyTestRun(ctx context.Context, id string, request *getstream.StartPolicyTestRunRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PolicyTestRunResponse], error) {
+	m.record(ctx, "StartPolicyTestRun", id, request, opts)
+	if m.StartPolicyTestRunFunc != nil {
+		return m.StartPolicyTestRunFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PolicyTestRunResponse]), nil
+}
+
+// ListQueues records the call and calls ListQueuesFunc if set.
+func (m *ModerationAPI) ListQueues(ctx context.Context, request *getstream.ListQueuesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListQueuesResponse], error) {
+	m.record(ctx, "ListQueues", request, opts)
+	if m.ListQueuesFunc != nil {
+		return m.ListQueuesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListQueuesResponse]), nil
+}
+
+// CreateQueue records the call and calls CreateQueueFunc if set.
+func (m *ModerationAPI) CreateQueue(ctx context.Context, request *getstream.CreateQueueRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueueResponse], error) {
+	m.record(ctx, "CreateQueue", request, opts)
+	if m.CreateQueueFunc != nil {
+		return m.CreateQueueFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueueResponse]), nil
+}
+
+// GetQueue records the call and calls GetQueueFunc if set.
+func (m *ModerationAPI) GetQueue(ctx context.Context, id string, request *getstream.GetQueueRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueueResponse], error) {
+	m.record(ctx, "GetQueue", id, request, opts)
+	if m.GetQueueFunc != nil {
+		return m.GetQueueFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueueResponse]), nil
+}
+
+// UpdateQueue records the call and calls UpdateQueueFunc if set.
+func (m *ModerationAPI) UpdateQueue(ctx context.Context, id string, request *getstream.UpdateQueueRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueueResponse], error) {
+	m.record(ctx, "UpdateQueue", id, request, opts)
+	if m.UpdateQueueFunc != nil {
+		return m.UpdateQueueFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueueResponse]), nil
+}
+
+// DeleteQueue records the call and calls DeleteQueueFunc if set.
+func (m *ModerationAPI) DeleteQueue(ctx context.Context, id string, request *getstream.DeleteQueueRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueueResponse], error) {
+	m.record(ctx, "DeleteQueue", id, request, opts)
+	if m.DeleteQueueFunc != nil {
+		return m.DeleteQueueFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueueResponse]), nil
+}
+
+// QueryReviewQueue records the call and calls QueryReviewQueueFunc if set.
+func (m *ModerationAPI) QueryReviewQueue(ctx context.Context, request *getstream.QueryReviewQueueRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryReviewQueueResponse], error) {
+	m.record(ctx, "QueryReviewQueue", request, opts)
+	if m.QueryReviewQueueFunc != nil {
+		return m.QueryReviewQueueFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryReviewQueueResponse]), nil
+}
+
+// GetReviewQueueItem records the call and calls GetReviewQueueItemFunc if set.
+func (m *ModerationAPI) GetReviewQueueItem(ctx context.Context, id string, request *getstream.GetReviewQueueItemRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetReviewQueueItemResponse], error) {
+	m.record(ctx, "GetReviewQueueItem", id, request, opts)
+	if m.GetReviewQueueItemFunc != nil {
+		return m.GetReviewQueueItemFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetReviewQueueItemResponse]), nil
+}
+
+// GetSetupSession records the call and calls GetSetupSessionFunc if set.
+func (m *ModerationAPI) GetSetupSession(ctx context.Context, request *getstream.GetSetupSessionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetSetupSessionResponse], error) {
+	m.record(ctx, "GetSetupSession", request, opts)
+	if m.GetSetupSessionFunc != nil {
+		return m.GetSetupSessionFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetSetupSessionResponse]), nil
+}
+
+// UpsertSetupSession records the call and calls UpsertSetupSessionFunc if set.
+func (m *ModerationAPI) UpsertSetupSession(ctx context.Context, request *getstream.UpsertSetupSessionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpsertSetupSessionResponse], error) {
+	m.record(ctx, "UpsertSetupSession", request, opts)
+	if m.UpsertSetupSessionFunc != nil {
+		return m.UpsertSetupSessionFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpsertSetupSessionResponse]), nil
+}
+
+// SubmitAction records the call and calls SubmitActionFunc if set.
+func (m *ModerationAPI) SubmitAction(ctx context.Context, request *getstream.SubmitActionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SubmitActionResponse], error) {
+	m.record(ctx, "SubmitAction", request, opts)
+	if m.SubmitActionFunc != nil {
+		return m.SubmitActionFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SubmitActionResponse]), nil
+}
+
+// SubmitModerationFeedback records the call and calls SubmitModerationFeedbackFunc if set.
+func (m *ModerationAPI) SubmitModerationFeedback(ctx context.Context, request *getstream.SubmitModerationFeedbackRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SubmitModerationFeedbackResponse], error) {
+	m.record(ctx, "SubmitModerationFeedback", request, opts)
+	if m.SubmitModerationFeedbackFunc != nil {
+		return m.SubmitModerationFeedbackFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SubmitModerationFeedbackResponse]), nil
+}
+
+// Unban records the call and calls UnbanFunc if set.
+func (m *ModerationAPI) Unban(ctx context.Context, request *getstream.UnbanRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnbanResponse], error) {
+	m.record(ctx, "Unban", request, opts)
+	if m.UnbanFunc != nil {
+		return m.UnbanFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnbanResponse]), nil
+}
+
+// Unmute records the call and calls UnmuteFunc if set.
+func (m *ModerationAPI) Unmute(ctx context.Context, request *getstream.UnmuteRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnmuteResponse], error) {
+	m.record(ctx, "Unmute", request, opts)
+	if m.UnmuteFunc != nil {
+		return m.UnmuteFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnmuteResponse]), nil
+}
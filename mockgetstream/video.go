@@ -0,0 +1,709 @@
+// Code generated by internal/apigen. DO NOT EDIT.
+
+package mockgetstream
+
+import (
+	"context"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+var _ getstream.VideoAPI = (*VideoAPI)(nil)
+
+// VideoAPI is a mock getstream.VideoAPI. Each method records the call and
+// calls the matching Func field if set; otherwise it returns an empty
+// response and no error. Set Func fields before use.
+type VideoAPI struct {
+	Recorder
+
+	GetActiveCallsStatusFunc                   func(ctx context.Context, request *getstream.GetActiveCallsStatusRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetActiveCallsStatusResponse], error)
+	QueryUserFeedbackFunc                      func(ctx context.Context, request *getstream.QueryUserFeedbackRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryUserFeedbackResponse], error)
+	QueryCallMembersFunc                       func(ctx context.Context, request *getstream.QueryCallMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallMembersResponse], error)
+	QueryCallStatsFunc                         func(ctx context.Context, request *getstream.QueryCallStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallStatsResponse], error)
+	GetCallFunc                                func(ctx context.Context, _type string, id string, request *getstream.GetCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallResponse], error)
+	UpdateCallFunc                             func(ctx context.Context, _type string, id string, request *getstream.UpdateCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCallResponse], error)
+	GetOrCreateCallFunc                        func(ctx context.Context, _type string, id string, request *getstream.GetOrCreateCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateCallResponse], error)
+	BlockUserFunc                              func(ctx context.Context, _type string, id string, request *getstream.BlockUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BlockUserResponse], error)
+	SendClosedCaptionFunc                      func(ctx context.Context, _type string, id string, request *getstream.SendClosedCaptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SendClosedCaptionResponse], error)
+	DeleteCallFunc                             func(ctx context.Context, _type string, id string, request *getstream.DeleteCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCallResponse], error)
+	SendCallEventFunc                          func(ctx context.Context, _type string, id string, request *getstream.SendCallEventRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SendCallEventResponse], error)
+	CollectUserFeedbackFunc                    func(ctx context.Context, _type string, id string, request *getstream.CollectUserFeedbackRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CollectUserFeedbackResponse], error)
+	GoLiveFunc                                 func(ctx context.Context, _type string, id string, request *getstream.GoLiveRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GoLiveResponse], error)
+	KickUserFunc                               func(ctx context.Context, _type string, id string, request *getstream.KickUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.KickUserResponse], error)
+	EndCallFunc                                func(ctx context.Context, _type string, id string, request *getstream.EndCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.EndCallResponse], error)
+	UpdateCallMembersFunc                      func(ctx context.Context, _type string, id string, request *getstream.UpdateCallMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCallMembersResponse], error)
+	MuteUsersFunc                              func(ctx context.Context, _type string, id string, request *getstream.MuteUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MuteUsersResponse], error)
+	QueryCallParticipantsFunc                  func(ctx context.Context, id string, _type string, request *getstream.QueryCallParticipantsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallParticipantsResponse], error)
+	VideoPinFunc                               func(ctx context.Context, _type string, id string, request *getstream.VideoPinRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PinResponse], error)
+	ListRecordingsFunc                         func(ctx context.Context, _type string, id string, request *getstream.ListRecordingsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListRecordingsResponse], error)
+	StartRecordingFunc                         func(ctx context.Context, _type string, id string, recordingType string, request *getstream.StartRecordingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartRecordingResponse], error)
+	StopRecordingFunc                          func(ctx context.Context, _type string, id string, recordingType string, request *getstream.StopRecordingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopRecordingResponse], error)
+	GetCallReportFunc                          func(ctx context.Context, _type string, id string, request *getstream.GetCallReportRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallReportResponse], error)
+	RingCallFunc                               func(ctx context.Context, _type string, id string, request *getstream.RingCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RingCallResponse], error)
+	StartRTMPBroadcastsFunc                    func(ctx context.Context, _type string, id string, request *getstream.StartRTMPBroadcastsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartRTMPBroadcastsResponse], error)
+	StopAllRTMPBroadcastsFunc                  func(ctx context.Context, _type string, id string, request *getstream.StopAllRTMPBroadcastsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopAllRTMPBroadcastsResponse], error)
+	StopRTMPBroadcastFunc                      func(ctx context.Context, _type string, id string, name string, request *getstream.StopRTMPBroadcastRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopRTMPBroadcastsResponse], error)
+	GetCallParticipantSessionMetricsFunc       func(ctx context.Context, _type string, id string, session string, user string, userSession string, request *getstream.GetCallParticipantSessionMetricsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallParticipantSessionMetricsResponse], error)
+	QueryCallParticipantSessionsFunc           func(ctx context.Context, _type string, id string, session string, request *getstream.QueryCallParticipantSessionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallParticipantSessionsResponse], error)
+	StartHLSBroadcastingFunc                   func(ctx context.Context, _type string, id string, request *getstream.StartHLSBroadcastingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartHLSBroadcastingResponse], error)
+	StartClosedCaptionsFunc                    func(ctx context.Context, _type string, id string, request *getstream.StartClosedCaptionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartClosedCaptionsResponse], error)
+	StartFrameRecordingFunc                    func(ctx context.Context, _type string, id string, request *getstream.StartFrameRecordingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartFrameRecordingResponse], error)
+	StartTranscriptionFunc                     func(ctx context.Context, _type string, id string, request *getstream.StartTranscriptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartTranscriptionResponse], error)
+	StopHLSBroadcastingFunc                    func(ctx context.Context, _type string, id string, request *getstream.StopHLSBroadcastingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopHLSBroadcastingResponse], error)
+	StopClosedCaptionsFunc                     func(ctx context.Context, _type string, id string, request *getstream.StopClosedCaptionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopClosedCaptionsResponse], error)
+	StopFrameRecordingFunc                     func(ctx context.Context, _type string, id string, request *getstream.StopFrameRecordingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopFrameRecordingResponse], error)
+	StopLiveFunc                               func(ctx context.Context, _type string, id string, request *getstream.StopLiveRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopLiveResponse], error)
+	StopTranscriptionFunc                      func(ctx context.Context, _type string, id string, request *getstream.StopTranscriptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopTranscriptionResponse], error)
+	ListTranscriptionsFunc                     func(ctx context.Context, _type string, id string, request *getstream.ListTranscriptionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListTranscriptionsResponse], error)
+	UnblockUserFunc                            func(ctx context.Context, _type string, id string, request *getstream.UnblockUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnblockUserResponse], error)
+	VideoUnpinFunc                             func(ctx context.Context, _type string, id string, request *getstream.VideoUnpinRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnpinResponse], error)
+	UpdateUserPermissionsFunc                  func(ctx context.Context, _type string, id string, request *getstream.UpdateUserPermissionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateUserPermissionsResponse], error)
+	DeleteRecordingFunc                        func(ctx context.Context, _type string, id string, session string, filename string, request *getstream.DeleteRecordingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteRecordingResponse], error)
+	DeleteTranscriptionFunc                    func(ctx context.Context, _type string, id string, session string, filename string, request *getstream.DeleteTranscriptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteTranscriptionResponse], error)
+	ReportClientCallEventFunc                  func(ctx context.Context, request *getstream.ReportClientCallEventRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ReportClientEventResponse], error)
+	QueryCallSessionStatsFunc                  func(ctx context.Context, request *getstream.QueryCallSessionStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallSessionStatsResponse], error)
+	GetCallStatsMapFunc                        func(ctx context.Context, callType string, callID string, session string, request *getstream.GetCallStatsMapRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallStatsMapResponse], error)
+	GetCallSessionParticipantStatsDetailsFunc  func(ctx context.Context, callType string, callID string, session string, user string, userSession string, request *getstream.GetCallSessionParticipantStatsDetailsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallSessionParticipantStatsDetailsResponse], error)
+	QueryCallSessionParticipantStatsFunc       func(ctx context.Context, callType string, callID string, session string, request *getstream.QueryCallSessionParticipantStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallSessionParticipantStatsResponse], error)
+	GetCallSessionParticipantStatsTimelineFunc func(ctx context.Context, callType string, callID string, session string, user string, userSession string, request *getstream.GetCallSessionParticipantStatsTimelineRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallSessionParticipantStatsTimelineResponse], error)
+	QueryCallsFunc                             func(ctx context.Context, request *getstream.QueryCallsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallsResponse], error)
+	ListCallTypesFunc                          func(ctx context.Context, request *getstream.ListCallTypesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListCallTypeResponse], error)
+	CreateCallTypeFunc                         func(ctx context.Context, request *getstream.CreateCallTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateCallTypeResponse], error)
+	DeleteCallTypeFunc                         func(ctx context.Context, name string, request *getstream.DeleteCallTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error)
+	GetCallTypeFunc                            func(ctx context.Context, name string, request *getstream.GetCallTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallTypeResponse], error)
+	UpdateCallTypeFunc                         func(ctx context.Context, name string, request *getstream.UpdateCallTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCallTypeResponse], error)
+	GetEdgesFunc                               func(ctx context.Context, request *getstream.GetEdgesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetEdgesResponse], error)
+	ResolveSipAuthFunc                         func(ctx context.Context, request *getstream.ResolveSipAuthRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ResolveSipAuthResponse], error)
+	ListSIPInboundRoutingRuleFunc              func(ctx context.Context, request *getstream.ListSIPInboundRoutingRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListSIPInboundRoutingRuleResponse], error)
+	CreateSIPInboundRoutingRuleFunc            func(ctx context.Context, request *getstream.CreateSIPInboundRoutingRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SIPInboundRoutingRuleResponse], error)
+	DeleteSIPInboundRoutingRuleFunc            func(ctx context.Context, id string, request *getstream.DeleteSIPInboundRoutingRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteSIPInboundRoutingRuleResponse], error)
+	UpdateSIPInboundRoutingRuleFunc            func(ctx context.Context, id string, request *getstream.UpdateSIPInboundRoutingRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateSIPInboundRoutingRuleResponse], error)
+	ListSIPTrunksFunc                          func(ctx context.Context, request *getstream.ListSIPTrunksRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListSIPTrunksResponse], error)
+	CreateSIPTrunkFunc                         func(ctx context.Context, request *getstream.CreateSIPTrunkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateSIPTrunkResponse], error)
+	DeleteSIPTrunkFunc                         func(ctx context.Context, id string, request *getstream.DeleteSIPTrunkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteSIPTrunkResponse], error)
+	UpdateSIPTrunkFunc                         func(ctx context.Context, id string, request *getstream.UpdateSIPTrunkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateSIPTrunkResponse], error)
+	ResolveSipInboundFunc                      func(ctx context.Context, request *getstream.ResolveSipInboundRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ResolveSipInboundResponse], error)
+	QueryAggregateCallStatsFunc                func(ctx context.Context, request *getstream.QueryAggregateCallStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryAggregateCallStatsResponse], error)
+	GetDailyDigestFunc                         func(ctx context.Context, request *getstream.GetDailyDigestRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetDailyDigestResponse], error)
+}
+
+// GetActiveCallsStatus records the call and calls GetActiveCallsStatusFunc if set.
+func (m *VideoAPI) GetActiveCallsStatus(ctx context.Context, request *getstream.GetActiveCallsStatusRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetActiveCallsStatusResponse], error) {
+	m.record(ctx, "GetActiveCallsStatus", request, opts)
+	if m.GetActiveCallsStatusFunc != nil {
+		return m.GetActiveCallsStatusFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetActiveCallsStatusResponse]), nil
+}
+
+// QueryUserFeedback records the call and calls QueryUserFeedbackFunc if set.
+func (m *VideoAPI) QueryUserFeedback(ctx context.Context, request *getstream.QueryUserFeedbackRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryUserFeedbackResponse], error) {
+	m.record(ctx, "QueryUserFeedback", request, opts)
+	if m.QueryUserFeedbackFunc != nil {
+		return m.QueryUserFeedbackFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryUserFeedbackResponse]), nil
+}
+
+// QueryCallMembers records the call and calls QueryCallMembersFunc if set.
+func (m *VideoAPI) QueryCallMembers(ctx context.Context, request *getstream.QueryCallMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallMembersResponse], error) {
+	m.record(ctx, "QueryCallMembers", request, opts)
+	if m.QueryCallMembersFunc != nil {
+		return m.QueryCallMembersFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCallMembersResponse]), nil
+}
+
+// QueryCallStats records the call and calls QueryCallStatsFunc if set.
+func (m *VideoAPI) QueryCallStats(ctx context.Context, request *getstream.QueryCallStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallStatsResponse], error) {
+	m.record(ctx, "QueryCallStats", request, opts)
+	if m.QueryCallStatsFunc != nil {
+		return m.QueryCallStatsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCallStatsResponse]), nil
+}
+
+// GetCall records the call and calls GetCallFunc if set.
+func (m *VideoAPI) GetCall(ctx context.Context, _type string, id string, request *getstream.GetCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallResponse], error) {
+	m.record(ctx, "GetCall", _type, id, request, opts)
+	if m.GetCallFunc != nil {
+		return m.GetCallFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCallResponse]), nil
+}
+
+// UpdateCall records the call and calls UpdateCallFunc if set.
+func (m *VideoAPI) UpdateCall(ctx context.Context, _type string, id string, request *getstream.UpdateCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCallResponse], error) {
+	m.record(ctx, "UpdateCall", _type, id, request, opts)
+	if m.UpdateCallFunc != nil {
+		return m.UpdateCallFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateCallResponse]), nil
+}
+
+// GetOrCreateCall records the call and calls GetOrCreateCallFunc if set.
+func (m *VideoAPI) GetOrCreateCall(ctx context.Context, _type string, id string, request *getstream.GetOrCreateCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetOrCreateCallResponse], error) {
+	m.record(ctx, "GetOrCreateCall", _type, id, request, opts)
+	if m.GetOrCreateCallFunc != nil {
+		return m.GetOrCreateCallFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetOrCreateCallResponse]), nil
+}
+
+// BlockUser records the call and calls BlockUserFunc if set.
+func (m *VideoAPI) BlockUser(ctx context.Context, _type string, id string, request *getstream.BlockUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.BlockUserResponse], error) {
+	m.record(ctx, "BlockUser", _type, id, request, opts)
+	if m.BlockUserFunc != nil {
+		return m.BlockUserFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.BlockUserResponse]), nil
+}
+
+// SendClosedCaption records the call and calls SendClosedCaptionFunc if set.
+func (m *VideoAPI) SendClosedCaption(ctx context.Context, _type string, id string, request *getstream.SendClosedCaptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SendClosedCaptionResponse], error) {
+	m.record(ctx, "SendClosedCaption", _type, id, request, opts)
+	if m.SendClosedCaptionFunc != nil {
+		return m.SendClosedCaptionFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SendClosedCaptionResponse]), nil
+}
+
+// DeleteCall records the call and calls DeleteCallFunc if set.
+func (m *VideoAPI) DeleteCall(ctx context.Context, _type string, id string, request *getstream.DeleteCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteCallResponse], error) {
+	m.record(ctx, "DeleteCall", _type, id, request, opts)
+	if m.DeleteCallFunc != nil {
+		return m.DeleteCallFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteCallResponse]), nil
+}
+
+// SendCallEvent records the call and calls SendCallEventFunc if set.
+func (m *VideoAPI) SendCallEvent(ctx context.Context, _type string, id string, request *getstream.SendCallEventRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SendCallEventResponse], error) {
+	m.record(ctx, "SendCallEvent", _type, id, request, opts)
+	if m.SendCallEventFunc != nil {
+		return m.SendCallEventFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SendCallEventResponse]), nil
+}
+
+// CollectUserFeedback records the call and calls CollectUserFeedbackFunc if set.
+func (m *VideoAPI) CollectUserFeedback(ctx context.Context, _type string, id string, request *getstream.CollectUserFeedbackRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CollectUserFeedbackResponse], error) {
+	m.record(ctx, "CollectUserFeedback", _type, id, request, opts)
+	if m.CollectUserFeedbackFunc != nil {
+		return m.CollectUserFeedbackFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CollectUserFeedbackResponse]), nil
+}
+
+// GoLive records the call and calls GoLiveFunc if set.
+func (m *VideoAPI) GoLive(ctx context.Context, _type string, id string, request *getstream.GoLiveRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GoLiveResponse], error) {
+	m.record(ctx, "GoLive", _type, id, request, opts)
+	if m.GoLiveFunc != nil {
+		return m.GoLiveFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GoLiveResponse]), nil
+}
+
+// KickUser records the call and calls KickUserFunc if set.
+func (m *VideoAPI) KickUser(ctx context.Context, _type string, id string, request *getstream.KickUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.KickUserResponse], error) {
+	m.record(ctx, "KickUser", _type, id, request, opts)
+	if m.KickUserFunc != nil {
+		return m.KickUserFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.KickUserResponse]), nil
+}
+
+// EndCall records the call and calls EndCallFunc if set.
+func (m *VideoAPI) EndCall(ctx context.Context, _type string, id string, request *getstream.EndCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.EndCallResponse], error) {
+	m.record(ctx, "EndCall", _type, id, request, opts)
+	if m.EndCallFunc != nil {
+		return m.EndCallFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.EndCallResponse]), nil
+}
+
+// UpdateCallMembers records the call and calls UpdateCallMembersFunc if set.
+func (m *VideoAPI) UpdateCallMembers(ctx context.Context, _type string, id string, request *getstream.UpdateCallMembersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCallMembersResponse], error) {
+	m.record(ctx, "UpdateCallMembers", _type, id, request, opts)
+	if m.UpdateCallMembersFunc != nil {
+		return m.UpdateCallMembersFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateCallMembersResponse]), nil
+}
+
+// MuteUsers records the call and calls MuteUsersFunc if set.
+func (m *VideoAPI) MuteUsers(ctx context.Context, _type string, id string, request *getstream.MuteUsersRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.MuteUsersResponse], error) {
+	m.record(ctx, "MuteUsers", _type, id, request, opts)
+	if m.MuteUsersFunc != nil {
+		return m.MuteUsersFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.MuteUsersResponse]), nil
+}
+
+// QueryCallParticipants records the call and calls QueryCallParticipantsFunc if set.
+func (m *VideoAPI) QueryCallParticipants(ctx context.Context, id string, _type string, request *getstream.QueryCallParticipantsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallParticipantsResponse], error) {
+	m.record(ctx, "QueryCallParticipants", id, _type, request, opts)
+	if m.QueryCallParticipantsFunc != nil {
+		return m.QueryCallParticipantsFunc(ctx, id, _type, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCallParticipantsResponse]), nil
+}
+
+// VideoPin records the call and calls VideoPinFunc if set.
+func (m *VideoAPI) VideoPin(ctx context.Context, _type string, id string, request *getstream.VideoPinRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.PinResponse], error) {
+	m.record(ctx, "VideoPin", _type, id, request, opts)
+	if m.VideoPinFunc != nil {
+		return m.VideoPinFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.PinResponse]), nil
+}
+
+// ListRecordings records the call and calls ListRecordingsFunc if set.
+func (m *VideoAPI) ListRecordings(ctx context.Context, _type string, id string, request *getstream.ListRecordingsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListRecordingsResponse], error) {
+	m.record(ctx, "ListRecordings", _type, id, request, opts)
+	if m.ListRecordingsFunc != nil {
+		return m.ListRecordingsFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListRecordingsResponse]), nil
+}
+
+// StartRecording records the call and calls StartRecordingFunc if set.
+func (m *VideoAPI) StartRecording(ctx context.Context, _type string, id string, recordingType string, request *getstream.StartRecordingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartRecordingResponse], error) {
+	m.record(ctx, "StartRecording", _type, id, recordingType, request, opts)
+	if m.StartRecordingFunc != nil {
+		return m.StartRecordingFunc(ctx, _type, id, recordingType, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StartRecordingResponse]), nil
+}
+
+// StopRecording records the call and calls StopRecordingFunc if set.
+func (m *VideoAPI) StopRecording(ctx context.Context, _type string, id string, recordingType string, request *getstream.StopRecordingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopRecordingResponse], error) {
+	m.record(ctx, "StopRecording", _type, id, recordingType, request, opts)
+	if m.StopRecordingFunc != nil {
+		return m.StopRecordingFunc(ctx, _type, id, recordingType, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StopRecordingResponse]), nil
+}
+
+// GetCallReport records the call and calls GetCallReportFunc if set.
+func (m *VideoAPI) GetCallReport(ctx context.Context, _type string, id string, request *getstream.GetCallReportRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallReportResponse], error) {
+	m.record(ctx, "GetCallReport", _type, id, request, opts)
+	if m.GetCallReportFunc != nil {
+		return m.GetCallReportFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCallReportResponse]), nil
+}
+
+// RingCall records the call and calls RingCallFunc if set.
+func (m *VideoAPI) RingCall(ctx context.Context, _type string, id string, request *getstream.RingCallRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.RingCallResponse], error) {
+	m.record(ctx, "RingCall", _type, id, request, opts)
+	if m.RingCallFunc != nil {
+		return m.RingCallFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.RingCallResponse]), nil
+}
+
+// StartRTMPBroadcasts records the call and calls StartRTMPBroadcastsFunc if set.
+func (m *VideoAPI) StartRTMPBroadcasts(ctx context.Context, _type string, id string, request *getstream.StartRTMPBroadcastsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartRTMPBroadcastsResponse], error) {
+	m.record(ctx, "StartRTMPBroadcasts", _type, id, request, opts)
+	if m.StartRTMPBroadcastsFunc != nil {
+		return m.StartRTMPBroadcastsFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StartRTMPBroadcastsResponse]), nil
+}
+
+// StopAllRTMPBroadcasts records the call and calls StopAllRTMPBroadcastsFunc if set.
+func (m *VideoAPI) StopAllRTMPBroadcasts(ctx context.Context, _type string, id string, request *getstream.StopAllRTMPBroadcastsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopAllRTMPBroadcastsResponse], error) {
+	m.record(ctx, "StopAllRTMPBroadcasts", _type, id, request, opts)
+	if m.StopAllRTMPBroadcastsFunc != nil {
+		return m.StopAllRTMPBroadcastsFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StopAllRTMPBroadcastsResponse]), nil
+}
+
+// StopRTMPBroadcast records the call and calls StopRTMPBroadcastFunc if set.
+func (m *VideoAPI) StopRTMPBroadcast(ctx context.Context, _type string, id string, name string, request *getstream.StopRTMPBroadcastRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopRTMPBroadcastsResponse], error) {
+	m.record(ctx, "StopRTMPBroadcast", _type, id, name, request, opts)
+	if m.StopRTMPBroadcastFunc != nil {
+		return m.StopRTMPBroadcastFunc(ctx, _type, id, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StopRTMPBroadcastsResponse]), nil
+}
+
+// GetCallParticipantSessionMetrics records the call and calls GetCallParticipantSessionMetricsFunc if set.
+func (m *VideoAPI) GetCallParticipantSessionMetrics(ctx context.Context, _type string, id string, session string, user string, userSession string, request *getstream.GetCallParticipantSessionMetricsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallParticipantSessionMetricsResponse], error) {
+	m.record(ctx, "GetCallParticipantSessionMetrics", _type, id, session, user, userSession, request, opts)
+	if m.GetCallParticipantSessionMetricsFunc != nil {
+		return m.GetCallParticipantSessionMetricsFunc(ctx, _type, id, session, user, userSession, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCallParticipantSessionMetricsResponse]), nil
+}
+
+// QueryCallParticipantSessions records the call and calls QueryCallParticipantSessionsFunc if set.
+func (m *VideoAPI) QueryCallParticipantSessions(ctx context.Context, _type string, id string, session string, request *getstream.QueryCallParticipantSessionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallParticipantSessionsResponse], error) {
+	m.record(ctx, "QueryCallParticipantSessions", _type, id, session, request, opts)
+	if m.QueryCallParticipantSessionsFunc != nil {
+		return m.QueryCallParticipantSessionsFunc(ctx, _type, id, session, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCallParticipantSessionsResponse]), nil
+}
+
+// StartHLSBroadcasting records the call and calls StartHLSBroadcastingFunc if set.
+func (m *VideoAPI) StartHLSBroadcasting(ctx context.Context, _type string, id string, request *getstream.StartHLSBroadcastingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartHLSBroadcastingResponse], error) {
+	m.record(ctx, "StartHLSBroadcasting", _type, id, request, opts)
+	if m.StartHLSBroadcastingFunc != nil {
+		return m.StartHLSBroadcastingFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StartHLSBroadcastingResponse]), nil
+}
+
+// StartClosedCaptions records the call and calls StartClosedCaptionsFunc if set.
+func (m *VideoAPI) StartClosedCaptions(ctx context.Context, _type string, id string, request *getstream.StartClosedCaptionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartClosedCaptionsResponse], error) {
+	m.record(ctx, "StartClosedCaptions", _type, id, request, opts)
+	if m.StartClosedCaptionsFunc != nil {
+		return m.StartClosedCaptionsFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StartClosedCaptionsResponse]), nil
+}
+
+// StartFrameRecording records the call and calls StartFrameRecordingFunc if set.
+func (m *VideoAPI) StartFrameRecording(ctx context.Context, _type string, id string, request *getstream.StartFrameRecordingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartFrameRecordingResponse], error) {
+	m.record(ctx, "StartFrameRecording", _type, id, request, opts)
+	if m.StartFrameRecordingFunc != nil {
+		return m.StartFrameRecordingFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StartFrameRecordingResponse]), nil
+}
+
+// StartTranscription records the call and calls StartTranscriptionFunc if set.
+func (m *VideoAPI) StartTranscription(ctx context.Context, _type string, id string, request *getstream.StartTranscriptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StartTranscriptionResponse], error) {
+	m.record(ctx, "StartTranscription", _type, id, request, opts)
+	if m.StartTranscriptionFunc != nil {
+		return m.StartTranscriptionFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StartTranscriptionResponse]), nil
+}
+
+// StopHLSBroadcasting records the call and calls StopHLSBroadcastingFunc if set.
+func (m *VideoAPI) StopHLSBroadcasting(ctx context.Context, _type string, id string, request *getstream.StopHLSBroadcastingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopHLSBroadcastingResponse], error) {
+	m.record(ctx, "StopHLSBroadcasting", _type, id, request, opts)
+	if m.StopHLSBroadcastingFunc != nil {
+		return m.StopHLSBroadcastingFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StopHLSBroadcastingResponse]), nil
+}
+
+// StopClosedCaptions records the call and calls StopClosedCaptionsFunc if set.
+func (m *VideoAPI) StopClosedCaptions(ctx context.Context, _type string, id string, request *getstream.StopClosedCaptionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopClosedCaptionsResponse], error) {
+	m.record(ctx, "StopClosedCaptions", _type, id, request, opts)
+	if m.StopClosedCaptionsFunc != nil {
+		return m.StopClosedCaptionsFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StopClosedCaptionsResponse]), nil
+}
+
+// StopFrameRecording records the call and calls StopFrameRecordingFunc if set.
+func (m *VideoAPI) StopFrameRecording(ctx context.Context, _type string, id string, request *getstream.StopFrameRecordingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopFrameRecordingResponse], error) {
+	m.record(ctx, "StopFrameRecording", _type, id, request, opts)
+	if m.StopFrameRecordingFunc != nil {
+		return m.StopFrameRecordingFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StopFrameRecordingResponse]), nil
+}
+
+// StopLive records the call and calls StopLiveFunc if set.
+func (m *VideoAPI) StopLive(ctx context.Context, _type string, id string, request *getstream.StopLiveRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopLiveResponse], error) {
+	m.record(ctx, "StopLive", _type, id, request, opts)
+	if m.StopLiveFunc != nil {
+		return m.StopLiveFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StopLiveResponse]), nil
+}
+
+// StopTranscription records the call and calls StopTranscriptionFunc if set.
+func (m *VideoAPI) StopTranscription(ctx context.Context, _type string, id string, request *getstream.StopTranscriptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.StopTranscriptionResponse], error) {
+	m.record(ctx, "StopTranscription", _type, id, request, opts)
+	if m.StopTranscriptionFunc != nil {
+		return m.StopTranscriptionFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.StopTranscriptionResponse]), nil
+}
+
+// ListTranscriptions records the call and calls ListTranscriptionsFunc if set.
+func (m *VideoAPI) ListTranscriptions(ctx context.Context, _type string, id string, request *getstream.ListTranscriptionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListTranscriptionsResponse], error) {
+	m.record(ctx, "ListTranscriptions", _type, id, request, opts)
+	if m.ListTranscriptionsFunc != nil {
+		return m.ListTranscriptionsFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListTranscriptionsResponse]), nil
+}
+
+// UnblockUser records the call and calls UnblockUserFunc if set.
+func (m *VideoAPI) UnblockUser(ctx context.Context, _type string, id string, request *getstream.UnblockUserRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnblockUserResponse], error) {
+	m.record(ctx, "UnblockUser", _type, id, request, opts)
+	if m.UnblockUserFunc != nil {
+		return m.UnblockUserFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnblockUserResponse]), nil
+}
+
+// VideoUnpin records the call and calls VideoUnpinFunc if set.
+func (m *VideoAPI) VideoUnpin(ctx context.Context, _type string, id string, request *getstream.VideoUnpinRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UnpinResponse], error) {
+	m.record(ctx, "VideoUnpin", _type, id, request, opts)
+	if m.VideoUnpinFunc != nil {
+		return m.VideoUnpinFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UnpinResponse]), nil
+}
+
+// UpdateUserPermissions records the call and calls UpdateUserPermissionsFunc if set.
+func (m *VideoAPI) UpdateUserPermissions(ctx context.Context, _type string, id string, request *getstream.UpdateUserPermissionsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateUserPermissionsResponse], error) {
+	m.record(ctx, "UpdateUserPermissions", _type, id, request, opts)
+	if m.UpdateUserPermissionsFunc != nil {
+		return m.UpdateUserPermissionsFunc(ctx, _type, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateUserPermissionsResponse]), nil
+}
+
+// DeleteRecording records the call and calls DeleteRecordingFunc if set.
+func (m *VideoAPI) DeleteRecording(ctx context.Context, _type string, id string, session string, filename string, request *getstream.DeleteRecordingRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteRecordingResponse], error) {
+	m.record(ctx, "DeleteRecording", _type, id, session, filename, request, opts)
+	if m.DeleteRecordingFunc != nil {
+		return m.DeleteRecordingFunc(ctx, _type, id, session, filename, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteRecordingResponse]), nil
+}
+
+// DeleteTranscription records the call and calls DeleteTranscriptionFunc if set.
+func (m *VideoAPI) DeleteTranscription(ctx context.Context, _type string, id string, session string, filename string, request *getstream.DeleteTranscriptionRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteTranscriptionResponse], error) {
+	m.record(ctx, "DeleteTranscription", _type, id, session, filename, request, opts)
+	if m.DeleteTranscriptionFunc != nil {
+		return m.DeleteTranscriptionFunc(ctx, _type, id, session, filename, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteTranscriptionResponse]), nil
+}
+
+// ReportClientCallEvent records the call and calls ReportClientCallEventFunc if set.
+func (m *VideoAPI) ReportClientCallEvent(ctx context.Context, request *getstream.ReportClientCallEventRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ReportClientEventResponse], error) {
+	m.record(ctx, "ReportClientCallEvent", request, opts)
+	if m.ReportClientCallEventFunc != nil {
+		return m.ReportClientCallEventFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ReportClientEventResponse]), nil
+}
+
+// QueryCallSessionStats records the call and calls QueryCallSessionStatsFunc if set.
+func (m *VideoAPI) QueryCallSessionStats(ctx context.Context, request *getstream.QueryCallSessionStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallSessionStatsResponse], error) {
+	m.record(ctx, "QueryCallSessionStats", request, opts)
+	if m.QueryCallSessionStatsFunc != nil {
+		return m.QueryCallSessionStatsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCallSessionStatsResponse]), nil
+}
+
+// GetCallStatsMap records the call and calls GetCallStatsMapFunc if set.
+func (m *VideoAPI) GetCallStatsMap(ctx context.Context, callType string, callID string, session string, request *getstream.GetCallStatsMapRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallStatsMapResponse], error) {
+	m.record(ctx, "GetCallStatsMap", callType, callID, session, request, opts)
+	if m.GetCallStatsMapFunc != nil {
+		return m.GetCallStatsMapFunc(ctx, callType, callID, session, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCallStatsMapResponse]), nil
+}
+
+// GetCallSessionParticipantStatsDetails records the call and calls GetCallSessionParticipantStatsDetailsFunc if set.
+func (m *VideoAPI) GetCallSessionParticipantStatsDetails(ctx context.Context, callType string, callID string, session string, user string, userSession string, request *getstream.GetCallSessionParticipantStatsDetailsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallSessionParticipantStatsDetailsResponse], error) {
+	m.record(ctx, "GetCallSessionParticipantStatsDetails", callType, callID, session, user, userSession, request, opts)
+	if m.GetCallSessionParticipantStatsDetailsFunc != nil {
+		return m.GetCallSessionParticipantStatsDetailsFunc(ctx, callType, callID, session, user, userSession, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCallSessionParticipantStatsDetailsResponse]), nil
+}
+
+// QueryCallSessionParticipantStats records the call and calls QueryCallSessionParticipantStatsFunc if set.
+func (m *VideoAPI) QueryCallSessionParticipantStats(ctx context.Context, callType string, callID string, session string, request *getstream.QueryCallSessionParticipantStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallSessionParticipantStatsResponse], error) {
+	m.record(ctx, "QueryCallSessionParticipantStats", callType, callID, session, request, opts)
+	if m.QueryCallSessionParticipantStatsFunc != nil {
+		return m.QueryCallSessionParticipantStatsFunc(ctx, callType, callID, session, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCallSessionParticipantStatsResponse]), nil
+}
+
+// GetCallSessionParticipantStatsTimeline records the call and calls GetCallSessionParticipantStatsTimelineFunc if set.
+func (m *VideoAPI) GetCallSessionParticipantStatsTimeline(ctx context.Context, callType string, callID string, session string, user string, userSession string, request *getstream.GetCallSessionParticipantStatsTimelineRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallSessionParticipantStatsTimelineResponse], error) {
+	m.record(ctx, "GetCallSessionParticipantStatsTimeline", callType, callID, session, user, userSession, request, opts)
+	if m.GetCallSessionParticipantStatsTimelineFunc != nil {
+		return m.GetCallSessionParticipantStatsTimelineFunc(ctx, callType, callID, session, user, userSession, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCallSessionParticipantStatsTimelineResponse]), nil
+}
+
+// QueryCalls records the call and calls QueryCallsFunc if set.
+func (m *VideoAPI) QueryCalls(ctx context.Context, request *getstream.QueryCallsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryCallsResponse], error) {
+	m.record(ctx, "QueryCalls", request, opts)
+	if m.QueryCallsFunc != nil {
+		return m.QueryCallsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryCallsResponse]), nil
+}
+
+// ListCallTypes records the call and calls ListCallTypesFunc if set.
+func (m *VideoAPI) ListCallTypes(ctx context.Context, request *getstream.ListCallTypesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListCallTypeResponse], error) {
+	m.record(ctx, "ListCallTypes", request, opts)
+	if m.ListCallTypesFunc != nil {
+		return m.ListCallTypesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListCallTypeResponse]), nil
+}
+
+// CreateCallType records the call and calls CreateCallTypeFunc if set.
+func (m *VideoAPI) CreateCallType(ctx context.Context, request *getstream.CreateCallTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateCallTypeResponse], error) {
+	m.record(ctx, "CreateCallType", request, opts)
+	if m.CreateCallTypeFunc != nil {
+		return m.CreateCallTypeFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateCallTypeResponse]), nil
+}
+
+// DeleteCallType records the call and calls DeleteCallTypeFunc if set.
+func (m *VideoAPI) DeleteCallType(ctx context.Context, name string, request *getstream.DeleteCallTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.Response], error) {
+	m.record(ctx, "DeleteCallType", name, request, opts)
+	if m.DeleteCallTypeFunc != nil {
+		return m.DeleteCallTypeFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.Response]), nil
+}
+
+// GetCallType records the call and calls GetCallTypeFunc if set.
+func (m *VideoAPI) GetCallType(ctx context.Context, name string, request *getstream.GetCallTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetCallTypeResponse], error) {
+	m.record(ctx, "GetCallType", name, request, opts)
+	if m.GetCallTypeFunc != nil {
+		return m.GetCallTypeFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetCallTypeResponse]), nil
+}
+
+// UpdateCallType records the call and calls UpdateCallTypeFunc if set.
+func (m *VideoAPI) UpdateCallType(ctx context.Context, name string, request *getstream.UpdateCallTypeRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateCallTypeResponse], error) {
+	m.record(ctx, "UpdateCallType", name, request, opts)
+	if m.UpdateCallTypeFunc != nil {
+		return m.UpdateCallTypeFunc(ctx, name, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateCallTypeResponse]), nil
+}
+
+// GetEdges records the call and calls GetEdgesFunc if set.
+func (m *VideoAPI) GetEdges(ctx context.Context, request *getstream.GetEdgesRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetEdgesResponse], error) {
+	m.record(ctx, "GetEdges", request, opts)
+	if m.GetEdgesFunc != nil {
+		return m.GetEdgesFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetEdgesResponse]), nil
+}
+
+// ResolveSipAuth records the call and calls ResolveSipAuthFunc if set.
+func (m *VideoAPI) ResolveSipAuth(ctx context.Context, request *getstream.ResolveSipAuthRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ResolveSipAuthResponse], error) {
+	m.record(ctx, "ResolveSipAuth", request, opts)
+	if m.ResolveSipAuthFunc != nil {
+		return m.ResolveSipAuthFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ResolveSipAuthResponse]), nil
+}
+
+// ListSIPInboundRoutingRule records the call and calls ListSIPInboundRoutingRuleFunc if set.
+func (m *VideoAPI) ListSIPInboundRoutingRule(ctx context.Context, request *getstream.ListSIPInboundRoutingRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListSIPInboundRoutingRuleResponse], error) {
+	m.record(ctx, "ListSIPInboundRoutingRule", request, opts)
+	if m.ListSIPInboundRoutingRuleFunc != nil {
+		return m.ListSIPInboundRoutingRuleFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListSIPInboundRoutingRuleResponse]), nil
+}
+
+// CreateSIPInboundRoutingRule records the call and calls CreateSIPInboundRoutingRuleFunc if set.
+func (m *VideoAPI) CreateSIPInboundRoutingRule(ctx context.Context, request *getstream.CreateSIPInboundRoutingRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.SIPInboundRoutingRuleResponse], error) {
+	m.record(ctx, "CreateSIPInboundRoutingRule", request, opts)
+	if m.CreateSIPInboundRoutingRuleFunc != nil {
+		return m.CreateSIPInboundRoutingRuleFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.SIPInboundRoutingRuleResponse]), nil
+}
+
+// DeleteSIPInboundRoutingRule records the call and calls DeleteSIPInboundRoutingRuleFunc if set.
+func (m *VideoAPI) DeleteSIPInboundRoutingRule(ctx context.Context, id string, request *getstream.DeleteSIPInboundRoutingRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteSIPInboundRoutingRuleResponse], error) {
+	m.record(ctx, "DeleteSIPInboundRoutingRule", id, request, opts)
+	if m.DeleteSIPInboundRoutingRuleFunc != nil {
+		return m.DeleteSIPInboundRoutingRuleFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteSIPInboundRoutingRuleResponse]), nil
+}
+
+// UpdateSIPInboundRoutingRule records the call and calls UpdateSIPInboundRoutingRuleFunc if set.
+func (m *VideoAPI) UpdateSIPInboundRoutingRule(ctx context.Context, id string, request *getstream.UpdateSIPInboundRoutingRuleRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateSIPInboundRoutingRuleResponse], error) {
+	m.record(ctx, "UpdateSIPInboundRoutingRule", id, request, opts)
+	if m.UpdateSIPInboundRoutingRuleFunc != nil {
+		return m.UpdateSIPInboundRoutingRuleFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateSIPInboundRoutingRuleResponse]), nil
+}
+
+// ListSIPTrunks records the call and calls ListSIPTrunksFunc if set.
+func (m *VideoAPI) ListSIPTrunks(ctx context.Context, request *getstream.ListSIPTrunksRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ListSIPTrunksResponse], error) {
+	m.record(ctx, "ListSIPTrunks", request, opts)
+	if m.ListSIPTrunksFunc != nil {
+		return m.ListSIPTrunksFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ListSIPTrunksResponse]), nil
+}
+
+// CreateSIPTrunk records the call and calls CreateSIPTrunkFunc if set.
+func (m *VideoAPI) CreateSIPTrunk(ctx context.Context, request *getstream.CreateSIPTrunkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.CreateSIPTrunkResponse], error) {
+	m.record(ctx, "CreateSIPTrunk", request, opts)
+	if m.CreateSIPTrunkFunc != nil {
+		return m.CreateSIPTrunkFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.CreateSIPTrunkResponse]), nil
+}
+
+// DeleteSIPTrunk records the call and calls DeleteSIPTrunkFunc if set.
+func (m *VideoAPI) DeleteSIPTrunk(ctx context.Context, id string, request *getstream.DeleteSIPTrunkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.DeleteSIPTrunkResponse], error) {
+	m.record(ctx, "DeleteSIPTrunk", id, request, opts)
+	if m.DeleteSIPTrunkFunc != nil {
+		return m.DeleteSIPTrunkFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.DeleteSIPTrunkResponse]), nil
+}
+
+// UpdateSIPTrunk records the call and calls UpdateSIPTrunkFunc if set.
+func (m *VideoAPI) UpdateSIPTrunk(ctx context.Context, id string, request *getstream.UpdateSIPTrunkRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.UpdateSIPTrunkResponse], error) {
+	m.record(ctx, "UpdateSIPTrunk", id, request, opts)
+	if m.UpdateSIPTrunkFunc != nil {
+		return m.UpdateSIPTrunkFunc(ctx, id, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.UpdateSIPTrunkResponse]), nil
+}
+
+// ResolveSipInbound records the call and calls ResolveSipInboundFunc if set.
+func (m *VideoAPI) ResolveSipInbound(ctx context.Context, request *getstream.ResolveSipInboundRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.ResolveSipInboundResponse], error) {
+	m.record(ctx, "ResolveSipInbound", request, opts)
+	if m.ResolveSipInboundFunc != nil {
+		return m.ResolveSipInboundFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.ResolveSipInboundResponse]), nil
+}
+
+// QueryAggregateCallStats records the call and calls QueryAggregateCallStatsFunc if set.
+func (m *VideoAPI) QueryAggregateCallStats(ctx context.Context, request *getstream.QueryAggregateCallStatsRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.QueryAggregateCallStatsResponse], error) {
+	m.record(ctx, "QueryAggregateCallStats", request, opts)
+	if m.QueryAggregateCallStatsFunc != nil {
+		return m.QueryAggregateCallStatsFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.QueryAggregateCallStatsResponse]), nil
+}
+
+// GetDailyDigest records the call and calls GetDailyDigestFunc if set.
+func (m *VideoAPI) GetDailyDigest(ctx context.Context, request *getstream.GetDailyDigestRequest, opts ...getstream.RequestOption) (*getstream.StreamResponse[getstream.GetDailyDigestResponse], error) {
+	m.record(ctx, "GetDailyDigest", request, opts)
+	if m.GetDailyDigestFunc != nil {
+		return m.GetDailyDigestFunc(ctx, request, opts...)
+	}
+	return new(getstream.StreamResponse[getstream.GetDailyDigestResponse]), nil
+}
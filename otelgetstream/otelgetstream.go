@@ -0,0 +1,140 @@
+// Package otelgetstream instruments getstream-go with OpenTelemetry tracing.
+//
+// It lives in its own module so the core SDK stays free of OpenTelemetry
+// dependencies:
+//
+//	client, err := getstream.NewClient(apiKey, apiSecret,
+//		otelgetstream.WithTracerProvider(otel.GetTracerProvider()),
+//	)
+package otelgetstream
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/GetStream/getstream-go/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name of the tracer.
+const ScopeName = "github.com/GetStream/getstream-go/v5/otelgetstream"
+
+// Attribute keys set on every span. The HTTP keys follow the OpenTelemetry
+// semantic conventions, matching the fields the SDK logs.
+const (
+	AttrHTTPRequestMethod  = attribute.Key("http.request.method")
+	AttrHTTPResendCount    = attribute.Key("http.request.resend_count")
+	AttrHTTPResponseStatus = attribute.Key("http.response.status_code")
+	AttrURLTemplate        = attribute.Key("url.template")
+	AttrErrorType          = attribute.Key("error.type")
+	AttrStreamOperation    = attribute.Key("stream.operation")
+	AttrStreamErrorCode    = attribute.Key("stream.error.code")
+	AttrStreamDryRun       = attribute.Key("stream.dry_run")
+	AttrRateLimitLimit     = attribute.Key("stream.ratelimit.limit")
+	AttrRateLimitRemaining = attribute.Key("stream.ratelimit.remaining")
+)
+
+// error.type values for failures that carry neither a transport
+// classification nor an HTTP status.
+const (
+	errorTypeOther          = "_OTHER"
+	errorTypeClientThrottle = "rate_limited"
+)
+
+// Option configures the tracing interceptor.
+type Option func(*config)
+
+type config struct {
+	propagator propagation.TextMapPropagator
+}
+
+// WithPropagator sets the propagator used to inject trace context into
+// outgoing request headers. Default: W3C Trace Context (traceparent).
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		if p != nil {
+			c.propagator = p
+		}
+	}
+}
+
+// WithTracerProvider returns a ClientOption that starts a client span for
+// every API call attempt. Retried attempts get their own span carrying
+// http.request.resend_count, all parented to the caller's span.
+func WithTracerProvider(tp trace.TracerProvider, opts ...Option) getstream.ClientOption {
+	cfg := config{propagator: propagation.TraceContext{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	tracer := tp.Tracer(ScopeName, trace.WithInstrumentationVersion(getstream.Version()))
+	return getstream.WithInterceptors(newInterceptor(tracer, cfg))
+}
+
+func newInterceptor(tracer trace.Tracer, cfg config) getstream.Interceptor {
+	return func(ctx context.Context, op *getstream.Operation, next getstream.Invoker) (any, error) {
+		attrs := []attribute.KeyValue{
+			AttrHTTPRequestMethod.String(op.Method),
+			AttrURLTemplate.String(op.Path),
+			AttrStreamOperation.String(op.Name()),
+		}
+		if op.Attempt > 0 {
+			attrs = append(attrs, AttrHTTPResendCount.Int(op.Attempt))
+		}
+		ctx, span := tracer.Start(ctx, op.Name(), trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+		defer span.End()
+
+		cfg.propagator.Inject(ctx, propagation.HeaderCarrier(op.Header))
+
+		res, err := next(ctx, op)
+
+		if op.StatusCode != 0 {
+			span.SetAttributes(AttrHTTPResponseStatus.Int(op.StatusCode))
+		}
+		if op.RateLimit != nil && op.RateLimit.Limit > 0 {
+			span.SetAttributes(
+				AttrRateLimitLimit.Int64(op.RateLimit.Limit),
+				AttrRateLimitRemaining.Int64(op.RateLimit.Remaining),
+			)
+		}
+		switch {
+		case errors.Is(err, getstream.ErrDryRun):
+			// Held back by dry-run mode: nothing was sent, nothing failed.
+			span.SetAttributes(AttrStreamDryRun.Bool(true))
+		case err != nil:
+			recordError(span, err)
+		}
+		return res, err
+	}
+}
+
+// recordError marks the span failed. Transport error messages can embed the
+// request URL (including api_key), so only the classified error type is
+// recorded for them; API errors carry the backend's message.
+func recordError(span trace.Span, err error) {
+	errType := errorTypeOther
+	desc := errType
+
+	var se *getstream.StreamError
+	if errors.As(err, &se) {
+		switch {
+		case errors.Is(err, getstream.ErrTransport):
+			errType = se.ErrorType
+			desc = "transport error: " + se.ErrorType
+		case se.StatusCode != 0:
+			errType = strconv.Itoa(se.StatusCode)
+			desc = se.Message
+		case errors.Is(err, getstream.ErrRateLimited):
+			errType = errorTypeClientThrottle
+			desc = se.Message
+		}
+		if se.Code != 0 {
+			span.SetAttributes(AttrStreamErrorCode.Int(se.Code))
+		}
+	}
+	span.SetAttributes(AttrErrorType.String(errType))
+	span.SetStatus(codes.Error, desc)
+}
@@ -0,0 +1,137 @@
+package otelgetstream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/GetStream/getstream-go/v5"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type fakeHTTP struct {
+	status  int
+	body    string
+	err     error
+	headers []http.Header
+}
+
+func (f *fakeHTTP) Do(r *http.Request) (*http.Response, error) {
+	f.headers = append(f.headers, r.Header.Clone())
+	if f.err != nil {
+		return nil, f.err
+	}
+	h := http.Header{}
+	h.Set(getstream.HeaderRateLimit, "100")
+	h.Set(getstream.HeaderRateRemaining, "42")
+	return &http.Response{StatusCode: f.status, Header: h, Body: io.NopCloser(strings.NewReader(f.body))}, nil
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+func newTracedClient(t *testing.T, fake *fakeHTTP, opts ...getstream.ClientOption) (*getstream.Stream, *tracetest.SpanRecorder, trace.Tracer) {
+	t.Helper()
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	opts = append([]getstream.ClientOption{getstream.WithHTTPClient(fake), getstream.WithLogger(nopLogger{}), WithTracerProvider(tp)}, opts...)
+	client, err := getstream.NewClient("key", "secret", opts...)
+	require.NoError(t, err)
+	return client, rec, tp.Tracer("test")
+}
+
+func attrs(s sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	out := map[attribute.Key]attribute.Value{}
+	for _, kv := range s.Attributes() {
+		out[kv.Key] = kv.Value
+	}
+	return out
+}
+
+func TestSpanPerCall(t *testing.T) {
+	fake := &fakeHTTP{status: 200, body: `{}`}
+	client, rec, tracer := newTracedClient(t, fake)
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, err := client.Chat().GetChannel(ctx, "messaging", "general", &getstream.GetChannelRequest{})
+	require.NoError(t, err)
+	parent.End()
+
+	spans := rec.Ended()
+	require.Len(t, spans, 2)
+	span := spans[0]
+	require.Equal(t, "GET /api/v2/chat/channels/{type}/{id}", span.Name())
+	require.Equal(t, trace.SpanKindClient, span.SpanKind())
+	require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+
+	a := attrs(span)
+	require.Equal(t, "GET", a[AttrHTTPRequestMethod].AsString())
+	require.Equal(t, "/api/v2/chat/channels/{type}/{id}", a[AttrURLTemplate].AsString())
+	require.Equal(t, int64(200), a[AttrHTTPResponseStatus].AsInt64())
+	require.Equal(t, int64(42), a[AttrRateLimitRemaining].AsInt64())
+	require.Equal(t, codes.Unset, span.Status().Code)
+
+	traceparent := fake.headers[0].Get("traceparent")
+	require.Contains(t, traceparent, span.SpanContext().TraceID().String())
+	require.Contains(t, traceparent, span.SpanContext().SpanID().String())
+}
+
+func TestAPIErrorSpan(t *testing.T) {
+	fake := &fakeHTTP{status: 400, body: `{"code":4,"message":"bad input"}`}
+	client, rec, _ := newTracedClient(t, fake)
+
+	_, err := client.GetApp(context.Background(), &getstream.GetAppRequest{})
+	require.True(t, errors.Is(err, getstream.ErrApiResponse))
+
+	span := rec.Ended()[0]
+	a := attrs(span)
+	require.Equal(t, int64(4), a[AttrStreamErrorCode].AsInt64())
+	require.Equal(t, "400", a[AttrErrorType].AsString())
+	require.Equal(t, codes.Error, span.Status().Code)
+	require.Equal(t, "bad input", span.Status().Description)
+}
+
+func TestDryRunSpanNotFailed(t *testing.T) {
+	fake := &fakeHTTP{status: 200, body: `{}`}
+	client, rec, _ := newTracedClient(t, fake, getstream.WithDryRun(getstream.DryRunConfig{Enabled: true}))
+
+	_, err := client.DeleteUsers(context.Background(), &getstream.DeleteUsersRequest{UserIds: []string{"u"}})
+	require.True(t, errors.Is(err, getstream.ErrDryRun))
+	require.Empty(t, fake.headers)
+
+	span := rec.Ended()[0]
+	a := attrs(span)
+	require.True(t, a[AttrStreamDryRun].AsBool())
+	require.NotContains(t, a, AttrErrorType)
+	require.Equal(t, codes.Unset, span.Status().Code)
+}
+
+func TestRetriedAttemptsGetOwnSpans(t *testing.T) {
+	fake := &fakeHTTP{err: syscall.ECONNRESET}
+	client, rec, _ := newTracedClient(t, fake,
+		getstream.WithRetry(getstream.RetryConfig{Enabled: true, MaxAttempts: 2, MaxBackoff: 1}))
+
+	_, err := client.GetApp(context.Background(), &getstream.GetAppRequest{})
+	require.True(t, errors.Is(err, getstream.ErrTransport))
+
+	spans := rec.Ended()
+	require.Len(t, spans, 2)
+	_, first := attrs(spans[0])[AttrHTTPResendCount]
+	require.False(t, first)
+	require.Equal(t, int64(1), attrs(spans[1])[AttrHTTPResendCount].AsInt64())
+	require.Equal(t, getstream.ErrorTypeConnectionReset, attrs(spans[1])[AttrErrorType].AsString())
+	require.NotContains(t, spans[1].Status().Description, "api_key")
+}
@@ -0,0 +1,660 @@
+package getstream
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// PaginatorOption configures a Paginator.
+type PaginatorOption func(*paginatorConfig)
+
+type paginatorConfig struct {
+	maxItems       int
+	stableSort     bool
+	requestOptions []RequestOption
+}
+
+// WithPaginationMaxItems caps the total number of items a Paginator yields
+// across all pages. The last page is truncated to fit. Values <= 0 disable the
+// cap (default).
+func WithPaginationMaxItems(n int) PaginatorOption {
+	return func(c *paginatorConfig) {
+		c.maxItems = n
+	}
+}
+
+// WithPaginationStableSort makes offset-based paginators replace the request
+// sort with ascending created_at, so records created while the walk is in
+// progress land on later pages instead of shifting earlier ones past the
+// offset (which would skip or repeat items). Cursor paginators ignore it.
+func WithPaginationStableSort() PaginatorOption {
+	return func(c *paginatorConfig) {
+		c.stableSort = true
+	}
+}
+
+// WithPaginationRequestOptions applies opts to every page request, as if
+// passed to the client method.
+func WithPaginationRequestOptions(opts ...RequestOption) PaginatorOption {
+	return func(c *paginatorConfig) {
+		c.requestOptions = append(c.requestOptions, opts...)
+	}
+}
+
+// Paginator walks a paginated query endpoint page by page (NextPage) or item
+// by item (Next), re-issuing the call until the backend reports no further
+// pages, the item cap is reached, an error occurs, or ctx is cancelled.
+//
+// A Paginator is not safe for concurrent use.
+//
+//	p := client.Feeds().QueryActivitiesPaginator(&getstream.QueryActivitiesRequest{Limit: getstream.PtrTo(100)})
+//	for p.Next(ctx) {
+//		activity := p.Item()
+//		...
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+type Paginator[GResponse any, GItem any] struct {
+	fetch   func(ctx context.Context, opts ...RequestOption) (*StreamResponse[GResponse], error)
+	advance func(page *GResponse, n int) bool
+	items   func(*GResponse) []GItem
+	cfg     paginatorConfig
+
+	page      *StreamResponse[GResponse]
+	pageItems []GItem
+	itemIdx   int
+	yielded   int
+	started   bool
+	done      bool
+	err       error
+}
+
+// NewCursorPaginator builds a Paginator over an endpoint that paginates with
+// Next/Prev cursors. fetch is the generated client method (or a closure
+// binding its path params) and items selects the page's result slice.
+//
+// request is copied; the caller's value is never mutated. The Next cursor of
+// each response is fed into the Next field of the following request. If
+// either type has no Next cursor, the Paginator fetches nothing and Err
+// reports it.
+func NewCursorPaginator[GRequest any, GResponse any, GItem any](request *GRequest, fetch func(context.Context, *GRequest, ...RequestOption) (*StreamResponse[GResponse], error), items func(*GResponse) []GItem, opts ...PaginatorOption) *Paginator[GResponse, GItem] {
+	req := copyRequest(request)
+
+	var lastCursor string
+	p := newPaginator(
+		func(ctx context.Context, opts ...RequestOption) (*StreamResponse[GResponse], error) {
+			return fetch(ctx, req, opts...)
+		},
+		func(page *GResponse, _ int) bool {
+			next := cursorField(page, "Next")
+			if next == "" || next == lastCursor {
+				return false
+			}
+			lastCursor = next
+			return setCursorField(req, "Next", next)
+		},
+		items,
+		opts,
+	)
+	if !hasRequestField(reflect.TypeOf(req), "Next", stringPtrType) {
+		p.err = fmt.Errorf("%T has no Next cursor to paginate with", req)
+	} else if !hasRequestField(reflect.TypeOf((*GResponse)(nil)), "Next", stringPtrType) {
+		p.err = fmt.Errorf("%T has no Next cursor to paginate with", (*GResponse)(nil))
+	}
+	return p
+}
+
+// NewOffsetPaginator builds a Paginator over an endpoint that paginates with
+// Limit/Offset (directly on the request or inside its Payload). The offset
+// advances by the number of items received, and iteration stops on the first
+// short page: one smaller than Limit or, when Limit is unset, smaller than the
+// first page the backend returned.
+//
+// request is copied; the caller's value (including its Payload) is never
+// mutated. If the request has no Offset, the Paginator fetches nothing and
+// Err reports it.
+func NewOffsetPaginator[GRequest any, GResponse any, GItem any](request *GRequest, fetch func(context.Context, *GRequest, ...RequestOption) (*StreamResponse[GResponse], error), items func(*GResponse) []GItem, opts ...PaginatorOption) *Paginator[GResponse, GItem] {
+	req := copyRequest(request)
+
+	offset := 0
+	if f := requestField(req, "Offset", intPtrType); f.IsValid() && !f.IsNil() {
+		offset = int(f.Elem().Int())
+	}
+	pageSize := 0
+	if f := requestField(req, "Limit", intPtrType); f.IsValid() && !f.IsNil() {
+		pageSize = int(f.Elem().Int())
+	}
+
+	p := newPaginator(
+		func(ctx context.Context, opts ...RequestOption) (*StreamResponse[GResponse], error) {
+			return fetch(ctx, req, opts...)
+		},
+		func(_ *GResponse, n int) bool {
+			if pageSize == 0 {
+				pageSize = n
+			}
+			if n < pageSize {
+				return false
+			}
+			offset += n
+			f := requestField(req, "Offset", intPtrType)
+			if !f.IsValid() {
+				return false
+			}
+			next := offset
+			f.Set(reflect.ValueOf(&next))
+			return true
+		},
+		items,
+		opts,
+	)
+	if !hasRequestField(reflect.TypeOf(req), "Offset", intPtrType) {
+		p.err = fmt.Errorf("%T has no Offset to paginate with", req)
+	}
+	if p.cfg.stableSort {
+		if f := requestField(req, "Sort", sortType); f.IsValid() {
+			f.Set(reflect.ValueOf([]SortParamRequest{{Field: PtrTo("created_at"), Direction: PtrTo(1)}}))
+		}
+	}
+	return p
+}
+
+func newPaginator[GResponse any, GItem any](fetch func(context.Context, ...RequestOption) (*StreamResponse[GResponse], error), advance func(*GResponse, int) bool, items func(*GResponse) []GItem, opts []PaginatorOption) *Paginator[GResponse, GItem] {
+	p := &Paginator[GResponse, GItem]{
+		fetch:   fetch,
+		advance: advance,
+		items:   items,
+	}
+	for _, opt := range opts {
+		opt(&p.cfg)
+	}
+	return p
+}
+
+// NextPage fetches the next page. It returns false once the pages are
+// exhausted, the item cap is reached, or an error occurred (see Err).
+func (p *Paginator[GResponse, GItem]) NextPage(ctx context.Context) bool {
+	if p.done || p.err != nil {
+		return false
+	}
+	if p.started && !p.advance(&p.page.Data, len(p.items(&p.page.Data))) {
+		p.done = true
+		return false
+	}
+	if err := ctx.Err(); err != nil {
+		p.err = wrapTransportError(err)
+		return false
+	}
+
+	page, err := p.fetch(ctx, p.cfg.requestOptions...)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.started = true
+	p.page = page
+	p.itemIdx = 0
+
+	items := p.items(&page.Data)
+	if len(items) == 0 {
+		p.pageItems = nil
+		p.done = true
+		return false
+	}
+	if p.cfg.maxItems > 0 && p.yielded+len(items) >= p.cfg.maxItems {
+		items = items[:p.cfg.maxItems-p.yielded]
+		p.done = true
+	}
+	p.yielded += len(items)
+	p.pageItems = items
+	return true
+}
+
+// Next advances to the next item, fetching further pages as needed. It
+// returns false when iteration is over; check Err to tell exhaustion from
+// failure.
+func (p *Paginator[GResponse, GItem]) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+	if p.started && p.itemIdx < len(p.pageItems) {
+		p.itemIdx++
+		if p.itemIdx < len(p.pageItems) {
+			return true
+		}
+	}
+	// NextPage resets itemIdx to 0, which is the item to yield now.
+	return p.NextPage(ctx)
+}
+
+// Item returns the current item. Only valid after Next returned true.
+func (p *Paginator[GResponse, GItem]) Item() GItem {
+	if p.itemIdx < len(p.pageItems) {
+		return p.pageItems[p.itemIdx]
+	}
+	var zero GItem
+	return zero
+}
+
+// Page returns the most recently fetched page, or nil before the first fetch.
+func (p *Paginator[GResponse, GItem]) Page() *StreamResponse[GResponse] {
+	return p.page
+}
+
+// Items returns the items of the current page, truncated to the item cap.
+func (p *Paginator[GResponse, GItem]) Items() []GItem {
+	return p.pageItems
+}
+
+// RateLimit returns the rate-limit window reported with the current page.
+func (p *Paginator[GResponse, GItem]) RateLimit() *RateLimitInfo {
+	if p.page == nil {
+		return nil
+	}
+	return p.page.RateLimitInfo
+}
+
+// Err returns the error that stopped iteration, if any.
+func (p *Paginator[GResponse, GItem]) Err() error {
+	return p.err
+}
+
+// All drains the remaining pages and returns every item collected. Items
+// gathered before a failure are returned alongside the error.
+func (p *Paginator[GResponse, GItem]) All(ctx context.Context) ([]GItem, error) {
+	var all []GItem
+	for p.NextPage(ctx) {
+		all = append(all, p.pageItems...)
+	}
+	return all, p.err
+}
+
+var (
+	stringPtrType = reflect.TypeOf((*string)(nil))
+	intPtrType    = reflect.TypeOf((*int)(nil))
+	sortType      = reflect.TypeOf([]SortParamRequest(nil))
+)
+
+// copyRequest returns a shallow copy of request with its Payload (the
+// query-string JSON body used by QueryUsers, QueryMembers and Search) cloned
+// as well, so paginators can rewrite cursors without touching the caller's
+// value.
+func copyRequest[GRequest any](request *GRequest) *GRequest {
+	req := new(GRequest)
+	if request == nil {
+		return req
+	}
+	*req = *request
+
+	val := reflect.ValueOf(req).Elem()
+	if val.Kind() != reflect.Struct {
+		return req
+	}
+	payload := val.FieldByName("Payload")
+	if payload.IsValid() && payload.Kind() == reflect.Ptr && !payload.IsNil() {
+		clone := reflect.New(payload.Type().Elem())
+		clone.Elem().Set(payload.Elem())
+		payload.Set(clone)
+	}
+	return req
+}
+
+// cursorField reads the *string field called name from a response struct.
+// Returns "" when the field is absent or nil.
+func cursorField(v any, name string) string {
+	f := structField(reflect.ValueOf(v), name, stringPtrType)
+	if !f.IsValid() || f.IsNil() {
+		return ""
+	}
+	return f.Elem().String()
+}
+
+// setCursorField assigns value to the *string field called name on a request.
+// Reports false when the request has no such field.
+func setCursorField(v any, name, value string) bool {
+	f := requestField(v, name, stringPtrType)
+	if !f.IsValid() {
+		return false
+	}
+	f.Set(reflect.ValueOf(&value))
+	return true
+}
+
+// requestField returns the settable field called name with type typ, looking
+// first on the request itself and then inside its Payload, which is allocated
+// if nil. Returns the zero Value when neither has the field.
+func requestField(v any, name string, typ reflect.Type) reflect.Value {
+	val := reflect.ValueOf(v)
+	if f := structField(val, name, typ); f.IsValid() {
+		return f
+	}
+	payload := structField(val, "Payload", nil)
+	if !payload.IsValid() || payload.Kind() != reflect.Ptr {
+		return reflect.Value{}
+	}
+	if f := structField(reflect.New(payload.Type().Elem()), name, typ); !f.IsValid() {
+		return reflect.Value{}
+	}
+	if payload.IsNil() {
+		payload.Set(reflect.New(payload.Type().Elem()))
+	}
+	return structField(payload, name, typ)
+}
+
+// hasRequestField reports whether the struct type t (or pointer to it), or
+// the type its Payload points to, has a field called name of type typ.
+func hasRequestField(t reflect.Type, name string, typ reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	if f, ok := t.FieldByName(name); ok && f.Type == typ {
+		return true
+	}
+	payload, ok := t.FieldByName("Payload")
+	if !ok || payload.Type.Kind() != reflect.Ptr || payload.Type.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	f, ok := payload.Type.Elem().FieldByName(name)
+	return ok && f.Type == typ
+}
+
+// structField returns the field called name of the struct (or pointer to
+// struct) val, provided it has type typ. A nil typ matches any type.
+func structField(val reflect.Value, name string, typ reflect.Type) reflect.Value {
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	f := val.FieldByName(name)
+	if !f.IsValid() || (typ != nil && f.Type() != typ) {
+		return reflect.Value{}
+	}
+	return f
+}
+
+// QueryChannelsPaginator iterates QueryChannels by offset.
+func (c *ChatClient) QueryChannelsPaginator(request *QueryChannelsRequest, opts ...PaginatorOption) *Paginator[QueryChannelsResponse, ChannelStateResponseFields] {
+	return NewOffsetPaginator(request, c.QueryChannels, func(r *QueryChannelsResponse) []ChannelStateResponseFields { return r.Channels }, opts...)
+}
+
+// QueryMembersPaginator iterates QueryMembers by offset.
+func (c *ChatClient) QueryMembersPaginator(request *QueryMembersRequest, opts ...PaginatorOption) *Paginator[MembersResponse, ChannelMemberResponse] {
+	return NewOffsetPaginator(request, c.QueryMembers, func(r *MembersResponse) []ChannelMemberResponse { return r.Members }, opts...)
+}
+
+// SearchPaginator iterates Search results using the next cursor, which the
+// backend recommends over offset for message search.
+func (c *ChatClient) SearchPaginator(request *SearchRequest, opts ...PaginatorOption) *Paginator[SearchResponse, SearchResult] {
+	return NewCursorPaginator(request, c.Search, func(r *SearchResponse) []SearchResult { return r.Results }, opts...)
+}
+
+// QueryUsersPaginator iterates QueryUsers by offset.
+func (c *Client) QueryUsersPaginator(request *QueryUsersRequest, opts ...PaginatorOption) *Paginator[QueryUsersResponse, FullUserResponse] {
+	return NewOffsetPaginator(request, c.QueryUsers, func(r *QueryUsersResponse) []FullUserResponse { return r.Users }, opts...)
+}
+
+// QueryCampaignsPaginator iterates QueryCampaigns.
+func (c *ChatClient) QueryCampaignsPaginator(request *QueryCampaignsRequest, opts ...PaginatorOption) *Paginator[QueryCampaignsResponse, CampaignResponse] {
+	return NewCursorPaginator(request, c.QueryCampaigns, func(r *QueryCampaignsResponse) []CampaignResponse { return r.Campaigns }, opts...)
+}
+
+// QueryDraftsPaginator iterates QueryDrafts.
+func (c *ChatClient) QueryDraftsPaginator(request *QueryDraftsRequest, opts ...PaginatorOption) *Paginator[QueryDraftsResponse, DraftResponse] {
+	return NewCursorPaginator(request, c.QueryDrafts, func(r *QueryDraftsResponse) []DraftResponse { return r.Drafts }, opts...)
+}
+
+// QueryMessageHistoryPaginator iterates QueryMessageHistory.
+func (c *ChatClient) QueryMessageHistoryPaginator(request *QueryMessageHistoryRequest, opts ...PaginatorOption) *Paginator[QueryMessageHistoryResponse, MessageHistoryEntryResponse] {
+	return NewCursorPaginator(request, c.QueryMessageHistory, func(r *QueryMessageHistoryResponse) []MessageHistoryEntryResponse { return r.MessageHistory }, opts...)
+}
+
+// QueryReactionsPaginator iterates QueryReactions for message id.
+func (c *ChatClient) QueryReactionsPaginator(id string, request *QueryReactionsRequest, opts ...PaginatorOption) *Paginator[QueryReactionsResponse, ReactionResponse] {
+	fetch := func(ctx context.Context, r *QueryReactionsRequest, opts ...RequestOption) (*StreamResponse[QueryReactionsResponse], error) {
+		return c.QueryReactions(ctx, id, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *QueryReactionsResponse) []ReactionResponse { return r.Reactions }, opts...)
+}
+
+// QueryRemindersPaginator iterates QueryReminders.
+func (c *ChatClient) QueryRemindersPaginator(request *QueryRemindersRequest, opts ...PaginatorOption) *Paginator[QueryRemindersResponse, ReminderResponseData] {
+	return NewCursorPaginator(request, c.QueryReminders, func(r *QueryRemindersResponse) []ReminderResponseData { return r.Reminders }, opts...)
+}
+
+// GetRetentionPolicyRunsPaginator iterates GetRetentionPolicyRuns.
+func (c *ChatClient) GetRetentionPolicyRunsPaginator(request *GetRetentionPolicyRunsRequest, opts ...PaginatorOption) *Paginator[GetRetentionPolicyRunsResponse, RetentionRunResponse] {
+	return NewCursorPaginator(request, c.GetRetentionPolicyRuns, func(r *GetRetentionPolicyRunsResponse) []RetentionRunResponse { return r.Runs }, opts...)
+}
+
+// QuerySegmentsPaginator iterates QuerySegments.
+func (c *ChatClient) QuerySegmentsPaginator(request *QuerySegmentsRequest, opts ...PaginatorOption) *Paginator[QuerySegmentsResponse, SegmentResponse] {
+	return NewCursorPaginator(request, c.QuerySegments, func(r *QuerySegmentsResponse) []SegmentResponse { return r.Segments }, opts...)
+}
+
+// QuerySegmentTargetsPaginator iterates QuerySegmentTargets for segment id.
+func (c *ChatClient) QuerySegmentTargetsPaginator(id string, request *QuerySegmentTargetsRequest, opts ...PaginatorOption) *Paginator[QuerySegmentTargetsResponse, SegmentTargetResponse] {
+	fetch := func(ctx context.Context, r *QuerySegmentTargetsRequest, opts ...RequestOption) (*StreamResponse[QuerySegmentTargetsResponse], error) {
+		return c.QuerySegmentTargets(ctx, id, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *QuerySegmentTargetsResponse) []SegmentTargetResponse { return r.Targets }, opts...)
+}
+
+// QueryTeamUsageStatsPaginator iterates QueryTeamUsageStats.
+func (c *ChatClient) QueryTeamUsageStatsPaginator(request *QueryTeamUsageStatsRequest, opts ...PaginatorOption) *Paginator[QueryTeamUsageStatsResponse, TeamUsageStats] {
+	return NewCursorPaginator(request, c.QueryTeamUsageStats, func(r *QueryTeamUsageStatsResponse) []TeamUsageStats { return r.Teams }, opts...)
+}
+
+// QueryThreadsPaginator iterates QueryThreads.
+func (c *ChatClient) QueryThreadsPaginator(request *QueryThreadsRequest, opts ...PaginatorOption) *Paginator[QueryThreadsResponse, ThreadStateResponse] {
+	return NewCursorPaginator(request, c.QueryThreads, func(r *QueryThreadsResponse) []ThreadStateResponse { return r.Threads }, opts...)
+}
+
+// QueryPollsPaginator iterates QueryPolls.
+func (c *Client) QueryPollsPaginator(request *QueryPollsRequest, opts ...PaginatorOption) *Paginator[QueryPollsResponse, PollResponseData] {
+	return NewCursorPaginator(request, c.QueryPolls, func(r *QueryPollsResponse) []PollResponseData { return r.Polls }, opts...)
+}
+
+// QueryPollVotesPaginator iterates QueryPollVotes for pollID.
+func (c *Client) QueryPollVotesPaginator(pollID string, request *QueryPollVotesRequest, opts ...PaginatorOption) *Paginator[PollVotesResponse, PollVoteResponseData] {
+	fetch := func(ctx context.Context, r *QueryPollVotesRequest, opts ...RequestOption) (*StreamResponse[PollVotesResponse], error) {
+		return c.QueryPollVotes(ctx, pollID, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *PollVotesResponse) []PollVoteResponseData { return r.Votes }, opts...)
+}
+
+// QueryActivitiesPaginator iterates QueryActivities.
+func (c *FeedsClient) QueryActivitiesPaginator(request *QueryActivitiesRequest, opts ...PaginatorOption) *Paginator[QueryActivitiesResponse, ActivityResponse] {
+	return NewCursorPaginator(request, c.QueryActivities, func(r *QueryActivitiesResponse) []ActivityResponse { return r.Activities }, opts...)
+}
+
+// QueryActivityReactionsPaginator iterates QueryActivityReactions for activityID.
+func (c *FeedsClient) QueryActivityReactionsPaginator(activityID string, request *QueryActivityReactionsRequest, opts ...PaginatorOption) *Paginator[QueryActivityReactionsResponse, FeedsReactionResponse] {
+	fetch := func(ctx context.Context, r *QueryActivityReactionsRequest, opts ...RequestOption) (*StreamResponse[QueryActivityReactionsResponse], error) {
+		return c.QueryActivityReactions(ctx, activityID, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *QueryActivityReactionsResponse) []FeedsReactionResponse { return r.Reactions }, opts...)
+}
+
+// BatchQueryActivityReactionsPaginator iterates BatchQueryActivityReactions.
+func (c *FeedsClient) BatchQueryActivityReactionsPaginator(request *BatchQueryActivityReactionsRequest, opts ...PaginatorOption) *Paginator[BatchQueryActivityReactionsResponse, FeedsReactionResponse] {
+	return NewCursorPaginator(request, c.BatchQueryActivityReactions, func(r *BatchQueryActivityReactionsResponse) []FeedsReactionResponse { return r.Reactions }, opts...)
+}
+
+// QueryActivitySharesPaginator iterates QueryActivityShares for activityID.
+func (c *FeedsClient) QueryActivitySharesPaginator(activityID string, request *QueryActivitySharesRequest, opts ...PaginatorOption) *Paginator[QueryActivitySharesResponse, ShareResponse] {
+	fetch := func(ctx context.Context, r *QueryActivitySharesRequest, opts ...RequestOption) (*StreamResponse[QueryActivitySharesResponse], error) {
+		return c.QueryActivityShares(ctx, activityID, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *QueryActivitySharesResponse) []ShareResponse { return r.Shares }, opts...)
+}
+
+// QueryBookmarkFoldersPaginator iterates QueryBookmarkFolders.
+func (c *FeedsClient) QueryBookmarkFoldersPaginator(request *QueryBookmarkFoldersRequest, opts ...PaginatorOption) *Paginator[QueryBookmarkFoldersResponse, BookmarkFolderResponse] {
+	return NewCursorPaginator(request, c.QueryBookmarkFolders, func(r *QueryBookmarkFoldersResponse) []BookmarkFolderResponse { return r.BookmarkFolders }, opts...)
+}
+
+// QueryBookmarksPaginator iterates QueryBookmarks.
+func (c *FeedsClient) QueryBookmarksPaginator(request *QueryBookmarksRequest, opts ...PaginatorOption) *Paginator[QueryBookmarksResponse, BookmarkResponse] {
+	return NewCursorPaginator(request, c.QueryBookmarks, func(r *QueryBookmarksResponse) []BookmarkResponse { return r.Bookmarks }, opts...)
+}
+
+// QueryCollectionsPaginator iterates QueryCollections.
+func (c *FeedsClient) QueryCollectionsPaginator(request *QueryCollectionsRequest, opts ...PaginatorOption) *Paginator[QueryCollectionsResponse, CollectionResponse] {
+	return NewCursorPaginator(request, c.QueryCollections, func(r *QueryCollectionsResponse) []CollectionResponse { return r.Collections }, opts...)
+}
+
+// QueryCommentsPaginator iterates QueryComments.
+func (c *FeedsClient) QueryCommentsPaginator(request *QueryCommentsRequest, opts ...PaginatorOption) *Paginator[QueryCommentsResponse, CommentResponse] {
+	return NewCursorPaginator(request, c.QueryComments, func(r *QueryCommentsResponse) []CommentResponse { return r.Comments }, opts...)
+}
+
+// GetCommentsPaginator iterates GetComments.
+func (c *FeedsClient) GetCommentsPaginator(request *GetCommentsRequest, opts ...PaginatorOption) *Paginator[GetCommentsResponse, ThreadedCommentResponse] {
+	return NewCursorPaginator(request, c.GetComments, func(r *GetCommentsResponse) []ThreadedCommentResponse { return r.Comments }, opts...)
+}
+
+// GetCommentRepliesPaginator iterates GetCommentReplies for comment id.
+func (c *FeedsClient) GetCommentRepliesPaginator(id string, request *GetCommentRepliesRequest, opts ...PaginatorOption) *Paginator[GetCommentRepliesResponse, ThreadedCommentResponse] {
+	fetch := func(ctx context.Context, r *GetCommentRepliesRequest, opts ...RequestOption) (*StreamResponse[GetCommentRepliesResponse], error) {
+		return c.GetCommentReplies(ctx, id, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *GetCommentRepliesResponse) []ThreadedCommentResponse { return r.Comments }, opts...)
+}
+
+// QueryCommentReactionsPaginator iterates QueryCommentReactions for comment id.
+func (c *FeedsClient) QueryCommentReactionsPaginator(id string, request *QueryCommentReactionsRequest, opts ...PaginatorOption) *Paginator[QueryCommentReactionsResponse, FeedsReactionResponse] {
+	fetch := func(ctx context.Context, r *QueryCommentReactionsRequest, opts ...RequestOption) (*StreamResponse[QueryCommentReactionsResponse], error) {
+		return c.QueryCommentReactions(ctx, id, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *QueryCommentReactionsResponse) []FeedsReactionResponse { return r.Reactions }, opts...)
+}
+
+// BatchQueryCommentReactionsPaginator iterates BatchQueryCommentReactions.
+func (c *FeedsClient) BatchQueryCommentReactionsPaginator(request *BatchQueryCommentReactionsRequest, opts ...PaginatorOption) *Paginator[BatchQueryCommentReactionsResponse, FeedsReactionResponse] {
+	return NewCursorPaginator(request, c.BatchQueryCommentReactions, func(r *BatchQueryCommentReactionsResponse) []FeedsReactionResponse { return r.Reactions }, opts...)
+}
+
+// QueryFeedsPaginator iterates QueryFeeds.
+func (c *FeedsClient) QueryFeedsPaginator(request *QueryFeedsRequest, opts ...PaginatorOption) *Paginator[QueryFeedsResponse, FeedResponse] {
+	return NewCursorPaginator(request, c.QueryFeeds, func(r *QueryFeedsResponse) []FeedResponse { return r.Feeds }, opts...)
+}
+
+// QueryFeedMembersPaginator iterates QueryFeedMembers for the feed
+// feedGroupID:feedID.
+func (c *FeedsClient) QueryFeedMembersPaginator(feedGroupID, feedID string, request *QueryFeedMembersRequest, opts ...PaginatorOption) *Paginator[QueryFeedMembersResponse, FeedMemberResponse] {
+	fetch := func(ctx context.Context, r *QueryFeedMembersRequest, opts ...RequestOption) (*StreamResponse[QueryFeedMembersResponse], error) {
+		return c.QueryFeedMembers(ctx, feedGroupID, feedID, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *QueryFeedMembersResponse) []FeedMemberResponse { return r.Members }, opts...)
+}
+
+// QueryFollowsPaginator iterates QueryFollows.
+func (c *FeedsClient) QueryFollowsPaginator(request *QueryFollowsRequest, opts ...PaginatorOption) *Paginator[QueryFollowsResponse, FollowResponse] {
+	return NewCursorPaginator(request, c.QueryFollows, func(r *QueryFollowsResponse) []FollowResponse { return r.Follows }, opts...)
+}
+
+// QueryMembershipLevelsPaginator iterates QueryMembershipLevels.
+func (c *FeedsClient) QueryMembershipLevelsPaginator(request *QueryMembershipLevelsRequest, opts ...PaginatorOption) *Paginator[QueryMembershipLevelsResponse, MembershipLevelResponse] {
+	return NewCursorPaginator(request, c.QueryMembershipLevels, func(r *QueryMembershipLevelsResponse) []MembershipLevelResponse { return r.MembershipLevels }, opts...)
+}
+
+// QueryPinnedActivitiesPaginator iterates QueryPinnedActivities for the feed
+// feedGroupID:feedID.
+func (c *FeedsClient) QueryPinnedActivitiesPaginator(feedGroupID, feedID string, request *QueryPinnedActivitiesRequest, opts ...PaginatorOption) *Paginator[QueryPinnedActivitiesResponse, ActivityPinResponse] {
+	fetch := func(ctx context.Context, r *QueryPinnedActivitiesRequest, opts ...RequestOption) (*StreamResponse[QueryPinnedActivitiesResponse], error) {
+		return c.QueryPinnedActivities(ctx, feedGroupID, feedID, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *QueryPinnedActivitiesResponse) []ActivityPinResponse { return r.PinnedActivities }, opts...)
+}
+
+// QueryRevisionHistoryPaginator iterates QueryRevisionHistory.
+func (c *FeedsClient) QueryRevisionHistoryPaginator(request *QueryRevisionHistoryRequest, opts ...PaginatorOption) *Paginator[QueryRevisionHistoryResponse, RevisionHistoryResponse] {
+	return NewCursorPaginator(request, c.QueryRevisionHistory, func(r *QueryRevisionHistoryResponse) []RevisionHistoryResponse { return r.Revisions }, opts...)
+}
+
+// QueryCallsPaginator iterates QueryCalls.
+func (c *VideoClient) QueryCallsPaginator(request *QueryCallsRequest, opts ...PaginatorOption) *Paginator[QueryCallsResponse, CallStateResponseFields] {
+	return NewCursorPaginator(request, c.QueryCalls, func(r *QueryCallsResponse) []CallStateResponseFields { return r.Calls }, opts...)
+}
+
+// QueryCallMembersPaginator iterates QueryCallMembers.
+func (c *VideoClient) QueryCallMembersPaginator(request *QueryCallMembersRequest, opts ...PaginatorOption) *Paginator[QueryCallMembersResponse, MemberResponse] {
+	return NewCursorPaginator(request, c.QueryCallMembers, func(r *QueryCallMembersResponse) []MemberResponse { return r.Members }, opts...)
+}
+
+// QueryCallParticipantSessionsPaginator iterates QueryCallParticipantSessions
+// for a session of the call _type:id.
+func (c *VideoClient) QueryCallParticipantSessionsPaginator(_type, id, session string, request *QueryCallParticipantSessionsRequest, opts ...PaginatorOption) *Paginator[QueryCallParticipantSessionsResponse, ParticipantSessionDetails] {
+	fetch := func(ctx context.Context, r *QueryCallParticipantSessionsRequest, opts ...RequestOption) (*StreamResponse[QueryCallParticipantSessionsResponse], error) {
+		return c.QueryCallParticipantSessions(ctx, _type, id, session, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *QueryCallParticipantSessionsResponse) []ParticipantSessionDetails {
+		return r.ParticipantsSessions
+	}, opts...)
+}
+
+// QueryCallStatsPaginator iterates QueryCallStats.
+func (c *VideoClient) QueryCallStatsPaginator(request *QueryCallStatsRequest, opts ...PaginatorOption) *Paginator[QueryCallStatsResponse, CallStatsReportSummaryResponse] {
+	return NewCursorPaginator(request, c.QueryCallStats, func(r *QueryCallStatsResponse) []CallStatsReportSummaryResponse { return r.Reports }, opts...)
+}
+
+// QueryCallSessionStatsPaginator iterates QueryCallSessionStats.
+func (c *VideoClient) QueryCallSessionStatsPaginator(request *QueryCallSessionStatsRequest, opts ...PaginatorOption) *Paginator[QueryCallSessionStatsResponse, CallStatsSessionResponse] {
+	return NewCursorPaginator(request, c.QueryCallSessionStats, func(r *QueryCallSessionStatsResponse) []CallStatsSessionResponse { return r.CallStats }, opts...)
+}
+
+// QueryCallSessionParticipantStatsPaginator iterates
+// QueryCallSessionParticipantStats for a session of the call callType:callID.
+func (c *VideoClient) QueryCallSessionParticipantStatsPaginator(callType, callID, session string, request *QueryCallSessionParticipantStatsRequest, opts ...PaginatorOption) *Paginator[QueryCallSessionParticipantStatsResponse, CallStatsParticipant] {
+	fetch := func(ctx context.Context, r *QueryCallSessionParticipantStatsRequest, opts ...RequestOption) (*StreamResponse[QueryCallSessionParticipantStatsResponse], error) {
+		return c.QueryCallSessionParticipantStats(ctx, callType, callID, session, r, opts...)
+	}
+	return NewCursorPaginator(request, fetch, func(r *QueryCallSessionParticipantStatsResponse) []CallStatsParticipant { return r.Participants }, opts...)
+}
+
+// QueryUserFeedbackPaginator iterates QueryUserFeedback.
+func (c *VideoClient) QueryUserFeedbackPaginator(request *QueryUserFeedbackRequest, opts ...PaginatorOption) *Paginator[QueryUserFeedbackResponse, UserFeedbackResponse] {
+	return NewCursorPaginator(request, c.QueryUserFeedback, func(r *QueryUserFeedbackResponse) []UserFeedbackResponse { return r.UserFeedback }, opts...)
+}
+
+// QueryAppealsPaginator iterates QueryAppeals.
+func (c *ModerationClient) QueryAppealsPaginator(request *QueryAppealsRequest, opts ...PaginatorOption) *Paginator[QueryAppealsResponse, AppealItemResponse] {
+	return NewCursorPaginator(request, c.QueryAppeals, func(r *QueryAppealsResponse) []AppealItemResponse { return r.Items }, opts...)
+}
+
+// QueryModerationConfigsPaginator iterates QueryModerationConfigs.
+func (c *ModerationClient) QueryModerationConfigsPaginator(request *QueryModerationConfigsRequest, opts ...PaginatorOption) *Paginator[QueryModerationConfigsResponse, ConfigResponse] {
+	return NewCursorPaginator(request, c.QueryModerationConfigs, func(r *QueryModerationConfigsResponse) []ConfigResponse { return r.Configs }, opts...)
+}
+
+// QueryModerationFlagsPaginator iterates QueryModerationFlags.
+func (c *ModerationClient) QueryModerationFlagsPaginator(request *QueryModerationFlagsRequest, opts ...PaginatorOption) *Paginator[QueryModerationFlagsResponse, ModerationFlagResponse] {
+	return NewCursorPaginator(request, c.QueryModerationFlags, func(r *QueryModerationFlagsResponse) []ModerationFlagResponse { return r.Flags }, opts...)
+}
+
+// QueryLabelResultsPaginator iterates QueryLabelResults.
+func (c *ModerationClient) QueryLabelResultsPaginator(request *QueryLabelResultsRequest, opts ...PaginatorOption) *Paginator[QueryLabelResultsResponse, LabelResultResponse] {
+	return NewCursorPaginator(request, c.QueryLabelResults, func(r *QueryLabelResultsResponse) []LabelResultResponse { return r.LabelResults }, opts...)
+}
+
+// QueryModerationLogsPaginator iterates QueryModerationLogs.
+func (c *ModerationClient) QueryModerationLogsPaginator(request *QueryModerationLogsRequest, opts ...PaginatorOption) *Paginator[QueryModerationLogsResponse, ActionLogResponse] {
+	return NewCursorPaginator(request, c.QueryModerationLogs, func(r *QueryModerationLogsResponse) []ActionLogResponse { return r.Logs }, opts...)
+}
+
+// QueryModerationRulesPaginator iterates QueryModerationRules.
+func (c *ModerationClient) QueryModerationRulesPaginator(request *QueryModerationRulesRequest, opts ...PaginatorOption) *Paginator[QueryModerationRulesResponse, ModerationRuleV2Response] {
+	return NewCursorPaginator(request, c.QueryModerationRules, func(r *QueryModerationRulesResponse) []ModerationRuleV2Response { return r.Rules }, opts...)
+}
+
+// QueryReviewQueuePaginator iterates QueryReviewQueue.
+func (c *ModerationClient) QueryReviewQueuePaginator(request *QueryReviewQueueRequest, opts ...PaginatorOption) *Paginator[QueryReviewQueueResponse, ReviewQueueItemResponse] {
+	return NewCursorPaginator(request, c.QueryReviewQueue, func(r *QueryReviewQueueResponse) []ReviewQueueItemResponse { return r.Items }, opts...)
+}
@@ -0,0 +1,288 @@
+package getstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// cursorPagesClient serves QueryActivities-shaped pages chained by the "next"
+// cursor of the request body. Each page holds pageSize activities.
+type cursorPagesClient struct {
+	pages    int
+	pageSize int
+	cursors  []string
+}
+
+func (s *cursorPagesClient) Do(r *http.Request) (*http.Response, error) {
+	var body struct {
+		Next *string `json:"next"`
+	}
+	if r.Body != nil {
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, &body)
+	}
+	cursor := ""
+	if body.Next != nil {
+		cursor = *body.Next
+	}
+	s.cursors = append(s.cursors, cursor)
+
+	page := len(s.cursors) - 1
+	activities := make([]map[string]any, 0, s.pageSize)
+	for i := 0; i < s.pageSize && page < s.pages; i++ {
+		activities = append(activities, map[string]any{"id": fmt.Sprintf("a%d-%d", page, i)})
+	}
+	resp := map[string]any{"activities": activities}
+	if page+1 < s.pages {
+		resp["next"] = fmt.Sprintf("cursor-%d", page+1)
+	}
+	b, _ := json.Marshal(resp)
+
+	h := http.Header{}
+	h.Set(HeaderRateRemaining, fmt.Sprint(100-page))
+	return &http.Response{StatusCode: http.StatusOK, Header: h, Body: io.NopCloser(strings.NewReader(string(b)))}, nil
+}
+
+func TestCursorPaginator_WalksAllPages(t *testing.T) {
+	fake := &cursorPagesClient{pages: 3, pageSize: 2}
+	client := newTestClient(t, fake)
+
+	req := &QueryActivitiesRequest{Limit: PtrTo(2)}
+	p := client.Feeds().QueryActivitiesPaginator(req)
+
+	var ids []string
+	for p.Next(context.Background()) {
+		ids = append(ids, p.Item().ID)
+	}
+	require.NoError(t, p.Err())
+	require.Equal(t, []string{"a0-0", "a0-1", "a1-0", "a1-1", "a2-0", "a2-1"}, ids)
+	require.Equal(t, []string{"", "cursor-1", "cursor-2"}, fake.cursors)
+	require.Nil(t, req.Next, "caller's request must not be mutated")
+}
+
+func TestCursorPaginator_PagesReportRateLimit(t *testing.T) {
+	client := newTestClient(t, &cursorPagesClient{pages: 2, pageSize: 1})
+	p := client.Feeds().QueryActivitiesPaginator(nil)
+
+	var remaining []int64
+	for p.NextPage(context.Background()) {
+		require.Len(t, p.Items(), 1)
+		remaining = append(remaining, p.RateLimit().Remaining)
+	}
+	require.NoError(t, p.Err())
+	require.Equal(t, []int64{100, 99}, remaining)
+}
+
+func TestCursorPaginator_MaxItems(t *testing.T) {
+	fake := &cursorPagesClient{pages: 5, pageSize: 2}
+	client := newTestClient(t, fake)
+
+	items, err := client.Feeds().QueryActivitiesPaginator(nil, WithPaginationMaxItems(3)).All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	require.Len(t, fake.cursors, 2, "no page may be fetched past the cap")
+}
+
+func TestCursorPaginator_StopsOnRepeatedCursor(t *testing.T) {
+	calls := 0
+	fetch := func(ctx context.Context, r *QueryCallsRequest, _ ...RequestOption) (*StreamResponse[QueryCallsResponse], error) {
+		calls++
+		return &StreamResponse[QueryCallsResponse]{Data: QueryCallsResponse{
+			Calls: []CallStateResponseFields{{}},
+			Next:  PtrTo("same"),
+		}}, nil
+	}
+	p := NewCursorPaginator(&QueryCallsRequest{}, fetch, func(r *QueryCallsResponse) []CallStateResponseFields { return r.Calls })
+
+	items, err := p.All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, 2, calls)
+}
+
+func TestCursorPaginator_RejectsRequestWithoutCursor(t *testing.T) {
+	calls := 0
+	fetch := func(ctx context.Context, r *GetAppRequest, _ ...RequestOption) (*StreamResponse[QueryCallsResponse], error) {
+		calls++
+		return &StreamResponse[QueryCallsResponse]{Data: QueryCallsResponse{Calls: []CallStateResponseFields{{}}}}, nil
+	}
+	callItems := func(r *QueryCallsResponse) []CallStateResponseFields { return r.Calls }
+
+	cursor := NewCursorPaginator(&GetAppRequest{}, fetch, callItems)
+	require.False(t, cursor.Next(context.Background()))
+	require.ErrorContains(t, cursor.Err(), "*getstream.GetAppRequest has no Next cursor")
+	require.Zero(t, calls)
+}
+
+func TestCursorPaginator_ContextCancelled(t *testing.T) {
+	client := newTestClient(t, &cursorPagesClient{pages: 3, pageSize: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	p := client.Feeds().QueryActivitiesPaginator(nil)
+	require.True(t, p.Next(ctx))
+	cancel()
+	require.False(t, p.Next(ctx))
+	require.True(t, errors.Is(p.Err(), ErrTransport))
+	require.True(t, errors.Is(p.Err(), context.Canceled))
+}
+
+func TestCursorPaginator_SurfacesFetchError(t *testing.T) {
+	client := newTestClient(t, &oneShotClient{status: 500, body: `{"code":-1,"message":"boom"}`})
+
+	items, err := client.Moderation().QueryReviewQueuePaginator(nil).All(context.Background())
+	require.Empty(t, items)
+	require.True(t, errors.Is(err, ErrApiResponse))
+}
+
+// offsetUsersClient serves QueryUsers pages out of total users, honouring the
+// limit/offset carried in the JSON "payload" query parameter.
+type offsetUsersClient struct {
+	total    int
+	payloads []QueryUsersPayload
+}
+
+func (s *offsetUsersClient) Do(r *http.Request) (*http.Response, error) {
+	var payload QueryUsersPayload
+	_ = json.Unmarshal([]byte(r.URL.Query().Get("payload")), &payload)
+	s.payloads = append(s.payloads, payload)
+
+	offset, limit := 0, 10
+	if payload.Offset != nil {
+		offset = *payload.Offset
+	}
+	if payload.Limit != nil {
+		limit = *payload.Limit
+	}
+	users := []map[string]any{}
+	for i := offset; i < offset+limit && i < s.total; i++ {
+		users = append(users, map[string]any{"id": fmt.Sprintf("u%d", i)})
+	}
+	b, _ := json.Marshal(map[string]any{"users": users})
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(string(b)))}, nil
+}
+
+func TestOffsetPaginator_StopsOnShortPage(t *testing.T) {
+	fake := &offsetUsersClient{total: 7}
+	client := newTestClient(t, fake)
+
+	req := &QueryUsersRequest{Payload: &QueryUsersPayload{Limit: PtrTo(3)}}
+	users, err := client.QueryUsersPaginator(req).All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, users, 7)
+	require.Equal(t, "u6", users[6].ID)
+
+	require.Len(t, fake.payloads, 3, "a short page must end the walk without an extra call")
+	require.Nil(t, fake.payloads[0].Offset)
+	require.Equal(t, 3, *fake.payloads[1].Offset)
+	require.Equal(t, 6, *fake.payloads[2].Offset)
+	require.Nil(t, req.Payload.Offset, "caller's payload must not be mutated")
+}
+
+func TestOffsetPaginator_RejectsRequestWithoutOffset(t *testing.T) {
+	calls := 0
+	fetch := func(ctx context.Context, r *QueryCallsRequest, _ ...RequestOption) (*StreamResponse[QueryCallsResponse], error) {
+		calls++
+		return &StreamResponse[QueryCallsResponse]{Data: QueryCallsResponse{Calls: []CallStateResponseFields{{}}}}, nil
+	}
+	p := NewOffsetPaginator(&QueryCallsRequest{}, fetch, func(r *QueryCallsResponse) []CallStateResponseFields { return r.Calls })
+
+	_, err := p.All(context.Background())
+	require.ErrorContains(t, err, "*getstream.QueryCallsRequest has no Offset")
+	require.Zero(t, calls)
+}
+
+func TestOffsetPaginator_InfersPageSizeWithoutLimit(t *testing.T) {
+	fake := &offsetUsersClient{total: 20}
+	client := newTestClient(t, fake)
+
+	users, err := client.QueryUsersPaginator(nil).All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, users, 20)
+	require.Len(t, fake.payloads, 3, "an exactly-full last page needs one empty page to confirm the end")
+}
+
+func TestOffsetPaginator_StableSort(t *testing.T) {
+	fake := &offsetUsersClient{total: 1}
+	client := newTestClient(t, fake)
+
+	req := &QueryUsersRequest{Payload: &QueryUsersPayload{
+		Sort: []SortParamRequest{{Field: PtrTo("last_active"), Direction: PtrTo(-1)}},
+	}}
+	_, err := client.QueryUsersPaginator(req, WithPaginationStableSort()).All(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "created_at", *fake.payloads[0].Sort[0].Field)
+	require.Equal(t, 1, *fake.payloads[0].Sort[0].Direction)
+	require.Equal(t, "last_active", *req.Payload.Sort[0].Field)
+}
+
+func TestOffsetPaginator_QueryChannelsBody(t *testing.T) {
+	var offsets []*int
+	fetch := func(ctx context.Context, r *QueryChannelsRequest, _ ...RequestOption) (*StreamResponse[QueryChannelsResponse], error) {
+		offsets = append(offsets, r.Offset)
+		n := 2
+		if len(offsets) == 2 {
+			n = 1
+		}
+		return &StreamResponse[QueryChannelsResponse]{Data: QueryChannelsResponse{Channels: make([]ChannelStateResponseFields, n)}}, nil
+	}
+	p := NewOffsetPaginator(&QueryChannelsRequest{Limit: PtrTo(2), Offset: PtrTo(4)}, fetch, func(r *QueryChannelsResponse) []ChannelStateResponseFields { return r.Channels })
+
+	items, err := p.All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	require.Equal(t, 4, *offsets[0])
+	require.Equal(t, 6, *offsets[1])
+}
+
+// TestCursorPaginators_CoverEveryCursorEndpoint checks that every generated
+// service method paging with next cursors on both request and response has a
+// ...Paginator wrapper.
+func TestCursorPaginators_CoverEveryCursorEndpoint(t *testing.T) {
+	excluded := map[string]bool{
+		// A get-or-create write whose cursor pages the feed's activities;
+		// QueryActivitiesPaginator with a feed filter walks those.
+		"FeedsClient.GetOrCreateFeed": true,
+	}
+	stringPtr := reflect.TypeOf((*string)(nil))
+	client := newTestClient(t, &cursorPagesClient{})
+	var missing []string
+	checked := 0
+	for _, svc := range []any{client.Client, client.Chat(), client.Video(), client.Feeds(), client.Moderation()} {
+		typ := reflect.TypeOf(svc)
+		for i := 0; i < typ.NumMethod(); i++ {
+			m := typ.Method(i)
+			mt := m.Type
+			if mt.NumIn() < 3 || mt.NumOut() != 2 || !strings.HasPrefix(mt.Out(0).String(), "*getstream.StreamResponse[") {
+				continue
+			}
+			data, _ := mt.Out(0).Elem().FieldByName("Data")
+			last := mt.NumIn() - 1
+			if mt.IsVariadic() {
+				last--
+			}
+			request := reflect.New(mt.In(last).Elem()).Interface()
+			if f, ok := data.Type.FieldByName("Next"); !ok || f.Type != stringPtr {
+				continue
+			}
+			if !requestField(request, "Next", stringPtr).IsValid() {
+				continue
+			}
+			checked++
+			name := typ.Elem().Name() + "." + m.Name
+			if _, ok := typ.MethodByName(m.Name + "Paginator"); !ok && !excluded[name] {
+				missing = append(missing, name)
+			}
+		}
+	}
+	require.NotZero(t, checked, "no cursor endpoints found")
+	require.Empty(t, missing, "cursor endpoints without a ...Paginator wrapper")
+}
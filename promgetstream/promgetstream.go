@@ -0,0 +1,147 @@
+// Package promgetstream exports getstream-go client metrics to Prometheus.
+//
+// It lives in its own module so the core SDK stays free of Prometheus
+// dependencies:
+//
+//	m, err := promgetstream.New(prometheus.DefaultRegisterer)
+//	if err != nil {
+//		...
+//	}
+//	client, err := getstream.NewClient(apiKey, apiSecret, getstream.WithMetrics(m))
+package promgetstream
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/GetStream/getstream-go/v5"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultNamespace prefixes every metric name unless overridden with
+// WithNamespace.
+const DefaultNamespace = "getstream"
+
+// Option configures the collectors built by New.
+type Option func(*config)
+
+type config struct {
+	namespace       string
+	durationBuckets []float64
+	sizeBuckets     []float64
+}
+
+// WithNamespace overrides the metric name prefix. Default "getstream".
+func WithNamespace(ns string) Option {
+	return func(c *config) {
+		c.namespace = ns
+	}
+}
+
+// WithDurationBuckets overrides the request latency histogram buckets, in
+// seconds. Default prometheus.DefBuckets.
+func WithDurationBuckets(buckets []float64) Option {
+	return func(c *config) {
+		c.durationBuckets = buckets
+	}
+}
+
+// WithSizeBuckets overrides the response size histogram buckets, in bytes.
+// Default: powers of 4 from 256B to 4MiB.
+func WithSizeBuckets(buckets []float64) Option {
+	return func(c *config) {
+		c.sizeBuckets = buckets
+	}
+}
+
+// Metrics implements getstream.Metrics on top of Prometheus collectors. Every
+// series is labelled by the stable operation name (method + path template),
+// never by the expanded URL, so cardinality stays bounded.
+type Metrics struct {
+	requests           *prometheus.CounterVec
+	duration           *prometheus.HistogramVec
+	responseSize       *prometheus.HistogramVec
+	retries            *prometheus.CounterVec
+	rateLimitRemaining *prometheus.GaugeVec
+	rateLimitLimit     *prometheus.GaugeVec
+}
+
+var _ getstream.Metrics = (*Metrics)(nil)
+
+// New builds the collectors and registers them with reg.
+func New(reg prometheus.Registerer, opts ...Option) (*Metrics, error) {
+	cfg := config{
+		namespace:       DefaultNamespace,
+		durationBuckets: prometheus.DefBuckets,
+		sizeBuckets:     prometheus.ExponentialBuckets(256, 4, 8),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: cfg.namespace,
+			Name:      "requests_total",
+			Help:      "API call attempts by operation, HTTP status class, status code and transport error type.",
+		}, []string{"operation", "status_class", "status_code", "error_type"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: cfg.namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Time from sending an API request to reading the full response.",
+			Buckets:   cfg.durationBuckets,
+		}, []string{"operation", "status_class"}),
+		responseSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: cfg.namespace,
+			Name:      "response_size_bytes",
+			Help:      "API response body size.",
+			Buckets:   cfg.sizeBuckets,
+		}, []string{"operation"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: cfg.namespace,
+			Name:      "retries_total",
+			Help:      "Failed attempts scheduled for retry.",
+		}, []string{"operation"}),
+		rateLimitRemaining: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: cfg.namespace,
+			Name:      "ratelimit_remaining",
+			Help:      "Calls remaining in the current rate-limit window, as last reported by the API.",
+		}, []string{"operation"}),
+		rateLimitLimit: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: cfg.namespace,
+			Name:      "ratelimit_limit",
+			Help:      "Calls allowed per rate-limit window, as last reported by the API.",
+		}, []string{"operation"}),
+	}
+
+	for _, c := range []prometheus.Collector{m.requests, m.duration, m.responseSize, m.retries, m.rateLimitRemaining, m.rateLimitLimit} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// RecordRequest implements getstream.Metrics.
+func (m *Metrics) RecordRequest(r getstream.RequestMetrics) {
+	code := ""
+	if r.StatusCode > 0 {
+		code = strconv.Itoa(r.StatusCode)
+	}
+	m.requests.WithLabelValues(r.Operation, r.StatusClass, code, r.ErrorType).Inc()
+	if r.Duration > 0 {
+		m.duration.WithLabelValues(r.Operation, r.StatusClass).Observe(r.Duration.Seconds())
+	}
+	if r.StatusCode > 0 {
+		m.responseSize.WithLabelValues(r.Operation).Observe(float64(r.ResponseSize))
+	}
+	if r.RateLimit != nil {
+		m.rateLimitRemaining.WithLabelValues(r.Operation).Set(float64(r.RateLimit.Remaining))
+		m.rateLimitLimit.WithLabelValues(r.Operation).Set(float64(r.RateLimit.Limit))
+	}
+}
+
+// RecordRetry implements getstream.Metrics.
+func (m *Metrics) RecordRetry(operation string, _ int, _ time.Duration) {
+	m.retries.WithLabelValues(operation).Inc()
+}
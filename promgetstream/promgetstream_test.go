@@ -0,0 +1,70 @@
+package promgetstream
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/GetStream/getstream-go/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHTTP struct {
+	status int
+}
+
+func (f *fakeHTTP) Do(r *http.Request) (*http.Response, error) {
+	h := http.Header{}
+	h.Set(getstream.HeaderRateLimit, "100")
+	h.Set(getstream.HeaderRateRemaining, "60")
+	h.Set(getstream.HeaderRateReset, "1")
+	return &http.Response{StatusCode: f.status, Header: h, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+func TestRecordsRequests(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m, err := New(reg)
+	require.NoError(t, err)
+
+	client, err := getstream.NewClient("key", "secret",
+		getstream.WithHTTPClient(&fakeHTTP{status: 200}), getstream.WithLogger(nopLogger{}), getstream.WithMetrics(m))
+	require.NoError(t, err)
+
+	for _, id := range []string{"a", "b"} {
+		_, err = client.Chat().GetChannel(context.Background(), "messaging", id, &getstream.GetChannelRequest{})
+		require.NoError(t, err)
+	}
+
+	op := "GET /api/v2/chat/channels/{type}/{id}"
+	require.Equal(t, 2.0, testutil.ToFloat64(m.requests.WithLabelValues(op, "2xx", "200", "")))
+	require.Equal(t, 60.0, testutil.ToFloat64(m.rateLimitRemaining.WithLabelValues(op)))
+	require.Equal(t, 100.0, testutil.ToFloat64(m.rateLimitLimit.WithLabelValues(op)))
+	require.Equal(t, 1, testutil.CollectAndCount(m.requests), "expanded channel IDs must not become label values")
+
+	m.RecordRetry(op, 1, 0)
+	require.Equal(t, 1.0, testutil.ToFloat64(m.retries.WithLabelValues(op)))
+}
+
+func TestNamespaceAndDoubleRegistration(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	_, err := New(reg, WithNamespace("chat_sdk"))
+	require.NoError(t, err)
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	require.Empty(t, families, "no series before the first request")
+
+	_, err = New(reg, WithNamespace("chat_sdk"))
+	require.Error(t, err)
+}
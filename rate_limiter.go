@@ -0,0 +1,145 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimiterConfig is the opt-in client-side throttle. Disabled by default:
+// the client only learns about rate limits from HTTP 429. When enabled, the
+// X-Ratelimit-* headers of every response are tracked per endpoint (method +
+// path template, e.g. "POST /api/v2/chat/channels") and requests are held
+// back before the window is exhausted instead of tripping ErrRateLimited on
+// the server.
+type RateLimiterConfig struct {
+	// Enabled turns the throttle on. Default false.
+	Enabled bool
+	// Reserve is the number of calls per window the client leaves unused,
+	// e.g. for other processes sharing the same app. Requests start waiting
+	// once Remaining drops to Reserve. Default 0.
+	Reserve int64
+	// MaxWait caps how long a request may be held. A request that would have
+	// to wait longer fails immediately with ErrRateLimited and RetryAfter set
+	// to the time until the window resets. Zero waits for the reset.
+	MaxWait time.Duration
+}
+
+// WithRateLimiter enables the opt-in client-side throttle. Requests are also
+// rejected without waiting when ctx's deadline falls before the window resets.
+func WithRateLimiter(cfg RateLimiterConfig) ClientOption {
+	return func(c *Client) {
+		if !cfg.Enabled {
+			c.rateLimiter = nil
+			return
+		}
+		c.rateLimiter = newRateLimiter(cfg)
+	}
+}
+
+// rateLimiter tracks the last reported window per endpoint and hands out the
+// remaining quota optimistically until the next response refreshes it.
+type rateLimiter struct {
+	cfg RateLimiterConfig
+	now func() time.Time
+
+	mu      sync.Mutex
+	windows map[string]*RateLimitInfo
+}
+
+func newRateLimiter(cfg RateLimiterConfig) *rateLimiter {
+	return &rateLimiter{
+		cfg:     cfg,
+		now:     time.Now,
+		windows: map[string]*RateLimitInfo{},
+	}
+}
+
+// observe records the window reported by a response. Responses without
+// rate-limit headers leave the tracked window untouched.
+func (l *rateLimiter) observe(key string, info *RateLimitInfo) {
+	if info == nil || info.Limit <= 0 || info.Reset <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	w := *info
+	l.windows[key] = &w
+}
+
+// reserve claims one call from the endpoint's window. It returns zero when
+// the request may go ahead, otherwise the wait until the window resets along
+// with a snapshot of the exhausted window.
+func (l *rateLimiter) reserve(key string) (time.Duration, RateLimitInfo) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w, ok := l.windows[key]
+	if !ok {
+		return 0, RateLimitInfo{}
+	}
+	now := l.now()
+	resetAt := time.Unix(w.Reset, 0)
+	if !now.Before(resetAt) {
+		delete(l.windows, key)
+		return 0, RateLimitInfo{}
+	}
+	if w.Remaining > l.cfg.Reserve {
+		w.Remaining--
+		return 0, RateLimitInfo{}
+	}
+	return resetAt.Sub(now), *w
+}
+
+// throttle blocks until the endpoint has quota left, returning a
+// *StreamError with ErrRateLimited when the wait would exceed MaxWait or
+// ctx's deadline, and an ErrTransport error if ctx ends while waiting.
+func (c *Client) throttle(ctx context.Context, op *Operation) error {
+	if c.rateLimiter == nil {
+		return nil
+	}
+	method, path, key := op.Method, op.Path, op.Name()
+	for {
+		wait, window := c.rateLimiter.reserve(key)
+		if wait <= 0 {
+			return nil
+		}
+		if c.rateLimiter.cfg.MaxWait > 0 && wait > c.rateLimiter.cfg.MaxWait {
+			return clientRateLimitedError(method, path, wait, window)
+		}
+		if deadline, ok := ctx.Deadline(); ok && deadline.Sub(c.rateLimiter.now()) < wait {
+			return clientRateLimitedError(method, path, wait, window)
+		}
+
+		c.logEvent(ctx, LogLevelDebug, "http.request.throttled",
+			LogField{"http.request.method", method},
+			LogField{"url.path", path},
+			LogField{"ratelimit.limit", window.Limit},
+			LogField{"ratelimit.remaining", window.Remaining},
+			LogField{"wait_ms", wait.Milliseconds()},
+		)
+		select {
+		case <-ctx.Done():
+			return wrapTransportError(ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+}
+
+// clientRateLimitedError constructs the *StreamError surfaced when the
+// client-side throttle refuses to send a request. It is unrecoverable: the
+// throttle already decided the window cannot be waited out, so retrying with
+// backoff would only delay the same answer.
+func clientRateLimitedError(method, path string, wait time.Duration, window RateLimitInfo) *StreamError {
+	msg := fmt.Sprintf("stream: client-side rate limit reached for %s %s, window resets in %s", method, path, wait.Round(time.Millisecond))
+	return &StreamError{
+		sentinel:      ErrRateLimited,
+		Message:       msg,
+		Unrecoverable: true,
+		RetryAfter:    wait,
+		RateLimit:     &window,
+		cause:         stackWrap(errors.New(msg), "rate limiter"),
+	}
+}
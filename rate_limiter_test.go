@@ -0,0 +1,134 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// windowClient answers every request with 200 and the given rate-limit
+// window headers.
+type windowClient struct {
+	limit, remaining int64
+	reset            time.Time
+	calls            int
+}
+
+func (s *windowClient) Do(r *http.Request) (*http.Response, error) {
+	s.calls++
+	h := http.Header{}
+	h.Set(HeaderRateLimit, strconv.FormatInt(s.limit, 10))
+	h.Set(HeaderRateRemaining, strconv.FormatInt(s.remaining, 10))
+	h.Set(HeaderRateReset, strconv.FormatInt(s.reset.Unix(), 10))
+	return &http.Response{StatusCode: http.StatusOK, Header: h, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+}
+
+func throttledGET(c *Client, ctx context.Context, path string) error {
+	var out map[string]any
+	_, err := MakeRequest[any, map[string]any](c, ctx, http.MethodGet, path, nil, nil, &out, nil)
+	return err
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	fake := &windowClient{limit: 10, remaining: 0, reset: time.Now().Add(time.Hour)}
+	c, err := newClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, throttledGET(c, context.Background(), "/api/v2/app"))
+	}
+	require.Equal(t, 3, fake.calls)
+}
+
+func TestRateLimiter_RejectsBeyondMaxWait(t *testing.T) {
+	fake := &windowClient{limit: 10, remaining: 1, reset: time.Now().Add(time.Hour)}
+	c, err := newClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}),
+		WithRateLimiter(RateLimiterConfig{Enabled: true, MaxWait: time.Second}))
+	require.NoError(t, err)
+
+	// The first call learns the window; the second spends the last remaining call.
+	require.NoError(t, throttledGET(c, context.Background(), "/api/v2/app"))
+	fake.remaining = 0
+	require.NoError(t, throttledGET(c, context.Background(), "/api/v2/app"))
+
+	err = throttledGET(c, context.Background(), "/api/v2/app")
+	require.True(t, errors.Is(err, ErrRateLimited))
+	var se *StreamError
+	require.True(t, errors.As(err, &se))
+	require.Greater(t, se.RetryAfter, 59*time.Minute)
+	require.Equal(t, int64(10), se.RateLimit.Limit)
+	require.Equal(t, 2, fake.calls, "a rejected request must never reach the transport")
+
+	// Other endpoints keep their own window.
+	require.NoError(t, throttledGET(c, context.Background(), "/api/v2/chat/channeltypes"))
+}
+
+func TestRateLimiter_RejectionNotRetried(t *testing.T) {
+	fake := &windowClient{limit: 10, remaining: 0, reset: time.Now().Add(time.Hour)}
+	c, err := newClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}),
+		WithRateLimiter(RateLimiterConfig{Enabled: true, MaxWait: time.Millisecond}),
+		WithRetry(RetryConfig{Enabled: true, MaxAttempts: 5, MaxBackoff: 100 * time.Millisecond}))
+	require.NoError(t, err)
+	require.NoError(t, throttledGET(c, context.Background(), "/api/v2/app"))
+
+	start := time.Now()
+	err = throttledGET(c, context.Background(), "/api/v2/app")
+	require.True(t, errors.Is(err, ErrRateLimited))
+	var se *StreamError
+	require.True(t, errors.As(err, &se))
+	require.True(t, se.Unrecoverable)
+	require.Less(t, time.Since(start), 50*time.Millisecond, "a client-side rejection must not be retried with backoff")
+	require.Equal(t, 1, fake.calls)
+}
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	fake := &windowClient{limit: 10, remaining: 2, reset: time.Now().Add(time.Hour)}
+	c, err := newClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}),
+		WithRateLimiter(RateLimiterConfig{Enabled: true, Reserve: 2, MaxWait: time.Second}))
+	require.NoError(t, err)
+
+	require.NoError(t, throttledGET(c, context.Background(), "/api/v2/app"))
+	require.True(t, errors.Is(throttledGET(c, context.Background(), "/api/v2/app"), ErrRateLimited))
+}
+
+func TestRateLimiter_RejectsWhenDeadlineBeforeReset(t *testing.T) {
+	fake := &windowClient{limit: 10, remaining: 0, reset: time.Now().Add(time.Hour)}
+	c, err := newClient("k", "s", WithHTTPClient(fake), WithLogger(&recordingLogger{}),
+		WithRateLimiter(RateLimiterConfig{Enabled: true}))
+	require.NoError(t, err)
+	require.NoError(t, throttledGET(c, context.Background(), "/api/v2/app"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	start := time.Now()
+	err = throttledGET(c, ctx, "/api/v2/app")
+	require.True(t, errors.Is(err, ErrRateLimited))
+	require.Less(t, time.Since(start), 500*time.Millisecond, "must fail fast instead of waiting out ctx")
+}
+
+func TestRateLimiter_WaitsForReset(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+	fake := &windowClient{limit: 10, remaining: 0, reset: reset}
+	rec := &recordingLogger{}
+	c, err := newClient("k", "s", WithHTTPClient(fake), WithLogger(rec),
+		WithRateLimiter(RateLimiterConfig{Enabled: true}))
+	require.NoError(t, err)
+
+	// Shift the limiter's clock so the window resets 50ms from now.
+	offset := reset.Add(-50 * time.Millisecond).Sub(time.Now())
+	c.rateLimiter.now = func() time.Time { return time.Now().Add(offset) }
+
+	require.NoError(t, throttledGET(c, context.Background(), "/api/v2/app"))
+	start := time.Now()
+	require.NoError(t, throttledGET(c, context.Background(), "/api/v2/app"))
+	require.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+	require.Equal(t, 2, fake.calls)
+	require.True(t, has(rec.debug, "http.request.throttled"))
+}
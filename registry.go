@@ -0,0 +1,265 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultRegistryIdleTimeout = 30 * time.Minute
+
+// AppCredentials describe one Stream app served by a Registry.
+type AppCredentials struct {
+	APIKey    string
+	APISecret string
+	// SecondarySecrets are passed to WithSecondarySecrets.
+	SecondarySecrets []string
+	// BaseURL overrides the base URL for this app. Empty keeps the default
+	// (or whatever RegistryConfig.Options set).
+	BaseURL string
+	// Options are applied after RegistryConfig.Options.
+	Options []ClientOption
+}
+
+// CredentialsProvider looks up the credentials of a tenant's app, e.g. in a
+// database or secret store. It is called once per tenant until the tenant's
+// client is evicted. Return an error to fail Registry.Client.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context, tenant string) (AppCredentials, error)
+}
+
+// CredentialsProviderFunc adapts a function to CredentialsProvider.
+type CredentialsProviderFunc func(ctx context.Context, tenant string) (AppCredentials, error)
+
+// Credentials calls f(ctx, tenant).
+func (f CredentialsProviderFunc) Credentials(ctx context.Context, tenant string) (AppCredentials, error) {
+	return f(ctx, tenant)
+}
+
+// RegistryConfig configures a Registry.
+type RegistryConfig struct {
+	// Provider supplies each tenant's credentials. Required.
+	Provider CredentialsProvider
+	// Options are applied to every client, e.g. a shared logger, retry
+	// policy or metrics.
+	Options []ClientOption
+	// IdleTimeout evicts clients that have not been requested for this
+	// long. Default 30m. Negative disables eviction.
+	IdleTimeout time.Duration
+}
+
+// Registry builds and caches one *Stream per tenant for backends that serve
+// many Stream apps from one process. Clients are built lazily on first use
+// from the CredentialsProvider, and clients with the same base URL and
+// transport settings (WithMaxConnsPerHost, WithIdleTimeout,
+// WithConnectTimeout, WithRequestTimeout) share one connection pool. Note
+// that the MaxConnsPerHost cap then applies to all of them together; raise
+// it in RegistryConfig.Options for many busy tenants. Clients that bring
+// their own WithHTTPClient keep it.
+//
+// A Registry is safe for concurrent use.
+type Registry struct {
+	cfg RegistryConfig
+	now func() time.Time
+
+	mu        sync.Mutex
+	clients   map[string]*registryEntry
+	pools     map[registryPoolKey]*registryPool
+	lastSweep time.Time
+}
+
+type registryEntry struct {
+	ready    chan struct{}
+	client   *Stream
+	err      error
+	pool     *registryPool
+	lastUsed time.Time
+}
+
+// registryPoolKey identifies clients that can share a transport.
+type registryPoolKey struct {
+	baseURL         string
+	requestTimeout  time.Duration
+	maxConnsPerHost int
+	idleTimeout     time.Duration
+	connectTimeout  time.Duration
+}
+
+type registryPool struct {
+	key    registryPoolKey
+	client *http.Client
+	refs   int
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry(cfg RegistryConfig) (*Registry, error) {
+	if cfg.Provider == nil {
+		return nil, errors.New("registry: credentials provider is required")
+	}
+	if cfg.IdleTimeout == 0 {
+		cfg.IdleTimeout = defaultRegistryIdleTimeout
+	}
+	return &Registry{
+		cfg:     cfg,
+		now:     time.Now,
+		clients: map[string]*registryEntry{},
+		pools:   map[registryPoolKey]*registryPool{},
+	}, nil
+}
+
+// Client returns the tenant's client, building it on first use. Concurrent
+// first calls for the same tenant share one build. A failed build is not
+// cached.
+func (r *Registry) Client(ctx context.Context, tenant string) (*Stream, error) {
+	r.mu.Lock()
+	now := r.now()
+	r.sweepLocked(now)
+	e, ok := r.clients[tenant]
+	if ok {
+		e.lastUsed = now
+		r.mu.Unlock()
+		select {
+		case <-e.ready:
+			return e.client, e.err
+		case <-ctx.Done():
+			return nil, wrapTransportError(ctx.Err())
+		}
+	}
+	e = &registryEntry{ready: make(chan struct{}), lastUsed: now}
+	r.clients[tenant] = e
+	r.mu.Unlock()
+
+	client, pool, err := r.build(ctx, tenant)
+
+	r.mu.Lock()
+	e.client, e.err, e.pool = client, err, pool
+	if err != nil && r.clients[tenant] == e {
+		delete(r.clients, tenant)
+	}
+	r.mu.Unlock()
+	close(e.ready)
+	return client, err
+}
+
+func (r *Registry) build(ctx context.Context, tenant string) (*Stream, *registryPool, error) {
+	creds, err := r.cfg.Provider.Credentials(ctx, tenant)
+	if err != nil {
+		return nil, nil, fmt.Errorf("registry: credentials for tenant %q: %w", tenant, err)
+	}
+
+	opts := make([]ClientOption, 0, len(r.cfg.Options)+len(creds.Options)+3)
+	opts = append(opts, r.cfg.Options...)
+	if creds.BaseURL != "" {
+		opts = append(opts, WithBaseUrl(creds.BaseURL))
+	}
+	if len(creds.SecondarySecrets) > 0 {
+		opts = append(opts, WithSecondarySecrets(creds.SecondarySecrets...))
+	}
+	opts = append(opts, creds.Options...)
+	// Runs last, once the base URL and transport settings are final.
+	var pool *registryPool
+	opts = append(opts, func(c *Client) {
+		if c.httpClient == nil {
+			pool = r.acquirePool(c)
+			c.httpClient = pool.client
+		}
+	})
+
+	client, err := NewClient(creds.APIKey, creds.APISecret, opts...)
+	if err != nil {
+		if pool != nil {
+			r.releasePool(pool)
+		}
+		return nil, nil, fmt.Errorf("registry: client for tenant %q: %w", tenant, err)
+	}
+	return client, pool, nil
+}
+
+func (r *Registry) acquirePool(c *Client) *registryPool {
+	key := registryPoolKey{
+		baseURL:         c.BaseUrl(),
+		requestTimeout:  c.defaultTimeout,
+		maxConnsPerHost: c.maxConnsPerHost,
+		idleTimeout:     c.idleTimeout,
+		connectTimeout:  c.connectTimeout,
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.pools[key]
+	if !ok {
+		p = &registryPool{
+			key:    key,
+			client: buildDefaultHTTPClient(key.requestTimeout, key.maxConnsPerHost, key.idleTimeout, key.connectTimeout),
+		}
+		r.pools[key] = p
+	}
+	p.refs++
+	return p
+}
+
+func (r *Registry) releasePool(p *registryPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.releasePoolLocked(p)
+}
+
+// releasePoolLocked drops a reference to p and closes its idle connections
+// once no cached client uses it.
+func (r *Registry) releasePoolLocked(p *registryPool) {
+	p.refs--
+	if p.refs > 0 {
+		return
+	}
+	delete(r.pools, p.key)
+	p.client.CloseIdleConnections()
+}
+
+// Evict drops the tenant's cached client, e.g. after its credentials
+// changed. The next Client call rebuilds it. Callers still holding the old
+// client may keep using it.
+func (r *Registry) Evict(tenant string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.evictLocked(tenant)
+}
+
+func (r *Registry) evictLocked(tenant string) {
+	e, ok := r.clients[tenant]
+	if !ok {
+		return
+	}
+	select {
+	case <-e.ready:
+	default:
+		// Still building: leave it to finish; it is evicted once idle.
+		return
+	}
+	delete(r.clients, tenant)
+	if e.pool != nil {
+		r.releasePoolLocked(e.pool)
+	}
+}
+
+// Len returns the number of cached clients.
+func (r *Registry) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.clients)
+}
+
+// sweepLocked evicts idle clients, at most once per half IdleTimeout.
+func (r *Registry) sweepLocked(now time.Time) {
+	idle := r.cfg.IdleTimeout
+	if idle < 0 || now.Sub(r.lastSweep) < idle/2 {
+		return
+	}
+	r.lastSweep = now
+	for tenant, e := range r.clients {
+		if now.Sub(e.lastUsed) >= idle {
+			r.evictLocked(tenant)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countingProvider hands out credentials per tenant and counts lookups.
+type countingProvider struct {
+	calls int32
+	creds map[string]AppCredentials
+}
+
+func (p *countingProvider) Credentials(_ context.Context, tenant string) (AppCredentials, error) {
+	atomic.AddInt32(&p.calls, 1)
+	creds, ok := p.creds[tenant]
+	if !ok {
+		return AppCredentials{}, errors.New("unknown tenant")
+	}
+	return creds, nil
+}
+
+func newTestRegistry(t *testing.T, p CredentialsProvider, idle time.Duration) *Registry {
+	t.Helper()
+	r, err := NewRegistry(RegistryConfig{
+		Provider:    p,
+		Options:     []ClientOption{WithLogger(NewDefaultLogger(io.Discard, "", 0, LogLevelDebug))},
+		IdleTimeout: idle,
+	})
+	require.NoError(t, err)
+	return r
+}
+
+func TestRegistry_CachesClientsAndSharesPools(t *testing.T) {
+	p := &countingProvider{creds: map[string]AppCredentials{
+		"acme":   {APIKey: "k1", APISecret: "s1"},
+		"globex": {APIKey: "k2", APISecret: "s2", SecondarySecrets: []string{"old"}},
+		"eu":     {APIKey: "k3", APISecret: "s3", BaseURL: "https://chat.eu.example.com"},
+	}}
+	r := newTestRegistry(t, p, 0)
+	ctx := context.Background()
+
+	acme, err := r.Client(ctx, "acme")
+	require.NoError(t, err)
+	again, err := r.Client(ctx, "acme")
+	require.NoError(t, err)
+	require.Same(t, acme, again)
+	require.Equal(t, int32(1), atomic.LoadInt32(&p.calls))
+	require.Equal(t, "k1", acme.apiKey)
+
+	globex, err := r.Client(ctx, "globex")
+	require.NoError(t, err)
+	require.Len(t, globex.secondarySecrets, 1)
+	require.Same(t, acme.httpClient, globex.httpClient, "same base URL must share the connection pool")
+	require.False(t, globex.httpClientFromUser)
+
+	eu, err := r.Client(ctx, "eu")
+	require.NoError(t, err)
+	require.Equal(t, "https://chat.eu.example.com", eu.BaseUrl())
+	require.NotSame(t, acme.httpClient, eu.httpClient)
+	require.Equal(t, 3, r.Len())
+}
+
+func TestRegistry_KeepsOwnHTTPClient(t *testing.T) {
+	own := &http.Client{}
+	p := &countingProvider{creds: map[string]AppCredentials{
+		"acme": {APIKey: "k1", APISecret: "s1", Options: []ClientOption{WithHTTPClient(own)}},
+	}}
+	r := newTestRegistry(t, p, 0)
+	c, err := r.Client(context.Background(), "acme")
+	require.NoError(t, err)
+	require.Same(t, own, c.httpClient)
+}
+
+func TestRegistry_FailedBuildIsNotCached(t *testing.T) {
+	p := &countingProvider{creds: map[string]AppCredentials{}}
+	r := newTestRegistry(t, p, 0)
+
+	_, err := r.Client(context.Background(), "nobody")
+	require.Error(t, err)
+	_, err = r.Client(context.Background(), "nobody")
+	require.Error(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&p.calls))
+	require.Equal(t, 0, r.Len())
+
+	p.creds["blank"] = AppCredentials{APIKey: "k"}
+	_, err = r.Client(context.Background(), "blank")
+	require.Error(t, err, "an empty secret must fail the build")
+	require.Empty(t, r.pools, "a failed build must release its pool")
+}
+
+func TestRegistry_ConcurrentFirstCallsShareOneBuild(t *testing.T) {
+	p := &countingProvider{creds: map[string]AppCredentials{"acme": {APIKey: "k1", APISecret: "s1"}}}
+	r := newTestRegistry(t, p, 0)
+
+	var wg sync.WaitGroup
+	clients := make([]*Stream, 20)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c, err := r.Client(context.Background(), "acme")
+			require.NoError(t, err)
+			clients[i] = c
+		}(i)
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), atomic.LoadInt32(&p.calls))
+	for _, c := range clients {
+		require.Same(t, clients[0], c)
+	}
+}
+
+func TestRegistry_EvictsIdleClients(t *testing.T) {
+	p := &countingProvider{creds: map[string]AppCredentials{
+		"acme":   {APIKey: "k1", APISecret: "s1"},
+		"globex": {APIKey: "k2", APISecret: "s2"},
+	}}
+	r := newTestRegistry(t, p, time.Minute)
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	acme, err := r.Client(ctx, "acme")
+	require.NoError(t, err)
+	_, err = r.Client(ctx, "globex")
+	require.NoError(t, err)
+
+	now = now.Add(40 * time.Second)
+	_, err = r.Client(ctx, "globex") // keeps globex fresh
+	require.NoError(t, err)
+
+	now = now.Add(40 * time.Second)
+	_, err = r.Client(ctx, "globex")
+	require.NoError(t, err)
+	require.Equal(t, 1, r.Len(), "acme has been idle for over a minute")
+	require.Len(t, r.pools, 1)
+
+	rebuilt, err := r.Client(ctx, "acme")
+	require.NoError(t, err)
+	require.NotSame(t, acme, rebuilt)
+	require.Equal(t, int32(3), atomic.LoadInt32(&p.calls))
+
+	r.Evict("acme")
+	r.Evict("globex")
+	require.Equal(t, 0, r.Len())
+	require.Empty(t, r.pools)
+}
+
+func TestNewRegistry_RequiresProvider(t *testing.T) {
+	_, err := NewRegistry(RegistryConfig{})
+	require.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader is the header carrying a call's request ID. The SDK sends
+// the call's correlation ID in it on every attempt, and reads the server's
+// request ID from the response header of the same name.
+const RequestIDHeader = "X-Request-Id"
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a copy of ctx that makes the API calls made
+// with it send id as their X-Request-Id, e.g. to tie them to the inbound
+// request being served. Calls without one get a new UUID per call; all
+// attempts of a call share its ID.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID set with ContextWithRequestID.
+// Within a call, e.g. in an Interceptor or a StructuredLogger, it returns the
+// call's ID, generated or not.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// withRequestID returns ctx carrying the correlation ID of the call about to
+// be made: the one from ctx, from a RequestHeader(RequestIDHeader, ...)
+// option, or a new one.
+func withRequestID(ctx context.Context) (context.Context, string) {
+	if id, ok := RequestIDFromContext(ctx); ok {
+		return ctx, id
+	}
+	id := requestOptionsFromContext(ctx).header.Get(RequestIDHeader)
+	if id == "" {
+		id = uuid.NewString()
+	}
+	return ContextWithRequestID(ctx, id), id
+}
+
+// stampRequestID records the call's correlation ID on its result and error
+// where the server reported no request ID of its own.
+func stampRequestID[GResponse any](res *StreamResponse[GResponse], err error, id string) {
+	if res != nil && res.RequestID == "" {
+		res.RequestID = id
+	}
+	var se *StreamError
+	if errors.As(err, &se) && se.RequestID == "" {
+		se.RequestID = id
+	}
+}
@@ -0,0 +1,91 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestID_GeneratedPerCallAndLogged(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	rec := &recordingStructuredLogger{}
+	c, err := newClient("key", "secret", WithHTTPClient(fake), WithRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond}), WithStructuredLogger(rec))
+	require.NoError(t, err)
+
+	var out map[string]any
+	resp, err := MakeRequest[any, map[string]any](c, context.Background(), http.MethodGet, "/api/v2/x", url.Values{}, nil, &out, nil)
+	require.NoError(t, err)
+
+	require.Len(t, fake.headers, 2)
+	id := fake.headers[0].Get(RequestIDHeader)
+	_, err = uuid.Parse(id)
+	require.NoError(t, err)
+	require.Equal(t, id, fake.headers[1].Get(RequestIDHeader), "retries keep the call's ID")
+	require.Equal(t, id, resp.RequestID, "no server request ID, so the sent one")
+
+	var requestEvents int
+	for _, ev := range rec.events {
+		if ev.event == "client.initialized" {
+			require.NotContains(t, ev.fields, "stream.request_id")
+			continue
+		}
+		requestEvents++
+		require.Equal(t, id, ev.fields["stream.request_id"], ev.event)
+	}
+	require.Equal(t, 4, requestEvents, "sent, retry scheduled, sent, received")
+
+	// The next call gets a new ID.
+	fake.headers = nil
+	fake.responses = append(fake.responses, canned(200, `{}`, nil))
+	_, err = MakeRequest[any, map[string]any](c, context.Background(), http.MethodGet, "/api/v2/x", url.Values{}, nil, &out, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, id, fake.headers[0].Get(RequestIDHeader))
+}
+
+func TestRequestID_FromContextAndServer(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: []func() (*http.Response, error){
+		canned(200, `{}`, map[string]string{RequestIDHeader: "srv-1"}),
+		canned(400, `{"code":4,"message":"bad"}`, map[string]string{RequestIDHeader: "srv-2"}),
+		canned(500, `{"code":-1,"message":"boom"}`, nil),
+		func() (*http.Response, error) { return nil, syscall.ECONNRESET },
+	}}}
+	c := newRetryTestClient(t, fake, nil)
+	ctx := ContextWithRequestID(context.Background(), "job-42")
+	var out map[string]any
+	call := func() (*StreamResponse[map[string]any], error) {
+		return MakeRequest[any, map[string]any](c, ctx, http.MethodGet, "/api/v2/x", url.Values{}, nil, &out, nil)
+	}
+
+	resp, err := call()
+	require.NoError(t, err)
+	require.Equal(t, "srv-1", resp.RequestID)
+	require.Equal(t, "job-42", fake.headers[0].Get(RequestIDHeader))
+
+	var se *StreamError
+	_, err = call()
+	require.True(t, errors.As(err, &se))
+	require.Equal(t, "srv-2", se.RequestID)
+
+	_, err = call()
+	require.True(t, errors.As(err, &se))
+	require.Equal(t, "job-42", se.RequestID)
+
+	_, err = call()
+	require.True(t, errors.Is(err, ErrTransport))
+	require.True(t, errors.As(err, &se))
+	require.Equal(t, "job-42", se.RequestID)
+
+	// A RequestHeader option sets the ID too.
+	fake.responses = append(fake.responses, canned(200, `{}`, nil))
+	resp, err = MakeRequest[any, map[string]any](c, context.Background(), http.MethodGet, "/api/v2/x", url.Values{}, nil, &out, nil, RequestHeader(RequestIDHeader, "hdr-7"))
+	require.NoError(t, err)
+	require.Equal(t, "hdr-7", resp.RequestID)
+	require.Equal(t, "hdr-7", fake.headers[4].Get(RequestIDHeader))
+}
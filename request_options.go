@@ -0,0 +1,149 @@
+package getstream
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RequestOption customizes a single API call without changing the client:
+// extra headers, a timeout, a retry policy, an idempotency key or the token
+// the call is authenticated with. Every generated method takes options as
+// trailing arguments:
+//
+//	resp, err := client.Chat().SendMessage(ctx, "messaging", "general", req,
+//		getstream.RequestTimeout(2*time.Second),
+//		getstream.RequestActingUser("john"),
+//	)
+//
+// ContextWithRequestOptions attaches options to all the calls made with a
+// context; options passed to a method apply on top of those.
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	header         http.Header
+	timeout        time.Duration
+	retry          *RetryConfig
+	idempotencyKey string
+	authToken      string
+	actingUserID   string
+}
+
+// RequestHeader sets header key to value on every attempt of the call. It
+// overrides SDK-managed headers of the same name.
+func RequestHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.header == nil {
+			o.header = http.Header{}
+		}
+		o.header.Set(key, value)
+	}
+}
+
+// RequestTimeout bounds the whole call, including retries and the waits
+// between them. It applies on top of any deadline ctx already has and of the
+// client's per-attempt WithRequestTimeout. Values <= 0 are ignored.
+func RequestTimeout(d time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		if d > 0 {
+			o.timeout = d
+		}
+	}
+}
+
+// RequestRetry replaces the client's RetryConfig for the call. Zero values
+// for MaxAttempts/MaxBackoff fall back to the defaults as for WithRetry;
+// RetryConfig{} disables retries for the call.
+func RequestRetry(cfg RetryConfig) RequestOption {
+	return func(o *requestOptions) {
+		cfg = cfg.withDefaults()
+		o.retry = &cfg
+	}
+}
+
+// RequestIdempotencyKey sends key as the call's Idempotency-Key header on
+// every attempt. Pass it to one call; a context carrying it would send the same
+// key with every write made with that context.
+func RequestIdempotencyKey(key string) RequestOption {
+	return func(o *requestOptions) {
+		o.idempotencyKey = key
+	}
+}
+
+// RequestAuthToken authenticates the call with token (a JWT, e.g. one made
+// with Stream.CreateToken) instead of the client's server token.
+func RequestAuthToken(token string) RequestOption {
+	return func(o *requestOptions) {
+		o.authToken = token
+		o.actingUserID = ""
+	}
+}
+
+// RequestActingUser authenticates the call with a user token for userID,
+// signed with the client's secret, so the backend applies that user's
+// permissions instead of server-side access.
+func RequestActingUser(userID string) RequestOption {
+	return func(o *requestOptions) {
+		o.actingUserID = userID
+		o.authToken = ""
+	}
+}
+
+type requestOptionsKey struct{}
+
+// ContextWithRequestOptions returns a copy of ctx that applies opts to the
+// API calls made with it. Options accumulate across nested calls; later ones
+// win.
+func ContextWithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	o := requestOptionsFromContext(ctx)
+	if o.header != nil {
+		o.header = o.header.Clone()
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return context.WithValue(ctx, requestOptionsKey{}, o)
+}
+
+func requestOptionsFromContext(ctx context.Context) requestOptions {
+	if ctx == nil {
+		return requestOptions{}
+	}
+	o, _ := ctx.Value(requestOptionsKey{}).(requestOptions)
+	return o
+}
+
+// shared reports whether the call's response may be shared with other
+// callers through the response cache or request coalescing. Calls with their
+// own credentials or headers may see a different response.
+func (o requestOptions) shared() bool {
+	return o.header == nil && o.authToken == "" && o.actingUserID == ""
+}
+
+// retryPolicy returns the RetryConfig for the call made with ctx.
+func (c *Client) retryPolicy(ctx context.Context) RetryConfig {
+	if o := requestOptionsFromContext(ctx); o.retry != nil {
+		return *o.retry
+	}
+	return c.retry
+}
+
+// requestHeader returns the headers and credentials the call sends on top
+// of the SDK-managed ones. An acting-user token is signed once per call.
+func (c *Client) requestHeader(o requestOptions) (http.Header, error) {
+	h := o.header.Clone()
+	token := o.authToken
+	if o.actingUserID != "" {
+		var err error
+		if token, err = c.createToken(o.actingUserID, nil, nil); err != nil {
+			return nil, stackWrap(err, "failed to create acting user token")
+		}
+	}
+	if token != "" {
+		if h == nil {
+			h = http.Header{}
+		}
+		h.Set("Authorization", token)
+	}
+	return h, nil
+}
@@ -0,0 +1,163 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestRequestOptions_HeadersAndAuthTokenOnEveryAttempt(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c := newRetryTestClient(t, fake, &RetryConfig{Enabled: true, MaxBackoff: time.Millisecond})
+
+	ctx := ContextWithRequestOptions(context.Background(), RequestHeader("X-Tenant", "acme"), RequestAuthToken("user-jwt"))
+	var out map[string]any
+	if _, err := MakeRequest[any, map[string]any](c, ctx, http.MethodGet, "/api/v2/x", url.Values{}, nil, &out, nil); err != nil {
+		t.Fatalf("want success, got %v", err)
+	}
+	if len(fake.headers) != 2 {
+		t.Fatalf("want 2 attempts, got %d", len(fake.headers))
+	}
+	for i, h := range fake.headers {
+		if h.Get("X-Tenant") != "acme" || h.Get("Authorization") != "user-jwt" {
+			t.Fatalf("attempt %d: want per-call headers, got %v", i, h)
+		}
+		if h.Get("X-Stream-Client") == "" {
+			t.Fatalf("attempt %d: SDK headers must still be set", i)
+		}
+	}
+}
+
+func TestRequestOptions_ActingUserSignsUserToken(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()[1:]}}
+	c := newRetryTestClient(t, fake, nil)
+
+	var out map[string]any
+	if _, err := MakeRequest[any, map[string]any](c, context.Background(), http.MethodGet, "/api/v2/x", url.Values{}, nil, &out, nil, RequestActingUser("john")); err != nil {
+		t.Fatal(err)
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(fake.headers[0].Get("Authorization"), claims, func(*jwt.Token) (any, error) {
+		return []byte("secret"), nil
+	}); err != nil {
+		t.Fatalf("want a token signed with the client secret, got %v", err)
+	}
+	if claims["user_id"] != "john" {
+		t.Fatalf("want user_id john, got %v", claims["user_id"])
+	}
+}
+
+func TestRequestOptions_RetryOverride(t *testing.T) {
+	// Client retries are off; the per-call policy turns them on.
+	script := &scriptedRetryClient{responses: resetThenOK()}
+	c := newRetryTestClient(t, script, nil)
+	ctx := ContextWithRequestOptions(context.Background(), RequestRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond}))
+	var out map[string]any
+	if _, err := MakeRequest[any, map[string]any](c, ctx, http.MethodGet, "/api/v2/x", url.Values{}, nil, &out, nil); err != nil {
+		t.Fatalf("want success after retry, got %v", err)
+	}
+	if script.calls != 2 {
+		t.Fatalf("want 2 calls, got %d", script.calls)
+	}
+
+	// And the other way round: RetryConfig{} disables retries for one call.
+	script = &scriptedRetryClient{responses: resetThenOK()}
+	c = newRetryTestClient(t, script, &RetryConfig{Enabled: true, MaxBackoff: time.Millisecond})
+	_, err := MakeRequest[any, map[string]any](c, context.Background(), http.MethodGet, "/api/v2/x", url.Values{}, nil, &out, nil, RequestRetry(RetryConfig{}))
+	if !errors.Is(err, ErrTransport) || script.calls != 1 {
+		t.Fatalf("want a single failed call, got calls=%d err=%v", script.calls, err)
+	}
+}
+
+func TestRequestOptions_IdempotencyKey(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: resetThenOK()}}
+	c := newRetryTestClient(t, fake, &RetryConfig{Enabled: true, MaxBackoff: time.Millisecond, IdempotencyKeyOperations: []string{"POST /api/v2/x"}})
+
+	var out map[string]any
+	if _, err := MakeRequest[any, map[string]any](c, context.Background(), http.MethodPost, "/api/v2/x", url.Values{}, nil, &out, nil, RequestIdempotencyKey("k-1")); err != nil {
+		t.Fatalf("keyed write must be retried, got %v", err)
+	}
+	if len(fake.keys()) != 2 || fake.keys()[0] != "k-1" || fake.keys()[1] != "k-1" {
+		t.Fatalf("want k-1 on both attempts, got %v", fake.keys())
+	}
+}
+
+// blockingClient fails once ctx is done, like a real transport would.
+type blockingClient struct{}
+
+func (blockingClient) Do(r *http.Request) (*http.Response, error) {
+	<-r.Context().Done()
+	return nil, r.Context().Err()
+}
+
+func TestRequestOptions_Timeout(t *testing.T) {
+	c, err := newClient("key", "secret", WithHTTPClient(blockingClient{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	var out map[string]any
+	_, err = MakeRequest[any, map[string]any](c, context.Background(), http.MethodGet, "/api/v2/x", url.Values{}, nil, &out, nil, RequestTimeout(20*time.Millisecond))
+	if !errors.Is(err, ErrTransport) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("want a timeout transport error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("timeout not applied, call took %s", elapsed)
+	}
+}
+
+func TestRequestOptions_BypassSharedCache(t *testing.T) {
+	script := &scriptedRetryClient{responses: []func() (*http.Response, error){
+		canned(200, `{"n":1}`, nil),
+		canned(200, `{"n":2}`, nil),
+	}}
+	c := newRetryTestClient(t, script, nil)
+	WithResponseCache(ResponseCacheConfig{Enabled: true})(c)
+
+	var out map[string]any
+	if _, err := MakeRequest[any, map[string]any](c, context.Background(), http.MethodGet, "/api/v2/app", nil, nil, &out, nil); err != nil {
+		t.Fatal(err)
+	}
+	out = nil
+	res, err := MakeRequest[any, map[string]any](c, context.Background(), http.MethodGet, "/api/v2/app", nil, nil, &out, nil, RequestActingUser("john"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script.calls != 2 || res.Data["n"] != float64(2) {
+		t.Fatalf("acting-user call must not be served from the cache, calls=%d data=%v", script.calls, res.Data)
+	}
+}
+
+func TestRequestOptions_GeneratedMethods(t *testing.T) {
+	fake := &headerRecordingClient{scriptedRetryClient: scriptedRetryClient{responses: []func() (*http.Response, error){
+		canned(200, `{}`, nil),
+		canned(200, `{}`, nil),
+		canned(200, `{"channels":[]}`, nil),
+	}}}
+	c := newRetryTestClient(t, fake, nil)
+	chat := NewChatClient(c)
+	tenant := RequestHeader("X-Tenant", "acme")
+
+	if _, err := c.GetApp(context.Background(), &GetAppRequest{}, tenant); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chat.Channel("messaging", "general").Get(context.Background(), &GetChannelRequest{}, tenant); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chat.QueryChannelsPaginator(nil, WithPaginationRequestOptions(tenant)).All(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.headers) != 3 {
+		t.Fatalf("want 3 calls, got %d", len(fake.headers))
+	}
+	for i, h := range fake.headers {
+		if h.Get("X-Tenant") != "acme" {
+			t.Fatalf("call %d: want the per-call header, got %v", i, h)
+		}
+	}
+}
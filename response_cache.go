@@ -0,0 +1,235 @@
+package getstream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultResponseCacheTTL = time.Minute
+
+// Configuration reads served by the response cache, in Operation.Name form.
+const (
+	CacheOpGetApp           = "GET /api/v2/app"
+	CacheOpGetChannelType   = "GET /api/v2/chat/channeltypes/{name}"
+	CacheOpGetCallType      = "GET /api/v2/video/calltypes/{name}"
+	CacheOpListPermissions  = "GET /api/v2/permissions"
+	CacheOpGetFeedGroup     = "GET /api/v2/feeds/feed_groups/{id}"
+	CacheOpGetPushTemplates = "GET /api/v2/push_templates"
+)
+
+// cacheInvalidations maps each write to the cached reads it makes stale. A
+// write invalidates every cached entry of those operations, whatever their
+// path params.
+var cacheInvalidations = map[string][]string{
+	"PATCH /api/v2/app":                                      {CacheOpGetApp},
+	"POST /api/v2/chat/channeltypes":                         {CacheOpGetChannelType},
+	"PUT /api/v2/chat/channeltypes/{name}":                   {CacheOpGetChannelType},
+	"DELETE /api/v2/chat/channeltypes/{name}":                {CacheOpGetChannelType},
+	"POST /api/v2/video/calltypes":                           {CacheOpGetCallType},
+	"PUT /api/v2/video/calltypes/{name}":                     {CacheOpGetCallType},
+	"DELETE /api/v2/video/calltypes/{name}":                  {CacheOpGetCallType},
+	"POST /api/v2/feeds/feed_groups":                         {CacheOpGetFeedGroup},
+	"POST /api/v2/feeds/feed_groups/{id}":                    {CacheOpGetFeedGroup},
+	"PUT /api/v2/feeds/feed_groups/{id}":                     {CacheOpGetFeedGroup},
+	"DELETE /api/v2/feeds/feed_groups/{id}":                  {CacheOpGetFeedGroup},
+	"POST /api/v2/feeds/feed_groups/{feed_group_id}/restore": {CacheOpGetFeedGroup},
+	"POST /api/v2/push_templates":                            {CacheOpGetPushTemplates},
+}
+
+// ResponseCacheStore holds cached response bodies. Implementations must be
+// safe for concurrent use. Keys of one client share a common prefix, so a
+// store may be shared between clients.
+type ResponseCacheStore interface {
+	// Get returns the value stored under key, if present and not expired.
+	Get(key string) ([]byte, bool)
+	// Set stores value under key for ttl.
+	Set(key string, value []byte, ttl time.Duration)
+	// DeletePrefix removes every key starting with prefix.
+	DeletePrefix(prefix string)
+}
+
+// ResponseCacheConfig is the opt-in read-through cache for configuration
+// reads (the CacheOp* operations). Disabled by default. Cached responses are
+// returned without a request, so they carry no RateLimitInfo.
+type ResponseCacheConfig struct {
+	// Enabled turns the cache on. Default false.
+	Enabled bool
+	// TTL is the default time-to-live of a cached response. Default 1m.
+	TTL time.Duration
+	// OperationTTLs overrides TTL per operation (CacheOp* constants). A
+	// negative value disables caching for that operation.
+	OperationTTLs map[string]time.Duration
+	// Store holds the cached responses. Default: an in-memory store.
+	Store ResponseCacheStore
+}
+
+// WithResponseCache enables the opt-in response cache. Writes made through
+// the same client (e.g. UpdateApp, UpdateChannelType, DeleteFeedGroup)
+// invalidate the reads they affect; use Client.InvalidateCache for changes
+// made elsewhere.
+func WithResponseCache(cfg ResponseCacheConfig) ClientOption {
+	return func(c *Client) {
+		if !cfg.Enabled {
+			c.responseCache = nil
+			return
+		}
+		if cfg.TTL <= 0 {
+			cfg.TTL = defaultResponseCacheTTL
+		}
+		if cfg.Store == nil {
+			cfg.Store = NewMemoryCacheStore()
+		}
+		c.responseCache = &responseCache{cfg: cfg}
+	}
+}
+
+type responseCache struct {
+	cfg ResponseCacheConfig
+}
+
+// ttl returns the TTL for op, or zero if op is not cached.
+func (rc *responseCache) ttl(op string) time.Duration {
+	switch op {
+	case CacheOpGetApp, CacheOpGetChannelType, CacheOpGetCallType,
+		CacheOpListPermissions, CacheOpGetFeedGroup, CacheOpGetPushTemplates:
+	default:
+		return 0
+	}
+	if ttl, ok := rc.cfg.OperationTTLs[op]; ok {
+		if ttl < 0 {
+			return 0
+		}
+		return ttl
+	}
+	return rc.cfg.TTL
+}
+
+// cachePrefix scopes an operation's keys to the client's app.
+func (c *Client) cachePrefix(op string) string {
+	return c.apiKey + "|" + op + "|"
+}
+
+// cacheKey identifies one cached read: the operation plus its expanded path
+// and query.
+func (c *Client) cacheKey(op, path string, params url.Values, pathParams map[string]string) string {
+	return c.cachePrefix(op) + buildPath(path, pathParams) + "?" + params.Encode()
+}
+
+// InvalidateCache drops the cached responses of the given operations
+// (CacheOp* constants), or of all operations when none are given. It is a
+// no-op without WithResponseCache.
+func (c *Client) InvalidateCache(operations ...string) {
+	if c.responseCache == nil {
+		return
+	}
+	if len(operations) == 0 {
+		c.responseCache.cfg.Store.DeletePrefix(c.apiKey + "|")
+		return
+	}
+	for _, op := range operations {
+		c.responseCache.cfg.Store.DeletePrefix(c.cachePrefix(op))
+	}
+}
+
+// cachedResponse serves a GET from the response cache. It returns the cache
+// key to fill on a miss, or "" when the call is not cacheable.
+func cachedResponse[GResponse any](c *Client, ctx context.Context, method, path string, params url.Values, pathParams map[string]string, response *GResponse) (*StreamResponse[GResponse], string) {
+	if c.responseCache == nil || method != http.MethodGet {
+		return nil, ""
+	}
+	op := method + " " + path
+	if c.responseCache.ttl(op) <= 0 {
+		return nil, ""
+	}
+	key := c.cacheKey(op, path, params, pathParams)
+	b, ok := c.responseCache.cfg.Store.Get(key)
+	if !ok {
+		return nil, key
+	}
+	if err := json.Unmarshal(b, response); err != nil {
+		return nil, key
+	}
+	c.logEvent(ctx, LogLevelDebug, "http.cache.hit",
+		LogField{"http.request.method", method},
+		LogField{"url.path", path},
+	)
+	return &StreamResponse[GResponse]{Data: *response}, key
+}
+
+// updateCache stores a successful cacheable read under key, or invalidates
+// the reads a successful write affects.
+func updateCache[GResponse any](c *Client, method, path, key string, result *StreamResponse[GResponse]) {
+	if c.responseCache == nil || result == nil {
+		return
+	}
+	op := method + " " + path
+	if key != "" {
+		if b, err := json.Marshal(result.Data); err == nil {
+			c.responseCache.cfg.Store.Set(key, b, c.responseCache.ttl(op))
+		}
+		return
+	}
+	if stale, ok := cacheInvalidations[op]; ok {
+		c.InvalidateCache(stale...)
+	}
+}
+
+// memoryCacheStore is the default in-process ResponseCacheStore.
+type memoryCacheStore struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCacheStore returns an in-memory ResponseCacheStore. Expired
+// entries are dropped when read or when a later Set finds them.
+func NewMemoryCacheStore() ResponseCacheStore {
+	return &memoryCacheStore{now: time.Now, entries: map[string]memoryCacheEntry{}}
+}
+
+func (m *memoryCacheStore) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !m.now().Before(e.expiresAt) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (m *memoryCacheStore) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	for k, e := range m.entries {
+		if !now.Before(e.expiresAt) {
+			delete(m.entries, k)
+		}
+	}
+	m.entries[key] = memoryCacheEntry{value: value, expiresAt: now.Add(ttl)}
+}
+
+func (m *memoryCacheStore) DeletePrefix(prefix string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k := range m.entries {
+		if strings.HasPrefix(k, prefix) {
+			delete(m.entries, k)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package getstream
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countingClient answers every request with 200 and body, counting calls per
+// "METHOD path".
+type countingClient struct {
+	body  string
+	calls map[string]int
+}
+
+func (f *countingClient) Do(r *http.Request) (*http.Response, error) {
+	if f.calls == nil {
+		f.calls = map[string]int{}
+	}
+	f.calls[r.Method+" "+r.URL.Path]++
+	return canned(200, f.body, nil)()
+}
+
+func TestResponseCache_ServesRepeatedReads(t *testing.T) {
+	fake := &countingClient{body: `{"app":{"name":"demo"},"duration":"1ms"}`}
+	c := newTestClient(t, fake, WithResponseCache(ResponseCacheConfig{Enabled: true}))
+
+	for i := 0; i < 3; i++ {
+		res, err := c.GetApp(context.Background(), &GetAppRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "demo", res.Data.App.Name)
+	}
+	require.Equal(t, 1, fake.calls["GET /api/v2/app"])
+}
+
+func TestResponseCache_KeyedByPathParams(t *testing.T) {
+	fake := &countingClient{body: `{"name":"x"}`}
+	c := newTestClient(t, fake, WithResponseCache(ResponseCacheConfig{Enabled: true}))
+
+	_, err := c.Chat().GetChannelType(context.Background(), "messaging", &GetChannelTypeRequest{})
+	require.NoError(t, err)
+	_, err = c.Chat().GetChannelType(context.Background(), "livestream", &GetChannelTypeRequest{})
+	require.NoError(t, err)
+	_, err = c.Chat().GetChannelType(context.Background(), "messaging", &GetChannelTypeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, fake.calls["GET /api/v2/chat/channeltypes/messaging"])
+	require.Equal(t, 1, fake.calls["GET /api/v2/chat/channeltypes/livestream"])
+}
+
+func TestResponseCache_WriteInvalidates(t *testing.T) {
+	fake := &countingClient{body: `{}`}
+	c := newTestClient(t, fake, WithResponseCache(ResponseCacheConfig{Enabled: true}))
+
+	_, err := c.Video().GetCallType(context.Background(), "default", &GetCallTypeRequest{})
+	require.NoError(t, err)
+	_, err = c.Video().UpdateCallType(context.Background(), "default", &UpdateCallTypeRequest{})
+	require.NoError(t, err)
+	_, err = c.Video().GetCallType(context.Background(), "default", &GetCallTypeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, fake.calls["GET /api/v2/video/calltypes/default"])
+}
+
+// TestResponseCache_InvalidationsCoverWrites checks that every generated
+// write to a cached configuration resource invalidates its reads.
+func TestResponseCache_InvalidationsCoverWrites(t *testing.T) {
+	resources := []struct{ prefix, op string }{
+		{"/api/v2/app", CacheOpGetApp},
+		{"/api/v2/chat/channeltypes", CacheOpGetChannelType},
+		{"/api/v2/video/calltypes", CacheOpGetCallType},
+		{"/api/v2/feeds/feed_groups", CacheOpGetFeedGroup},
+		{"/api/v2/push_templates", CacheOpGetPushTemplates},
+	}
+	// The feeds of a group are not part of its configuration.
+	const groupFeeds = "/api/v2/feeds/feed_groups/{feed_group_id}/feeds/"
+	call := regexp.MustCompile(`MakeRequest\[[^\]]*\]\([^,]+, ctx, "([A-Z]+)", "([^"]+)"`)
+
+	checked := 0
+	for _, file := range []string{"common.go", "chat.go", "video.go", "feeds-v3.go"} {
+		src, err := os.ReadFile(file)
+		require.NoError(t, err)
+		for _, m := range call.FindAllStringSubmatch(string(src), -1) {
+			method, path := m[1], m[2]
+			if method == http.MethodGet || strings.HasPrefix(path, groupFeeds) {
+				continue
+			}
+			for _, r := range resources {
+				if path == r.prefix || strings.HasPrefix(path, r.prefix+"/") {
+					require.Contains(t, cacheInvalidations[method+" "+path], r.op, "%s %s must invalidate %s", method, path, r.op)
+					checked++
+				}
+			}
+		}
+	}
+	require.Equal(t, len(cacheInvalidations), checked, "every invalidation entry must match a generated write")
+}
+
+func TestResponseCache_ExplicitInvalidation(t *testing.T) {
+	fake := &countingClient{body: `{}`}
+	c := newTestClient(t, fake, WithResponseCache(ResponseCacheConfig{Enabled: true}))
+
+	_, _ = c.ListPermissions(context.Background(), &ListPermissionsRequest{})
+	_, _ = c.GetPushTemplates(context.Background(), &GetPushTemplatesRequest{PushProviderType: "apn"})
+	c.InvalidateCache(CacheOpListPermissions)
+	_, _ = c.ListPermissions(context.Background(), &ListPermissionsRequest{})
+	_, _ = c.GetPushTemplates(context.Background(), &GetPushTemplatesRequest{PushProviderType: "apn"})
+	require.Equal(t, 2, fake.calls["GET /api/v2/permissions"])
+	require.Equal(t, 1, fake.calls["GET /api/v2/push_templates"])
+
+	c.InvalidateCache()
+	_, _ = c.GetPushTemplates(context.Background(), &GetPushTemplatesRequest{PushProviderType: "apn"})
+	require.Equal(t, 2, fake.calls["GET /api/v2/push_templates"])
+}
+
+func TestResponseCache_PerOperationTTL(t *testing.T) {
+	fake := &countingClient{body: `{}`}
+	c := newTestClient(t, fake, WithResponseCache(ResponseCacheConfig{Enabled: true, OperationTTLs: map[string]time.Duration{CacheOpGetApp: -1}}))
+
+	_, _ = c.GetApp(context.Background(), &GetAppRequest{})
+	_, _ = c.GetApp(context.Background(), &GetAppRequest{})
+	require.Equal(t, 2, fake.calls["GET /api/v2/app"], "a negative TTL disables caching")
+}
+
+func TestResponseCache_SkipsOtherReadsAndErrors(t *testing.T) {
+	fake := &oneShotClient{status: 500, body: `{"code":-1,"message":"boom"}`}
+	c := newTestClient(t, fake, WithResponseCache(ResponseCacheConfig{Enabled: true}))
+
+	_, err := c.GetApp(context.Background(), &GetAppRequest{})
+	require.Error(t, err)
+	_, err = c.GetApp(context.Background(), &GetAppRequest{})
+	require.Error(t, err)
+	require.Equal(t, 2, fake.calls, "errors are not cached")
+
+	counting := &countingClient{body: `{}`}
+	c = newTestClient(t, counting, WithResponseCache(ResponseCacheConfig{Enabled: true}))
+	_, _ = c.Chat().GetChannel(context.Background(), "messaging", "general", &GetChannelRequest{})
+	_, _ = c.Chat().GetChannel(context.Background(), "messaging", "general", &GetChannelRequest{})
+	require.Equal(t, 2, counting.calls["GET /api/v2/chat/channels/messaging/general"])
+}
+
+func TestMemoryCacheStore_Expiry(t *testing.T) {
+	s := NewMemoryCacheStore().(*memoryCacheStore)
+	now := time.Unix(1_700_000_000, 0)
+	s.now = func() time.Time { return now }
+
+	s.Set("a", []byte("1"), time.Second)
+	v, ok := s.Get("a")
+	require.True(t, ok)
+	require.Equal(t, "1", string(v))
+
+	now = now.Add(time.Second)
+	_, ok = s.Get("a")
+	require.False(t, ok)
+}
@@ -17,6 +17,9 @@ const (
 // client performs exactly one attempt and surfaces errors unchanged. When
 // enabled, only GET/HEAD requests failing with HTTP 429 or a transport error
 // are retried, and never when the backend marked the error unrecoverable.
+// Writes are retried only when they are known to be idempotent (see
+// RetryIdempotentWrites) or carry an Idempotency-Key header that their
+// endpoint honours (see IdempotencyKeyOperations).
 type RetryConfig struct {
 	// Enabled turns retries on. Default false.
 	Enabled bool
@@ -26,6 +29,26 @@ type RetryConfig struct {
 	// MaxBackoff caps every wait between attempts, including Retry-After
 	// hints from the server. Default 30s.
 	MaxBackoff time.Duration
+	// RetryIdempotentWrites extends retries to writes that are safe to
+	// resend: PUT and DELETE, upserts and set/unset partial updates (e.g.
+	// UpdateUsers, UpdateUsersPartial, UpsertConfig), and creates that carry
+	// a client-supplied ID (SendMessage with Message.ID, AddActivity with ID,
+	// UpsertActivities with every ID set). Default false.
+	RetryIdempotentWrites bool
+	// IdempotentOperations adds operations to the RetryIdempotentWrites
+	// allow-list, in Operation.Name form, e.g.
+	// "POST /api/v2/chat/channels/{type}/{id}/read".
+	IdempotentOperations []string
+	// IdempotencyKeys attaches an Idempotency-Key header to every
+	// POST/PUT/PATCH/DELETE. The key is generated once per call and reused
+	// across its attempts. Use RequestIdempotencyKey to supply your own key
+	// for a call. Default false.
+	IdempotencyKeys bool
+	// IdempotencyKeyOperations lists the writes whose endpoint deduplicates
+	// by Idempotency-Key, in Operation.Name form. A write carrying a key is
+	// retry-eligible only if it is listed here; on any other endpoint the
+	// key is sent but a resend could still apply twice.
+	IdempotencyKeyOperations []string
 }
 
 // WithRetry enables the opt-in auto-retry policy. Zero values for
@@ -33,26 +56,31 @@ type RetryConfig struct {
 // 30s cap).
 func WithRetry(cfg RetryConfig) ClientOption {
 	return func(c *Client) {
-		if cfg.MaxAttempts <= 0 {
-			cfg.MaxAttempts = defaultRetryMaxAttempts
-		}
-		if cfg.MaxBackoff <= 0 {
-			cfg.MaxBackoff = defaultRetryMaxBackoff
-		}
-		c.retry = cfg
+		c.retry = cfg.withDefaults()
+	}
+}
+
+// withDefaults fills zero MaxAttempts/MaxBackoff with the documented defaults.
+func (cfg RetryConfig) withDefaults() RetryConfig {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultRetryMaxAttempts
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultRetryMaxBackoff
 	}
+	return cfg
 }
 
 // shouldRetry reports whether a failed attempt may be retried. attempt is
 // 0-indexed and counts completed attempts.
-func (c *Client) shouldRetry(err error, method string, attempt int) bool {
-	if !c.retry.Enabled || err == nil {
+func (cfg RetryConfig) shouldRetry(err error, op *Operation, attempt int) bool {
+	if !cfg.Enabled || err == nil {
 		return false
 	}
-	if method != http.MethodGet && method != http.MethodHead {
+	if op.Method != http.MethodGet && op.Method != http.MethodHead && !cfg.canRetryWrite(op) {
 		return false
 	}
-	if attempt+1 >= c.retry.MaxAttempts {
+	if attempt+1 >= cfg.MaxAttempts {
 		return false
 	}
 	var streamErr *StreamError
@@ -65,19 +93,32 @@ func (c *Client) shouldRetry(err error, method string, attempt int) bool {
 	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrTransport)
 }
 
+// canRetryWrite reports whether a write may be resent: its body can be
+// replayed, and it carries an idempotency key its endpoint honours or
+// RetryIdempotentWrites is on and it is allow-listed.
+func (cfg RetryConfig) canRetryWrite(op *Operation) bool {
+	if !isRewindableUpload(op.Request) {
+		return false
+	}
+	if op.Header.Get(IdempotencyKeyHeader) != "" && cfg.honoursIdempotencyKey(op) {
+		return true
+	}
+	return cfg.RetryIdempotentWrites && cfg.isIdempotentWrite(op)
+}
+
 // retryDelay returns the wait before the next attempt: a positive Retry-After
 // hint clamped to MaxBackoff, otherwise exponential backoff with full jitter.
-func (c *Client) retryDelay(err error, attempt int) time.Duration {
+func (cfg RetryConfig) retryDelay(err error, attempt int) time.Duration {
 	var streamErr *StreamError
 	if errors.As(err, &streamErr) && streamErr.RetryAfter > 0 {
-		if streamErr.RetryAfter > c.retry.MaxBackoff {
-			return c.retry.MaxBackoff
+		if streamErr.RetryAfter > cfg.MaxBackoff {
+			return cfg.MaxBackoff
 		}
 		return streamErr.RetryAfter
 	}
 	ceil := retryBackoffBase << uint(attempt)
-	if ceil <= 0 || ceil > c.retry.MaxBackoff {
-		ceil = c.retry.MaxBackoff
+	if ceil <= 0 || ceil > cfg.MaxBackoff {
+		ceil = cfg.MaxBackoff
 	}
 	if ceil <= 0 {
 		return 0
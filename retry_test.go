@@ -26,6 +26,27 @@ func (s *scriptedRetryClient) Do(_ *http.Request) (*http.Response, error) {
 	return step()
 }
 
+// headerRecordingClient wraps a script and records the headers of every
+// attempt.
+type headerRecordingClient struct {
+	scriptedRetryClient
+	headers []http.Header
+}
+
+func (h *headerRecordingClient) Do(r *http.Request) (*http.Response, error) {
+	h.headers = append(h.headers, r.Header.Clone())
+	return h.scriptedRetryClient.Do(r)
+}
+
+// keys returns the Idempotency-Key header of every attempt.
+func (h *headerRecordingClient) keys() []string {
+	out := make([]string, len(h.headers))
+	for i, hdr := range h.headers {
+		out[i] = hdr.Get(IdempotencyKeyHeader)
+	}
+	return out
+}
+
 func canned(status int, body string, headers map[string]string) func() (*http.Response, error) {
 	return func() (*http.Response, error) {
 		h := http.Header{}
@@ -37,7 +58,7 @@ func canned(status int, body string, headers map[string]string) func() (*http.Re
 	}
 }
 
-func newRetryTestClient(t *testing.T, script *scriptedRetryClient, cfg *RetryConfig) *Client {
+func newRetryTestClient(t *testing.T, script HttpClient, cfg *RetryConfig) *Client {
 	t.Helper()
 	opts := []ClientOption{WithHTTPClient(script)}
 	if cfg != nil {
@@ -50,6 +71,25 @@ func newRetryTestClient(t *testing.T, script *scriptedRetryClient, cfg *RetryCon
 	return c
 }
 
+// newTestClient returns a client that sends through fake and logs to a
+// recordingLogger, with opts applied on top.
+func newTestClient(t *testing.T, fake HttpClient, opts ...ClientOption) *Stream {
+	t.Helper()
+	c, err := NewClient("key", "secret", append([]ClientOption{WithHTTPClient(fake), WithLogger(&recordingLogger{})}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// stubClock replaces the clock at now with a fixed time and returns it, so
+// tests can advance it.
+func stubClock(now *func() time.Time) *time.Time {
+	t := time.Unix(1_700_000_000, 0)
+	*now = func() time.Time { return t }
+	return &t
+}
+
 func doGET(c *Client) error {
 	var out map[string]any
 	_, err := MakeRequest[map[string]any, map[string]any](c, context.Background(), http.MethodGet, "/api/v2/x", url.Values{}, nil, &out, nil)
@@ -212,7 +252,7 @@ func TestRetryExhaustionSurfacesLastError(t *testing.T) {
 func TestRetryDelayClampAndJitterBounds(t *testing.T) {
 	c := &Client{retry: RetryConfig{Enabled: true, MaxAttempts: 3, MaxBackoff: 30 * time.Second}}
 	rateLimited := &StreamError{sentinel: ErrRateLimited, RetryAfter: 600 * time.Second}
-	if d := c.retryDelay(rateLimited, 0); d != 30*time.Second {
+	if d := c.retry.retryDelay(rateLimited, 0); d != 30*time.Second {
 		t.Fatalf("want clamp to 30s, got %s", d)
 	}
 	transport := &StreamError{sentinel: ErrTransport}
@@ -222,7 +262,7 @@ func TestRetryDelayClampAndJitterBounds(t *testing.T) {
 			ceil = c.retry.MaxBackoff
 		}
 		for i := 0; i < 50; i++ {
-			if d := c.retryDelay(transport, attempt); d < 0 || d > ceil {
+			if d := c.retry.retryDelay(transport, attempt); d < 0 || d > ceil {
 				t.Fatalf("attempt %d: delay %s out of [0,%s]", attempt, d, ceil)
 			}
 		}
@@ -0,0 +1,96 @@
+package getstream
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// SecretMatch identifies which of the client's API secrets verified a
+// webhook signature or user token: SecretPrimary, or a secondary secret
+// numbered from 1 in the order given to WithSecondarySecrets.
+type SecretMatch int
+
+// SecretPrimary is the secret the client was created with.
+const SecretPrimary SecretMatch = 0
+
+// IsPrimary reports whether the primary secret matched.
+func (m SecretMatch) IsPrimary() bool {
+	return m == SecretPrimary
+}
+
+// String returns "primary" or "secondary:<n>", suitable as a metric label.
+func (m SecretMatch) String() string {
+	if m.IsPrimary() {
+		return "primary"
+	}
+	return "secondary:" + strconv.Itoa(int(m))
+}
+
+// WithSecondarySecrets adds API secrets that are still accepted while a
+// secret rotation is in progress. Tokens are always signed with the primary
+// secret passed to NewClient; webhook signatures and user tokens verify
+// against the primary and then each secondary secret in order, and report
+// the one that matched as a SecretMatch. Empty secrets are ignored.
+func WithSecondarySecrets(secrets ...string) ClientOption {
+	return func(c *Client) {
+		c.secondarySecrets = nil
+		for _, s := range secrets {
+			if s != "" {
+				c.secondarySecrets = append(c.secondarySecrets, []byte(s))
+			}
+		}
+	}
+}
+
+// apiSecrets returns the primary secret followed by the secondary ones, so
+// that the index of a secret is its SecretMatch.
+func (c *Client) apiSecrets() [][]byte {
+	secrets := make([][]byte, 0, 1+len(c.secondarySecrets))
+	secrets = append(secrets, c.apiSecret)
+	return append(secrets, c.secondarySecrets...)
+}
+
+// MatchWebhookSignature checks the HMAC-SHA256 signature of a webhook
+// payload against every configured API secret and reports which one
+// matched. payload must be uncompressed; see GunzipPayload.
+func (s *Stream) MatchWebhookSignature(payload []byte, signature string) (SecretMatch, bool) {
+	for i, secret := range s.apiSecrets() {
+		if VerifySignature(payload, signature, string(secret)) {
+			return SecretMatch(i), true
+		}
+	}
+	return 0, false
+}
+
+// verifyAndParseWebhookBytes is VerifyAndParseWebhookBytes accepting any of
+// the client's secrets.
+func (s *Stream) verifyAndParseWebhookBytes(body []byte, signature string) (WebhookEvent, SecretMatch, error) {
+	payload, err := GunzipPayload(body)
+	if err != nil {
+		return nil, 0, err
+	}
+	match, ok := s.MatchWebhookSignature(payload, signature)
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: signature mismatch", ErrInvalidWebhook)
+	}
+	event, err := ParseEvent(payload)
+	return event, match, err
+}
+
+// VerifyAndParseWebhookMatch is VerifyAndParseWebhook that also reports
+// which API secret verified the signature, e.g. to track how many webhooks
+// still arrive signed with a secret being rotated out.
+func (s *Stream) VerifyAndParseWebhookMatch(r *http.Request) (WebhookEvent, SecretMatch, error) {
+	if r == nil {
+		return nil, 0, fmt.Errorf("%w: nil request", ErrInvalidWebhook)
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: read body: %v", ErrInvalidWebhook, err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return s.verifyAndParseWebhookBytes(body, r.Header.Get("X-Signature"))
+}
@@ -0,0 +1,97 @@
+package getstream
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func signWebhookBody(secret string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func newRotationTestClient(t *testing.T) *Stream {
+	t.Helper()
+	c, err := NewClient("key", "new-secret", WithSecondarySecrets("", "old-secret", "older-secret"))
+	require.NoError(t, err)
+	return c
+}
+
+func TestSecondarySecrets_WebhookSignature(t *testing.T) {
+	c := newRotationTestClient(t)
+	body := []byte(`{"type":"user.updated"}`)
+
+	tests := []struct {
+		secret string
+		want   SecretMatch
+	}{
+		{"new-secret", SecretPrimary},
+		{"old-secret", 1},
+		{"older-secret", 2},
+	}
+	for _, tt := range tests {
+		sig := signWebhookBody(tt.secret, body)
+		match, ok := c.MatchWebhookSignature(body, sig)
+		require.True(t, ok, tt.secret)
+		require.Equal(t, tt.want, match, tt.secret)
+		require.True(t, c.VerifyWebhookSignature(body, sig))
+		require.True(t, c.VerifyWebhook(body, []byte(sig)))
+	}
+
+	_, ok := c.MatchWebhookSignature(body, signWebhookBody("unknown", body))
+	require.False(t, ok)
+	require.False(t, c.VerifyWebhook(body, []byte(signWebhookBody("unknown", body))))
+	require.Equal(t, "primary", SecretPrimary.String())
+	require.Equal(t, "secondary:2", SecretMatch(2).String())
+}
+
+func TestSecondarySecrets_VerifyAndParseWebhook(t *testing.T) {
+	c := newRotationTestClient(t)
+	payload := []byte(`{"type":"user.updated","user":{"id":"john"}}`)
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, _ = zw.Write(payload)
+	require.NoError(t, zw.Close())
+
+	req := httptest.NewRequest("POST", "/webhooks", bytes.NewReader(gz.Bytes()))
+	req.Header.Set("X-Signature", signWebhookBody("old-secret", payload))
+	event, match, err := c.VerifyAndParseWebhookMatch(req)
+	require.NoError(t, err)
+	require.Equal(t, "user.updated", event.GetEventType())
+	require.Equal(t, SecretMatch(1), match)
+
+	_, err = c.VerifyAndParseWebhookBytes(payload, signWebhookBody("unknown", payload))
+	require.True(t, errors.Is(err, ErrInvalidWebhook))
+}
+
+func TestSecondarySecrets_Tokens(t *testing.T) {
+	c := newRotationTestClient(t)
+
+	token, err := c.CreateToken("john")
+	require.NoError(t, err)
+	claims, err := c.ParseToken(token)
+	require.NoError(t, err)
+	require.True(t, claims.Secret.IsPrimary(), "tokens are signed with the primary secret")
+
+	old := signTestToken(t, jwt.SigningMethodHS256, "older-secret", jwt.MapClaims{"user_id": "john"})
+	claims, err = c.ParseToken(old)
+	require.NoError(t, err)
+	require.Equal(t, SecretMatch(2), claims.Secret)
+
+	expiredOld := signTestToken(t, jwt.SigningMethodHS256, "old-secret", jwt.MapClaims{"user_id": "john", "exp": 1})
+	_, err = c.ParseToken(expiredOld)
+	require.True(t, errors.Is(err, ErrTokenExpired), "want expired under the matching secret, got %v", err)
+
+	_, err = c.ParseToken(signTestToken(t, jwt.SigningMethodHS256, "unknown", jwt.MapClaims{"user_id": "john"}))
+	require.True(t, errors.Is(err, ErrTokenSignatureInvalid))
+}
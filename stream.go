@@ -1,5 +1,7 @@
 package getstream
 
+//go:generate go run ./internal/apigen
+
 import "net/http"
 
 type Stream struct {
@@ -80,26 +82,29 @@ func (s *Stream) Moderation() *ModerationClient {
 }
 
 // VerifyWebhookSignature verifies the HMAC-SHA256 signature of a webhook body
-// using this client's API secret. Convenience wrapper around the package-level
-// VerifyWebhookSignature function — drops the secret parameter in favor of
-// the secret stored on the client.
+// using this client's API secrets (see WithSecondarySecrets). Convenience
+// wrapper around the package-level VerifyWebhookSignature function — drops
+// the secret parameter in favor of the secrets stored on the client.
 func (s *Stream) VerifyWebhookSignature(body []byte, signature string) bool {
-	return VerifyWebhookSignature(body, signature, string(s.apiSecret))
+	_, ok := s.MatchWebhookSignature(body, signature)
+	return ok
 }
 
 // VerifyAndParseWebhook verifies and parses a webhook payload from an
-// *http.Request using this client's API secret. The request body is restored
+// *http.Request using this client's API secrets. The request body is restored
 // so downstream handlers can read it again. Convenience wrapper around the
 // package-level VerifyAndParseWebhook — drops the secret parameter.
 func (s *Stream) VerifyAndParseWebhook(r *http.Request) (WebhookEvent, error) {
-	return VerifyAndParseWebhook(r, string(s.apiSecret))
+	event, _, err := s.VerifyAndParseWebhookMatch(r)
+	return event, err
 }
 
 // VerifyAndParseWebhookBytes verifies and parses a webhook payload (raw bytes)
-// using this client's API secret. Convenience wrapper around the package-level
+// using this client's API secrets. Convenience wrapper around the package-level
 // VerifyAndParseWebhookBytes — drops the secret parameter.
 func (s *Stream) VerifyAndParseWebhookBytes(body []byte, signature string) (WebhookEvent, error) {
-	return VerifyAndParseWebhookBytes(body, signature, string(s.apiSecret))
+	event, _, err := s.verifyAndParseWebhookBytes(body, signature)
+	return event, err
 }
 
 // ParseSqs is a convenience wrapper that calls the package-level ParseSqs.
@@ -0,0 +1,607 @@
+package streamtest
+
+import (
+	"github.com/GetStream/getstream-go/v5"
+	"github.com/google/uuid"
+)
+
+func (s *Server) getOrCreateChannel(c *call) (any, *apiError) {
+	var req getstream.GetOrCreateChannelRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	cid := c.params["type"] + ":" + c.params["id"]
+	ch, ok := s.channels[cid]
+	if !ok {
+		var err *apiError
+		if ch, err = s.createChannel(c.params["type"], c.params["id"], req.Data); err != nil {
+			return nil, err
+		}
+	}
+	var limit *int
+	if req.Messages != nil {
+		limit = req.Messages.Limit
+	}
+	state := s.channelState(ch, limit)
+	return getstream.ChannelStateResponse{
+		Channel:        state.Channel,
+		Members:        state.Members,
+		Messages:       state.Messages,
+		PinnedMessages: state.PinnedMessages,
+		Threads:        state.Threads,
+	}, nil
+}
+
+func (s *Server) createChannel(typ, id string, data *getstream.ChannelInput) (*channel, *apiError) {
+	if data == nil {
+		data = &getstream.ChannelInput{}
+	}
+	creatorID := ""
+	if data.CreatedByID != nil {
+		creatorID = *data.CreatedByID
+	} else if data.CreatedBy != nil {
+		creatorID = data.CreatedBy.ID
+	}
+	if creatorID == "" {
+		return nil, errInput("either data.created_by or data.created_by_id must be provided when using server side auth")
+	}
+	creator, err := s.user(creatorID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range data.Members {
+		if _, err := s.user(m.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := s.stamp()
+	createdBy := userResponse(creator)
+	ch := &channel{resp: getstream.ChannelResponse{
+		Cid:         typ + ":" + id,
+		Type:        typ,
+		ID:          id,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Custom:      data.Custom,
+		Team:        data.Team,
+		FilterTags:  data.FilterTags,
+		CreatedBy:   &createdBy,
+		Frozen:      data.Frozen != nil && *data.Frozen,
+		Disabled:    data.Disabled != nil && *data.Disabled,
+		MemberCount: new(int),
+	}}
+	if ch.resp.Custom == nil {
+		ch.resp.Custom = map[string]any{}
+	}
+	for _, m := range data.Members {
+		s.addMember(ch, m)
+	}
+	s.channels[ch.resp.Cid] = ch
+	s.channelCIDs = append(s.channelCIDs, ch.resp.Cid)
+	return ch, nil
+}
+
+// addMember adds a user, who must exist, unless already a member.
+func (s *Server) addMember(ch *channel, m getstream.ChannelMemberRequest) {
+	for _, existing := range ch.members {
+		if *existing.UserID == m.UserID {
+			return
+		}
+	}
+	role := "channel_member"
+	if m.ChannelRole != nil {
+		role = *m.ChannelRole
+	}
+	user := userResponse(s.users[m.UserID])
+	userID := m.UserID
+	now := s.stamp()
+	member := &getstream.ChannelMemberResponse{
+		UserID:      &userID,
+		User:        &user,
+		ChannelRole: role,
+		Custom:      m.Custom,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if member.Custom == nil {
+		member.Custom = map[string]any{}
+	}
+	ch.members = append(ch.members, member)
+}
+
+// channel looks up the channel addressed by the type and id path params.
+func (s *Server) channel(c *call) (*channel, *apiError) {
+	cid := c.params["type"] + ":" + c.params["id"]
+	ch, ok := s.channels[cid]
+	if !ok {
+		return nil, errNotFound("channel %q not found", cid)
+	}
+	return ch, nil
+}
+
+func (s *Server) channelResponse(ch *channel) getstream.ChannelResponse {
+	resp := ch.resp
+	count := len(ch.members)
+	resp.MemberCount = &count
+	return resp
+}
+
+func (s *Server) memberResponses(ch *channel) []getstream.ChannelMemberResponse {
+	out := make([]getstream.ChannelMemberResponse, 0, len(ch.members))
+	for _, m := range ch.members {
+		out = append(out, *m)
+	}
+	return out
+}
+
+// channelState is the channel with its members and its most recent
+// top-level messages, up to limit if set.
+func (s *Server) channelState(ch *channel, limit *int) getstream.ChannelStateResponseFields {
+	resp := s.channelResponse(ch)
+	messages := []getstream.MessageResponse{}
+	pinned := []getstream.MessageResponse{}
+	for _, id := range ch.messageIDs {
+		m := s.messages[id].resp
+		if m.ParentID != nil && (m.ShowInChannel == nil || !*m.ShowInChannel) {
+			continue
+		}
+		messages = append(messages, m)
+		if m.Pinned {
+			pinned = append(pinned, m)
+		}
+	}
+	if limit != nil && *limit >= 0 && len(messages) > *limit {
+		messages = messages[len(messages)-*limit:]
+	}
+	return getstream.ChannelStateResponseFields{
+		Channel:        &resp,
+		Members:        s.memberResponses(ch),
+		Messages:       messages,
+		PinnedMessages: pinned,
+		Threads:        []getstream.ThreadStateResponse{},
+	}
+}
+
+func (s *Server) updateChannel(c *call) (any, *apiError) {
+	var req getstream.UpdateChannelRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(c)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range req.AddMembers {
+		if _, err := s.user(m.UserID); err != nil {
+			return nil, err
+		}
+	}
+	var msg *message
+	if req.Message != nil {
+		if msg, err = s.addMessage(ch, *req.Message); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, m := range req.AddMembers {
+		s.addMember(ch, m)
+	}
+	for _, id := range req.RemoveMembers {
+		kept := ch.members[:0]
+		for _, m := range ch.members {
+			if *m.UserID != id {
+				kept = append(kept, m)
+			}
+		}
+		ch.members = kept
+	}
+	if req.Data != nil {
+		ch.resp.Custom = req.Data.Custom
+		if ch.resp.Custom == nil {
+			ch.resp.Custom = map[string]any{}
+		}
+		ch.resp.Team = req.Data.Team
+		ch.resp.Frozen = req.Data.Frozen != nil && *req.Data.Frozen
+		ch.resp.Disabled = req.Data.Disabled != nil && *req.Data.Disabled
+	}
+	ch.resp.UpdatedAt = s.stamp()
+
+	resp := getstream.UpdateChannelResponse{Members: s.memberResponses(ch)}
+	if msg != nil {
+		resp.Message = &msg.resp
+	}
+	channel := s.channelResponse(ch)
+	resp.Channel = &channel
+	return resp, nil
+}
+
+func (s *Server) deleteChannel(c *call) (any, *apiError) {
+	ch, err := s.channel(c)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ch.messageIDs {
+		delete(s.messages, id)
+	}
+	delete(s.channels, ch.resp.Cid)
+	s.channelCIDs = removeString(s.channelCIDs, ch.resp.Cid)
+
+	resp := s.channelResponse(ch)
+	resp.DeletedAt = s.stampPtr()
+	return getstream.DeleteChannelResponse{Channel: &resp}, nil
+}
+
+func (s *Server) queryChannels(c *call) (any, *apiError) {
+	var req getstream.QueryChannelsRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	var found []*channel
+	var docs []map[string]any
+	for _, cid := range s.channelCIDs {
+		ch := s.channels[cid]
+		doc := s.channelDoc(ch)
+		ok, err := matches(doc, req.FilterConditions)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			found = append(found, ch)
+			docs = append(docs, doc)
+		}
+	}
+	sortDocs(found, docs, req.Sort)
+	start, end := page(len(found), req.Offset, req.Limit)
+	resp := getstream.QueryChannelsResponse{Channels: []getstream.ChannelStateResponseFields{}}
+	for _, ch := range found[start:end] {
+		resp.Channels = append(resp.Channels, s.channelState(ch, req.MessageLimit))
+	}
+	return resp, nil
+}
+
+// channelDoc is what channel filters and sorts see: the channel's fields,
+// its members' user IDs and its custom data at the top level.
+func (s *Server) channelDoc(ch *channel) map[string]any {
+	doc := toMap(s.channelResponse(ch))
+	members := make([]any, 0, len(ch.members))
+	for _, m := range ch.members {
+		members = append(members, *m.UserID)
+	}
+	doc["members"] = members
+	if ch.resp.CreatedBy != nil {
+		doc["created_by_id"] = ch.resp.CreatedBy.ID
+	}
+	for k, v := range ch.resp.Custom {
+		if _, ok := doc[k]; !ok {
+			doc[k] = v
+		}
+	}
+	return doc
+}
+
+func (s *Server) queryMembers(c *call) (any, *apiError) {
+	var q getstream.QueryMembersPayload
+	if err := c.payload(&q); err != nil {
+		return nil, err
+	}
+	if q.Type == "" || q.ID == nil {
+		return nil, errInput("type and id are required")
+	}
+	cid := q.Type + ":" + *q.ID
+	ch, ok := s.channels[cid]
+	if !ok {
+		return nil, errNotFound("channel %q not found", cid)
+	}
+	var members []getstream.ChannelMemberResponse
+	var docs []map[string]any
+	for _, m := range ch.members {
+		doc := toMap(m)
+		doc["id"] = *m.UserID
+		if m.User != nil && m.User.Name != nil {
+			doc["name"] = *m.User.Name
+		}
+		for k, v := range m.Custom {
+			if _, ok := doc[k]; !ok {
+				doc[k] = v
+			}
+		}
+		ok, err := matches(doc, q.FilterConditions)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			members = append(members, *m)
+			docs = append(docs, doc)
+		}
+	}
+	sortDocs(members, docs, q.Sort)
+	start, end := page(len(members), q.Offset, q.Limit)
+	return getstream.MembersResponse{Members: append([]getstream.ChannelMemberResponse{}, members[start:end]...)}, nil
+}
+
+func (s *Server) sendMessage(c *call) (any, *apiError) {
+	var req getstream.SendMessageRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(c)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.addMessage(ch, req.Message)
+	if err != nil {
+		return nil, err
+	}
+	return getstream.SendMessageResponse{Message: m.resp}, nil
+}
+
+// addMessage validates and stores a new message in ch.
+func (s *Server) addMessage(ch *channel, req getstream.MessageRequest) (*message, *apiError) {
+	user, err := s.actingUser("message", req.UserID, req.User)
+	if err != nil {
+		return nil, err
+	}
+	id := uuid.NewString()
+	if req.ID != nil && *req.ID != "" {
+		id = *req.ID
+	}
+	if _, exists := s.messages[id]; exists {
+		return nil, errInput("message with ID %s already exists", id)
+	}
+	typ := "regular"
+	var parent *message
+	if req.ParentID != nil && *req.ParentID != "" {
+		p, ok := s.messages[*req.ParentID]
+		if !ok || p.resp.Cid != ch.resp.Cid {
+			return nil, errInput("parent message %s does not exist", *req.ParentID)
+		}
+		parent = p
+		typ = "reply"
+	}
+	mentioned := []getstream.UserResponse{}
+	for _, uid := range req.MentionedUsers {
+		u, err := s.user(uid)
+		if err != nil {
+			return nil, err
+		}
+		mentioned = append(mentioned, userResponse(u))
+	}
+
+	now := s.stamp()
+	m := &message{resp: getstream.MessageResponse{
+		ID:                   id,
+		Cid:                  ch.resp.Cid,
+		Type:                 typ,
+		User:                 userResponse(user),
+		CreatedAt:            now,
+		UpdatedAt:            now,
+		ParentID:             req.ParentID,
+		ShowInChannel:        req.ShowInChannel,
+		QuotedMessageID:      req.QuotedMessageID,
+		Attachments:          req.Attachments,
+		MentionedUsers:       mentioned,
+		Custom:               req.Custom,
+		LatestReactions:      []getstream.ReactionResponse{},
+		OwnReactions:         []getstream.ReactionResponse{},
+		ReactionCounts:       map[string]int{},
+		ReactionScores:       map[string]int{},
+		RestrictedVisibility: req.RestrictedVisibility,
+	}}
+	if req.Text != nil {
+		m.resp.Text = *req.Text
+	}
+	if req.Silent != nil {
+		m.resp.Silent = *req.Silent
+	}
+	if req.Pinned != nil && *req.Pinned {
+		m.resp.Pinned = true
+		m.resp.PinnedAt = &now
+		pinnedBy := m.resp.User
+		m.resp.PinnedBy = &pinnedBy
+	}
+	if m.resp.Attachments == nil {
+		m.resp.Attachments = []getstream.Attachment{}
+	}
+	if m.resp.Custom == nil {
+		m.resp.Custom = map[string]any{}
+	}
+	if m.resp.RestrictedVisibility == nil {
+		m.resp.RestrictedVisibility = []string{}
+	}
+
+	s.messages[id] = m
+	ch.messageIDs = append(ch.messageIDs, id)
+	if parent != nil {
+		parent.resp.ReplyCount++
+	} else {
+		ch.resp.LastMessageAt = &now
+	}
+	return m, nil
+}
+
+// message looks up the message addressed by the id path param.
+func (s *Server) message(c *call) (*message, *apiError) {
+	m, ok := s.messages[c.params["id"]]
+	if !ok {
+		return nil, errNotFound("message with id %s not found", c.params["id"])
+	}
+	return m, nil
+}
+
+func (s *Server) getMessage(c *call) (any, *apiError) {
+	m, err := s.message(c)
+	if err != nil {
+		return nil, err
+	}
+	// MessageWithChannelResponse is MessageResponse plus the channel.
+	doc := toMap(m.resp)
+	doc["channel"] = toMap(s.channelResponse(s.channels[m.resp.Cid]))
+	return map[string]any{"message": doc}, nil
+}
+
+func (s *Server) updateMessage(c *call) (any, *apiError) {
+	var req getstream.UpdateMessageRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	m, err := s.message(c)
+	if err != nil {
+		return nil, err
+	}
+	now := s.stamp()
+	text := ""
+	if req.Message.Text != nil {
+		text = *req.Message.Text
+	}
+	if text != m.resp.Text {
+		m.resp.MessageTextUpdatedAt = &now
+	}
+	m.resp.Text = text
+	m.resp.Custom = req.Message.Custom
+	if m.resp.Custom == nil {
+		m.resp.Custom = map[string]any{}
+	}
+	m.resp.Attachments = req.Message.Attachments
+	if m.resp.Attachments == nil {
+		m.resp.Attachments = []getstream.Attachment{}
+	}
+	if req.Message.Pinned != nil {
+		m.resp.Pinned = *req.Message.Pinned
+		m.resp.PinnedAt = nil
+		if m.resp.Pinned {
+			m.resp.PinnedAt = &now
+		}
+	}
+	m.resp.UpdatedAt = now
+	return getstream.UpdateMessageResponse{Message: m.resp}, nil
+}
+
+func (s *Server) deleteMessage(c *call) (any, *apiError) {
+	m, err := s.message(c)
+	if err != nil {
+		return nil, err
+	}
+	now := s.stamp()
+	if c.flag("hard") {
+		delete(s.messages, m.resp.ID)
+		ch := s.channels[m.resp.Cid]
+		ch.messageIDs = removeString(ch.messageIDs, m.resp.ID)
+	} else {
+		m.resp.Type = "deleted"
+		m.resp.DeletedAt = &now
+	}
+	resp := m.resp
+	resp.Type = "deleted"
+	resp.DeletedAt = &now
+	return getstream.DeleteMessageResponse{Message: resp}, nil
+}
+
+func (s *Server) sendReaction(c *call) (any, *apiError) {
+	var req getstream.SendReactionRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	m, err := s.message(c)
+	if err != nil {
+		return nil, err
+	}
+	r := req.Reaction
+	if r.Type == "" {
+		return nil, errInput("reaction.type is a required field")
+	}
+	user, err := s.actingUser("reaction", r.UserID, r.User)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.stamp()
+	reaction := getstream.ReactionResponse{
+		MessageID: m.resp.ID,
+		Type:      r.Type,
+		UserID:    user.ID,
+		User:      userResponse(user),
+		Score:     1,
+		Custom:    r.Custom,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if r.Score != nil {
+		reaction.Score = *r.Score
+	}
+	if reaction.Custom == nil {
+		reaction.Custom = map[string]any{}
+	}
+	unique := req.EnforceUnique != nil && *req.EnforceUnique
+	kept := m.reactions[:0]
+	for _, existing := range m.reactions {
+		if existing.UserID == user.ID && (unique || existing.Type == r.Type) {
+			if existing.Type == r.Type {
+				reaction.CreatedAt = existing.CreatedAt
+			}
+			continue
+		}
+		kept = append(kept, existing)
+	}
+	m.reactions = append(kept, reaction)
+	s.updateReactionCounts(m)
+	return getstream.SendReactionResponse{Message: m.resp, Reaction: reaction}, nil
+}
+
+func (s *Server) deleteReaction(c *call) (any, *apiError) {
+	m, err := s.message(c)
+	if err != nil {
+		return nil, err
+	}
+	userID := c.query.Get("user_id")
+	if userID == "" {
+		return nil, errInput("user_id is required when using server side auth")
+	}
+	for i, r := range m.reactions {
+		if r.UserID == userID && r.Type == c.params["type"] {
+			m.reactions = append(m.reactions[:i], m.reactions[i+1:]...)
+			s.updateReactionCounts(m)
+			return getstream.DeleteReactionResponse{Message: m.resp, Reaction: r}, nil
+		}
+	}
+	return nil, errNotFound("reaction %q by user %q does not exist", c.params["type"], userID)
+}
+
+func (s *Server) getReactions(c *call) (any, *apiError) {
+	m, err := s.message(c)
+	if err != nil {
+		return nil, err
+	}
+	reactions := newestFirst(m.reactions)
+	start, end := page(len(reactions), queryInt(c, "offset"), queryInt(c, "limit"))
+	return getstream.GetReactionsResponse{Reactions: reactions[start:end]}, nil
+}
+
+// updateReactionCounts recomputes the reaction summary of a message.
+func (s *Server) updateReactionCounts(m *message) {
+	m.resp.ReactionCounts = map[string]int{}
+	m.resp.ReactionScores = map[string]int{}
+	m.resp.ReactionGroups = map[string]*getstream.ReactionGroupResponse{}
+	for _, r := range m.reactions {
+		m.resp.ReactionCounts[r.Type]++
+		m.resp.ReactionScores[r.Type] += r.Score
+		g, ok := m.resp.ReactionGroups[r.Type]
+		if !ok {
+			g = &getstream.ReactionGroupResponse{
+				FirstReactionAt:   r.CreatedAt,
+				LatestReactionsBy: []getstream.ReactionGroupUserResponse{},
+			}
+			m.resp.ReactionGroups[r.Type] = g
+		}
+		g.Count++
+		g.SumScores += r.Score
+		if timeOf(r.CreatedAt).Before(timeOf(g.FirstReactionAt)) {
+			g.FirstReactionAt = r.CreatedAt
+		}
+		if !timeOf(r.CreatedAt).Before(timeOf(g.LastReactionAt)) {
+			g.LastReactionAt = r.CreatedAt
+		}
+	}
+	m.resp.LatestReactions = newestFirst(m.reactions)
+	m.resp.UpdatedAt = s.stamp()
+}
@@ -0,0 +1,380 @@
+package streamtest
+
+import (
+	"time"
+
+	"github.com/GetStream/getstream-go/v5"
+	"github.com/google/uuid"
+)
+
+func (s *Server) getOrCreateFeed(c *call) (any, *apiError) {
+	var req getstream.GetOrCreateFeedRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	user, err := s.actingUser("feed", req.UserID, req.User)
+	if err != nil {
+		return nil, err
+	}
+	fid := c.params["group"] + ":" + c.params["id"]
+	f, exists := s.feeds[fid]
+	if !exists {
+		now := s.stamp()
+		f = &getstream.FeedResponse{
+			Feed:      fid,
+			GroupID:   c.params["group"],
+			ID:        c.params["id"],
+			CreatedBy: userResponse(user),
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if d := req.Data; d != nil {
+			if d.Name != nil {
+				f.Name = *d.Name
+			}
+			if d.Description != nil {
+				f.Description = *d.Description
+			}
+			f.Visibility = d.Visibility
+			f.FilterTags = d.FilterTags
+			f.Custom = d.Custom
+		}
+		s.feeds[fid] = f
+		s.feedIDs = append(s.feedIDs, fid)
+	}
+
+	activities := s.timeline(fid)
+	start, end := page(len(activities), nil, req.Limit)
+	resp := getstream.GetOrCreateFeedResponse{
+		Created:              !exists,
+		Feed:                 s.feedResponse(f),
+		Activities:           activities[start:end],
+		AggregatedActivities: []getstream.AggregatedActivityResponse{},
+		Followers:            []getstream.FollowResponse{},
+		Following:            []getstream.FollowResponse{},
+		Members:              []getstream.FeedMemberResponse{},
+		PinnedActivities:     []getstream.ActivityPinResponse{},
+	}
+	for _, fl := range s.follows {
+		switch {
+		case fl.TargetFeed.Feed == fid:
+			resp.Followers = append(resp.Followers, s.followResponse(fl))
+		case fl.SourceFeed.Feed == fid:
+			resp.Following = append(resp.Following, s.followResponse(fl))
+		}
+	}
+	return resp, nil
+}
+
+// timeline is what reading a feed returns: the live activities added to it
+// or to the feeds it follows, newest first.
+func (s *Server) timeline(fid string) []getstream.ActivityResponse {
+	sources := map[string]bool{fid: true}
+	for _, fl := range s.follows {
+		if fl.SourceFeed.Feed == fid && fl.Status == "accepted" {
+			sources[fl.TargetFeed.Feed] = true
+		}
+	}
+	out := []getstream.ActivityResponse{}
+	for i := len(s.activityIDs) - 1; i >= 0; i-- {
+		a := s.activities[s.activityIDs[i]]
+		if a.DeletedAt != nil {
+			continue
+		}
+		for _, feed := range a.Feeds {
+			if sources[feed] {
+				out = append(out, *a)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// feedResponse is a feed with its counts filled in.
+func (s *Server) feedResponse(f *getstream.FeedResponse) getstream.FeedResponse {
+	resp := *f
+	resp.ActivityCount, resp.FollowerCount, resp.FollowingCount = 0, 0, 0
+	for _, id := range s.activityIDs {
+		if a := s.activities[id]; a.DeletedAt == nil && contains(a.Feeds, f.Feed) {
+			resp.ActivityCount++
+		}
+	}
+	for _, fl := range s.follows {
+		if fl.TargetFeed.Feed == f.Feed {
+			resp.FollowerCount++
+		}
+		if fl.SourceFeed.Feed == f.Feed {
+			resp.FollowingCount++
+		}
+	}
+	return resp
+}
+
+func (s *Server) feed(fid string) (*getstream.FeedResponse, *apiError) {
+	f, ok := s.feeds[fid]
+	if !ok {
+		return nil, errNotFound("feed %q not found", fid)
+	}
+	return f, nil
+}
+
+func (s *Server) addActivity(c *call) (any, *apiError) {
+	var req getstream.AddActivityRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Type == "" {
+		return nil, errInput("type is a required field")
+	}
+	if len(req.Feeds) == 0 {
+		return nil, errInput("feeds is a required field")
+	}
+	if req.UserID == nil || *req.UserID == "" {
+		return nil, errInput("user_id is a required field when using server side auth")
+	}
+	user, ok := s.users[*req.UserID]
+	if !ok {
+		if req.CreateUsers == nil || !*req.CreateUsers {
+			return nil, errInput("user %q does not exist", *req.UserID)
+		}
+		user = s.upsertUser(getstream.UserRequest{ID: *req.UserID})
+	}
+	for _, fid := range req.Feeds {
+		if _, err := s.feed(fid); err != nil {
+			return nil, err
+		}
+	}
+	id := uuid.NewString()
+	if req.ID != nil && *req.ID != "" {
+		id = *req.ID
+	}
+	if _, exists := s.activities[id]; exists {
+		return nil, errInput("activity with ID %s already exists", id)
+	}
+	mentioned := []getstream.UserResponse{}
+	for _, uid := range req.MentionedUserIds {
+		u, err := s.user(uid)
+		if err != nil {
+			return nil, err
+		}
+		mentioned = append(mentioned, userResponse(u))
+	}
+	var expires *getstream.Timestamp
+	if req.ExpiresAt != nil {
+		t, err := time.Parse(time.RFC3339, *req.ExpiresAt)
+		if err != nil {
+			return nil, errInput("expires_at must be an RFC3339 timestamp")
+		}
+		expires = &getstream.Timestamp{Time: &t}
+	}
+
+	now := s.stamp()
+	a := &getstream.ActivityResponse{
+		ID:              id,
+		Type:            req.Type,
+		Feeds:           req.Feeds,
+		Text:            req.Text,
+		User:            userResponse(user),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		ExpiresAt:       expires,
+		Visibility:      "public",
+		RestrictReplies: "everyone",
+		VisibilityTag:   req.VisibilityTag,
+		Location:        req.Location,
+		Attachments:     nonNil(req.Attachments),
+		FilterTags:      nonNil(req.FilterTags),
+		InterestTags:    nonNil(req.InterestTags),
+		MentionedUsers:  mentioned,
+		Custom:          nonNilMap(req.Custom),
+		SearchData:      nonNilMap(req.SearchData),
+		Comments:        []getstream.CommentResponse{},
+		LatestReactions: []getstream.FeedsReactionResponse{},
+		OwnBookmarks:    []getstream.BookmarkResponse{},
+		OwnReactions:    []getstream.FeedsReactionResponse{},
+		Collections:     map[string]getstream.EnrichedCollectionResponse{},
+		ReactionGroups:  map[string]getstream.FeedsReactionGroupResponse{},
+	}
+	if req.Visibility != nil {
+		a.Visibility = *req.Visibility
+	}
+	if req.RestrictReplies != nil {
+		a.RestrictReplies = *req.RestrictReplies
+	}
+	s.activities[id] = a
+	s.activityIDs = append(s.activityIDs, id)
+	return getstream.AddActivityResponse{Activity: *a}, nil
+}
+
+func (s *Server) activity(c *call) (*getstream.ActivityResponse, *apiError) {
+	a, ok := s.activities[c.params["id"]]
+	if !ok || a.DeletedAt != nil {
+		return nil, errNotFound("activity %s not found", c.params["id"])
+	}
+	return a, nil
+}
+
+func (s *Server) getActivity(c *call) (any, *apiError) {
+	a, err := s.activity(c)
+	if err != nil {
+		return nil, err
+	}
+	return getstream.GetActivityResponse{Activity: *a}, nil
+}
+
+func (s *Server) deleteActivity(c *call) (any, *apiError) {
+	a, err := s.activity(c)
+	if err != nil {
+		return nil, err
+	}
+	if c.flag("hard_delete") {
+		delete(s.activities, a.ID)
+		s.activityIDs = removeString(s.activityIDs, a.ID)
+	} else {
+		a.DeletedAt = s.stampPtr()
+	}
+	return getstream.DeleteActivityResponse{}, nil
+}
+
+func (s *Server) queryActivities(c *call) (any, *apiError) {
+	var req getstream.QueryActivitiesRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	withDeleted := req.IncludeSoftDeletedActivities != nil && *req.IncludeSoftDeletedActivities
+	var found []getstream.ActivityResponse
+	var docs []map[string]any
+	for i := len(s.activityIDs) - 1; i >= 0; i-- {
+		a := s.activities[s.activityIDs[i]]
+		if a.DeletedAt != nil && !withDeleted {
+			continue
+		}
+		doc := toMap(a)
+		doc["activity_type"] = a.Type
+		doc["user_id"] = a.User.ID
+		for k, v := range a.Custom {
+			if _, ok := doc[k]; !ok {
+				doc[k] = v
+			}
+		}
+		ok, err := matches(doc, req.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			found = append(found, *a)
+			docs = append(docs, doc)
+		}
+	}
+	sortDocs(found, docs, req.Sort)
+	start, end := page(len(found), nil, req.Limit)
+	return getstream.QueryActivitiesResponse{Activities: append([]getstream.ActivityResponse{}, found[start:end]...)}, nil
+}
+
+func (s *Server) follow(c *call) (any, *apiError) {
+	var req getstream.FollowRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Source == "" || req.Target == "" {
+		return nil, errInput("source and target are required fields")
+	}
+	if req.Source == req.Target {
+		return nil, errInput("a feed cannot follow itself")
+	}
+	for _, fid := range []string{req.Source, req.Target} {
+		if _, err := s.feed(fid); err != nil {
+			return nil, err
+		}
+	}
+	if s.findFollow(req.Source, req.Target) >= 0 {
+		return nil, errInput("%s already follows %s", req.Source, req.Target)
+	}
+	now := s.stamp()
+	fl := &getstream.FollowResponse{
+		SourceFeed:     getstream.FeedResponse{Feed: req.Source},
+		TargetFeed:     getstream.FeedResponse{Feed: req.Target},
+		Status:         "accepted",
+		FollowerRole:   "feed_follower",
+		PushPreference: "all",
+		Custom:         req.Custom,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if req.PushPreference != nil {
+		fl.PushPreference = *req.PushPreference
+	}
+	s.follows = append(s.follows, fl)
+	return getstream.SingleFollowResponse{Follow: s.followResponse(fl)}, nil
+}
+
+func (s *Server) unfollow(c *call) (any, *apiError) {
+	i := s.findFollow(c.params["source"], c.params["target"])
+	if i < 0 {
+		return nil, errNotFound("%s does not follow %s", c.params["source"], c.params["target"])
+	}
+	resp := s.followResponse(s.follows[i])
+	s.follows = append(s.follows[:i], s.follows[i+1:]...)
+	return getstream.UnfollowResponse{Follow: resp}, nil
+}
+
+func (s *Server) queryFollows(c *call) (any, *apiError) {
+	var req getstream.QueryFollowsRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	var found []getstream.FollowResponse
+	var docs []map[string]any
+	for _, fl := range s.follows {
+		doc := toMap(fl)
+		doc["source_feed"] = fl.SourceFeed.Feed
+		doc["target_feed"] = fl.TargetFeed.Feed
+		ok, err := matches(doc, req.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			found = append(found, s.followResponse(fl))
+			docs = append(docs, doc)
+		}
+	}
+	sortDocs(found, docs, req.Sort)
+	start, end := page(len(found), nil, req.Limit)
+	return getstream.QueryFollowsResponse{Follows: append([]getstream.FollowResponse{}, found[start:end]...)}, nil
+}
+
+func (s *Server) findFollow(source, target string) int {
+	for i, fl := range s.follows {
+		if fl.SourceFeed.Feed == source && fl.TargetFeed.Feed == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// followResponse embeds the current state of both feeds.
+func (s *Server) followResponse(fl *getstream.FollowResponse) getstream.FollowResponse {
+	resp := *fl
+	if f, ok := s.feeds[fl.SourceFeed.Feed]; ok {
+		resp.SourceFeed = s.feedResponse(f)
+	}
+	if f, ok := s.feeds[fl.TargetFeed.Feed]; ok {
+		resp.TargetFeed = s.feedResponse(f)
+	}
+	return resp
+}
+
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+func nonNilMap(m map[string]any) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+	return m
+}
@@ -0,0 +1,185 @@
+package streamtest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// matches evaluates a Stream query filter against doc, a JSON object
+// describing one item. It supports the field operators $eq, $ne, $in,
+// $nin, $gt, $gte, $lt, $lte, $exists, $contains and $autocomplete, and
+// the logical $and, $or and $nor. A filter on an array field matches when
+// any element does, so {"members": {"$in": ["john"]}} finds john's
+// channels.
+func matches(doc map[string]any, filter map[string]any) (bool, *apiError) {
+	for key, cond := range filter {
+		var ok bool
+		var err *apiError
+		switch key {
+		case "$and", "$or", "$nor":
+			ok, err = matchLogical(doc, key, cond)
+		default:
+			ok, err = matchField(doc[key], cond)
+		}
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func matchLogical(doc map[string]any, op string, cond any) (bool, *apiError) {
+	list, ok := cond.([]any)
+	if !ok {
+		return false, errInput("%s expects an array of filters", op)
+	}
+	matched := false
+	for _, c := range list {
+		sub, ok := c.(map[string]any)
+		if !ok {
+			return false, errInput("%s expects an array of filters", op)
+		}
+		m, err := matches(doc, sub)
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case op == "$and" && !m:
+			return false, nil
+		case op != "$and" && m:
+			matched = true
+		}
+	}
+	switch op {
+	case "$or":
+		return matched, nil
+	case "$nor":
+		return !matched, nil
+	}
+	return true, nil
+}
+
+func matchField(value, cond any) (bool, *apiError) {
+	ops, ok := cond.(map[string]any)
+	if !ok || !isOperatorMap(ops) {
+		return matchEq(value, cond), nil
+	}
+	for op, arg := range ops {
+		var m bool
+		switch op {
+		case "$eq":
+			m = matchEq(value, arg)
+		case "$ne":
+			m = !matchEq(value, arg)
+		case "$in", "$nin":
+			list, ok := arg.([]any)
+			if !ok {
+				return false, errInput("%s expects an array", op)
+			}
+			for _, v := range list {
+				if matchEq(value, v) {
+					m = true
+					break
+				}
+			}
+			if op == "$nin" {
+				m = !m
+			}
+		case "$gt", "$gte", "$lt", "$lte":
+			if value == nil {
+				return false, nil
+			}
+			c := compare(value, arg)
+			m = op == "$gt" && c > 0 || op == "$gte" && c >= 0 || op == "$lt" && c < 0 || op == "$lte" && c <= 0
+		case "$exists":
+			want, _ := arg.(bool)
+			m = (value != nil) == want
+		case "$contains":
+			list, _ := value.([]any)
+			for _, v := range list {
+				if matchEq(v, arg) {
+					m = true
+					break
+				}
+			}
+		case "$autocomplete", "$q":
+			s, _ := value.(string)
+			q, _ := arg.(string)
+			m = strings.Contains(strings.ToLower(s), strings.ToLower(q))
+		default:
+			return false, errInput("operator %s is not supported by streamtest", op)
+		}
+		if !m {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func isOperatorMap(m map[string]any) bool {
+	for k := range m {
+		if !strings.HasPrefix(k, "$") {
+			return false
+		}
+	}
+	return len(m) > 0
+}
+
+// matchEq compares value to want, matching any element of an array value.
+func matchEq(value, want any) bool {
+	if list, ok := value.([]any); ok {
+		if _, wantList := want.([]any); !wantList {
+			for _, v := range list {
+				if compare(v, want) == 0 {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return compare(value, want) == 0
+}
+
+// compare orders two JSON values: numbers numerically, strings lexically,
+// and a timestamp string against a nanosecond number by time. Values of
+// other or mismatched types are equal only if deeply equal.
+func compare(a, b any) int {
+	switch x := a.(type) {
+	case float64:
+		switch y := b.(type) {
+		case float64:
+			return compareFloat(x, y)
+		case string:
+			if t, err := time.Parse(time.RFC3339Nano, y); err == nil {
+				return compareFloat(x, float64(t.UnixNano()))
+			}
+		}
+	case string:
+		switch y := b.(type) {
+		case string:
+			return strings.Compare(x, y)
+		case float64:
+			return -compare(y, x)
+		}
+	case bool:
+		if y, ok := b.(bool); ok && x == y {
+			return 0
+		}
+	}
+	if reflect.DeepEqual(a, b) {
+		return 0
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func compareFloat(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,80 @@
+package streamtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The SDK's Timestamp marshals as an RFC3339 string but only unmarshals the
+// integer nanoseconds the API sends, so responses are encoded through a
+// generic pass that rewrites timestamps to nanoseconds, and request bodies
+// get the same pass before they are decoded into the SDK's types.
+
+// encodeJSON marshals v with its timestamps as nanoseconds.
+func encodeJSON(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return rewriteTimestamps(b, nil)
+}
+
+// decodeJSON unmarshals data into v, accepting RFC3339 or nanosecond
+// timestamps.
+func decodeJSON(data []byte, v any) error {
+	b, err := rewriteTimestamps(data, requestStringTimes)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// requestStringTimes are timestamp keys that request types declare as
+// strings, e.g. AddActivityRequest.ExpiresAt.
+var requestStringTimes = map[string]bool{"expires_at": true}
+
+func rewriteTimestamps(data []byte, keep map[string]bool) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(timestampsToNanos(doc, keep))
+}
+
+// timestampsToNanos converts RFC3339 strings under timestamp keys to
+// nanoseconds, except under keys in keep. Custom data is left alone.
+func timestampsToNanos(v any, keep map[string]bool) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, elem := range v {
+			if k == "custom" || k == "search_data" {
+				continue
+			}
+			if s, ok := elem.(string); ok && isTimestampKey(k) && !keep[k] {
+				if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+					v[k] = json.Number(strconv.FormatInt(t.UnixNano(), 10))
+					continue
+				}
+			}
+			v[k] = timestampsToNanos(elem, keep)
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = timestampsToNanos(elem, keep)
+		}
+	}
+	return v
+}
+
+func isTimestampKey(k string) bool {
+	switch k {
+	case "last_active", "ban_expires", "pin_expires", "revoke_tokens_issued_before", "hide_messages_before", "hide_history_before":
+		return true
+	}
+	return strings.HasSuffix(k, "_at")
+}
@@ -0,0 +1,427 @@
+// Package streamtest runs an in-process fake of the Stream API for hermetic
+// tests. It implements the core of the users, chat and feeds APIs (users,
+// channels, members, messages, reactions, feeds, activities and follows)
+// with in-memory state, responses shaped like the real API and the same
+// error envelope, so code under test can use a regular client:
+//
+//	fake := streamtest.New()
+//	defer fake.Close()
+//	client, _ := getstream.NewClient(fake.APIKey, fake.APISecret, getstream.WithBaseUrl(fake.URL))
+//
+// Tests can then assert on the recorded state (Users, Messages, Activities,
+// Follows, ...) or on the raw Requests. Endpoints without a fake answer 404
+// with a message naming the route.
+package streamtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GetStream/getstream-go/v5"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Default credentials of a Server; override them with WithCredentials.
+const (
+	DefaultAPIKey    = "streamtest-key"
+	DefaultAPISecret = "streamtest-secret"
+)
+
+// Stream API error codes used by the fake.
+const (
+	codeAuthKey      = 2
+	codeInput        = 4
+	codeAuthFailed   = 5
+	codeRateLimited  = 9
+	codeDoesNotExist = 16
+)
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithCredentials sets the API key and secret the Server accepts. Requests
+// must carry the key and a token signed with the secret, like the real API.
+func WithCredentials(apiKey, apiSecret string) Option {
+	return func(s *Server) {
+		s.APIKey = apiKey
+		s.APISecret = apiSecret
+	}
+}
+
+// WithClock sets the clock used for created_at/updated_at timestamps.
+// Default time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+// Request is a request received by the Server.
+type Request struct {
+	Method string
+	// Path is the unescaped URL path, e.g. /api/v2/chat/channels/messaging/general/message.
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Fault is a canned error returned instead of handling a request; see
+// FailNext.
+type Fault struct {
+	// StatusCode is the HTTP status. Default 500.
+	StatusCode int
+	// Code is the Stream error code in the body.
+	Code int
+	// Message defaults to the status text.
+	Message string
+	// Header is added to the response, e.g. Retry-After.
+	Header http.Header
+}
+
+// Server is an in-process fake Stream API. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL to pass to getstream.WithBaseUrl.
+	URL       string
+	APIKey    string
+	APISecret string
+
+	srv    *httptest.Server
+	now    func() time.Time
+	routes []route
+
+	mu       sync.Mutex
+	requests []Request
+	faults   map[string][]Fault
+	state
+}
+
+// New starts a Server. Call Close when done.
+func New(opts ...Option) *Server {
+	s := &Server{
+		APIKey:    DefaultAPIKey,
+		APISecret: DefaultAPISecret,
+		now:       time.Now,
+		faults:    map[string][]Fault{},
+		state:     newState(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.routes = s.buildRoutes()
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts the Server down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a client for the Server. opts are applied after the base
+// URL.
+func (s *Server) Client(opts ...getstream.ClientOption) (*getstream.Stream, error) {
+	return getstream.NewClient(s.APIKey, s.APISecret, append([]getstream.ClientOption{getstream.WithBaseUrl(s.URL)}, opts...)...)
+}
+
+// Requests returns the requests received so far, oldest first, including
+// rejected ones.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// FailNext makes the next request matching method and path (unescaped,
+// without query) fail with f instead of being handled. Faults queue up: call
+// it n times to fail the next n matching requests.
+func (s *Server) FailNext(method, path string, f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := method + " " + path
+	s.faults[key] = append(s.faults[key], f)
+}
+
+// Reset drops all state, recorded requests and pending faults.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.faults = map[string][]Fault{}
+	s.state = newState()
+}
+
+// apiError is the error envelope of the Stream API.
+type apiError struct {
+	status  int
+	code    int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func errInput(format string, args ...any) *apiError {
+	return &apiError{status: http.StatusBadRequest, code: codeInput, message: fmt.Sprintf(format, args...)}
+}
+
+func errNotFound(format string, args ...any) *apiError {
+	return &apiError{status: http.StatusNotFound, code: codeDoesNotExist, message: fmt.Sprintf(format, args...)}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, "", errInput("failed to read request body: %v", err))
+		return
+	}
+	path := r.URL.Path
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	fault, faulted := s.popFaultLocked(r.Method + " " + path)
+	s.mu.Unlock()
+
+	if faulted {
+		writeFault(w, fault)
+		return
+	}
+	rt, params := s.match(r.Method, r.URL.EscapedPath())
+	if rt == nil {
+		writeError(w, "", errNotFound("streamtest: no fake for %s %s", r.Method, path))
+		return
+	}
+	if apiErr := s.authenticate(r); apiErr != nil {
+		writeError(w, rt.name, apiErr)
+		return
+	}
+
+	req := &call{params: params, query: r.URL.Query(), body: body}
+	s.mu.Lock()
+	resp, apiErr := rt.handle(req)
+	s.mu.Unlock()
+	if apiErr != nil {
+		writeError(w, rt.name, apiErr)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) popFaultLocked(key string) (Fault, bool) {
+	queue := s.faults[key]
+	if len(queue) == 0 {
+		return Fault{}, false
+	}
+	s.faults[key] = queue[1:]
+	return queue[0], true
+}
+
+// authenticate checks the api_key and the server token like the real API.
+func (s *Server) authenticate(r *http.Request) *apiError {
+	if r.URL.Query().Get("api_key") != s.APIKey {
+		return &apiError{status: http.StatusUnauthorized, code: codeAuthKey, message: "api_key not found"}
+	}
+	token := r.Header.Get("Authorization")
+	if token == "" {
+		return &apiError{status: http.StatusUnauthorized, code: codeAuthFailed, message: "JWTAuth error: token is missing"}
+	}
+	_, err := jwt.Parse(token, func(*jwt.Token) (any, error) {
+		return []byte(s.APISecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return &apiError{status: http.StatusUnauthorized, code: codeAuthFailed, message: "JWTAuth error: " + err.Error()}
+	}
+	return nil
+}
+
+// call is a request being handled.
+type call struct {
+	params map[string]string
+	query  url.Values
+	body   []byte
+}
+
+// decode unmarshals the request body into v, accepting the RFC3339
+// timestamps the SDK sends.
+func (c *call) decode(v any) *apiError {
+	if len(bytes.TrimSpace(c.body)) == 0 {
+		return nil
+	}
+	if err := decodeJSON(c.body, v); err != nil {
+		return errInput("invalid request body: %v", err)
+	}
+	return nil
+}
+
+// payload unmarshals the JSON "payload" query parameter into v.
+func (c *call) payload(v any) *apiError {
+	raw := c.query.Get("payload")
+	if raw == "" {
+		return errInput("payload is required")
+	}
+	if err := decodeJSON([]byte(raw), v); err != nil {
+		return errInput("invalid payload: %v", err)
+	}
+	return nil
+}
+
+func (c *call) flag(name string) bool {
+	return c.query.Get(name) == "true"
+}
+
+// queryInt returns an integer query parameter, nil if absent or invalid.
+func queryInt(c *call, name string) *int {
+	n, err := strconv.Atoi(c.query.Get(name))
+	if err != nil {
+		return nil
+	}
+	return &n
+}
+
+// route maps a method and path pattern, with {param} segments, to a handler.
+type route struct {
+	method   string
+	segments []string
+	name     string
+	handle   func(*call) (any, *apiError)
+}
+
+func (s *Server) match(method, escapedPath string) (*route, map[string]string) {
+	parts := strings.Split(strings.Trim(escapedPath, "/"), "/")
+	for i := range s.routes {
+		rt := &s.routes[i]
+		if rt.method != method || len(rt.segments) != len(parts) {
+			continue
+		}
+		params := map[string]string{}
+		ok := true
+		for j, seg := range rt.segments {
+			part, err := url.PathUnescape(parts[j])
+			if err != nil {
+				ok = false
+				break
+			}
+			if strings.HasPrefix(seg, "{") {
+				params[strings.Trim(seg, "{}")] = part
+			} else if seg != part {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return rt, params
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) buildRoutes() []route {
+	add := func(routes []route, method, pattern, name string, handle func(*call) (any, *apiError)) []route {
+		return append(routes, route{
+			method:   method,
+			segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+			name:     name,
+			handle:   handle,
+		})
+	}
+	var rs []route
+	rs = add(rs, "POST", "/api/v2/users", "UpdateUsers", s.updateUsers)
+	rs = add(rs, "PATCH", "/api/v2/users", "UpdateUsersPartial", s.updateUsersPartial)
+	rs = add(rs, "GET", "/api/v2/users", "QueryUsers", s.queryUsers)
+
+	rs = add(rs, "POST", "/api/v2/chat/channels", "QueryChannels", s.queryChannels)
+	rs = add(rs, "POST", "/api/v2/chat/channels/{type}/{id}/query", "GetOrCreateChannel", s.getOrCreateChannel)
+	rs = add(rs, "POST", "/api/v2/chat/channels/{type}/{id}", "UpdateChannel", s.updateChannel)
+	rs = add(rs, "DELETE", "/api/v2/chat/channels/{type}/{id}", "DeleteChannel", s.deleteChannel)
+	rs = add(rs, "GET", "/api/v2/chat/members", "QueryMembers", s.queryMembers)
+	rs = add(rs, "POST", "/api/v2/chat/channels/{type}/{id}/message", "SendMessage", s.sendMessage)
+	rs = add(rs, "GET", "/api/v2/chat/messages/{id}", "GetMessage", s.getMessage)
+	rs = add(rs, "POST", "/api/v2/chat/messages/{id}", "UpdateMessage", s.updateMessage)
+	rs = add(rs, "DELETE", "/api/v2/chat/messages/{id}", "DeleteMessage", s.deleteMessage)
+	rs = add(rs, "POST", "/api/v2/chat/messages/{id}/reaction", "SendReaction", s.sendReaction)
+	rs = add(rs, "DELETE", "/api/v2/chat/messages/{id}/reaction/{type}", "DeleteReaction", s.deleteReaction)
+	rs = add(rs, "GET", "/api/v2/chat/messages/{id}/reactions", "GetReactions", s.getReactions)
+
+	rs = add(rs, "POST", "/api/v2/feeds/feed_groups/{group}/feeds/{id}", "GetOrCreateFeed", s.getOrCreateFeed)
+	rs = add(rs, "POST", "/api/v2/feeds/activities", "AddActivity", s.addActivity)
+	rs = add(rs, "POST", "/api/v2/feeds/activities/query", "QueryActivities", s.queryActivities)
+	rs = add(rs, "GET", "/api/v2/feeds/activities/{id}", "GetActivity", s.getActivity)
+	rs = add(rs, "DELETE", "/api/v2/feeds/activities/{id}", "DeleteActivity", s.deleteActivity)
+	rs = add(rs, "POST", "/api/v2/feeds/follows", "Follow", s.follow)
+	rs = add(rs, "POST", "/api/v2/feeds/follows/query", "QueryFollows", s.queryFollows)
+	rs = add(rs, "DELETE", "/api/v2/feeds/follows/{source}/{target}", "Unfollow", s.unfollow)
+	return rs
+}
+
+// writeJSON writes a response body. Every response carries a duration,
+// like the real API's.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	doc := toMap(v)
+	doc["duration"] = "0.00ms"
+	b, err := json.Marshal(doc)
+	if err != nil {
+		writeError(w, "", &apiError{status: http.StatusInternalServerError, code: -1, message: "streamtest: encode response: " + err.Error()})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+// writeError writes the API error envelope. Like the real API, the message
+// names the endpoint that failed.
+func writeError(w http.ResponseWriter, endpoint string, e *apiError) {
+	msg := e.message
+	if endpoint != "" {
+		msg = fmt.Sprintf("%s failed with error: %q", endpoint, e.message)
+	}
+	writeEnvelope(w, e.status, e.code, msg)
+}
+
+func writeFault(w http.ResponseWriter, f Fault) {
+	if f.StatusCode == 0 {
+		f.StatusCode = http.StatusInternalServerError
+	}
+	if f.Message == "" {
+		f.Message = http.StatusText(f.StatusCode)
+	}
+	if f.Code == 0 && f.StatusCode == http.StatusTooManyRequests {
+		f.Code = codeRateLimited
+	}
+	for k, vs := range f.Header {
+		for _, v := range vs {
+			w.Header().Add(k, v)
+		}
+	}
+	writeEnvelope(w, f.StatusCode, f.Code, f.Message)
+}
+
+func writeEnvelope(w http.ResponseWriter, status, code int, message string) {
+	b, _ := json.Marshal(getstream.APIError{
+		Code:       code,
+		Duration:   "0.00ms",
+		Message:    message,
+		MoreInfo:   "https://getstream.io/chat/docs/api_errors_response",
+		StatusCode: status,
+		Details:    []int{},
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
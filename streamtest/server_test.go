@@ -0,0 +1,232 @@
+package streamtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/GetStream/getstream-go/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, opts ...Option) (*Server, *getstream.Stream) {
+	t.Helper()
+	fake := New(opts...)
+	t.Cleanup(fake.Close)
+	client, err := getstream.NewClient(fake.APIKey, fake.APISecret, getstream.WithBaseUrl(fake.URL))
+	require.NoError(t, err)
+	return fake, client
+}
+
+func createUsers(t *testing.T, client *getstream.Stream, ids ...string) {
+	t.Helper()
+	users := map[string]getstream.UserRequest{}
+	for _, id := range ids {
+		users[id] = getstream.UserRequest{ID: id, Name: getstream.PtrTo("User " + id)}
+	}
+	_, err := client.UpdateUsers(context.Background(), &getstream.UpdateUsersRequest{Users: users})
+	require.NoError(t, err)
+}
+
+func TestServer_Users(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	fake, client := newTestServer(t, WithClock(func() time.Time { return now }))
+	ctx := context.Background()
+
+	resp, err := client.UpdateUsers(ctx, &getstream.UpdateUsersRequest{Users: map[string]getstream.UserRequest{
+		"john": {ID: "john", Role: getstream.PtrTo("admin"), Custom: map[string]any{"plan": "pro"}},
+		"jane": {ID: "jane"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, "admin", resp.Data.Users["john"].Role)
+	require.Equal(t, "user", resp.Data.Users["jane"].Role)
+	require.True(t, resp.Data.Users["john"].CreatedAt.Time.Equal(now))
+
+	_, err = client.UpdateUsersPartial(ctx, &getstream.UpdateUsersPartialRequest{Users: []getstream.UpdateUserPartialRequest{
+		{ID: "jane", Set: map[string]any{"name": "Jane", "plan": "free"}},
+	}})
+	require.NoError(t, err)
+	jane, ok := fake.User("jane")
+	require.True(t, ok)
+	require.Equal(t, "Jane", *jane.Name)
+	require.Equal(t, "free", jane.Custom["plan"])
+
+	query := func(filter map[string]any) []string {
+		resp, err := client.QueryUsers(ctx, &getstream.QueryUsersRequest{Payload: &getstream.QueryUsersPayload{
+			FilterConditions: filter,
+			Sort:             []getstream.SortParamRequest{{Field: getstream.PtrTo("id"), Direction: getstream.PtrTo(1)}},
+		}})
+		require.NoError(t, err)
+		var ids []string
+		for _, u := range resp.Data.Users {
+			ids = append(ids, u.ID)
+		}
+		return ids
+	}
+	require.Equal(t, []string{"jane", "john"}, query(map[string]any{"id": map[string]any{"$in": []string{"john", "jane"}}}))
+	require.Equal(t, []string{"john"}, query(map[string]any{"role": "admin"}))
+	require.Equal(t, []string{"jane"}, query(map[string]any{"plan": map[string]any{"$eq": "free"}}))
+
+	_, err = client.UpdateUsersPartial(ctx, &getstream.UpdateUsersPartialRequest{Users: []getstream.UpdateUserPartialRequest{
+		{ID: "ghost", Set: map[string]any{"name": "Boo"}},
+	}})
+	var se *getstream.StreamError
+	require.True(t, errors.As(err, &se))
+	require.Equal(t, http.StatusBadRequest, se.StatusCode)
+	require.Equal(t, 4, se.Code)
+	require.Contains(t, se.Message, "UpdateUsersPartial failed with error")
+}
+
+func TestServer_ChannelsMessagesReactions(t *testing.T) {
+	fake, client := newTestServer(t)
+	ctx := context.Background()
+	createUsers(t, client, "john", "jane", "jack")
+	ch := client.Chat().Channel("messaging", "general")
+
+	state, err := ch.GetOrCreate(ctx, &getstream.GetOrCreateChannelRequest{Data: &getstream.ChannelInput{
+		CreatedByID: getstream.PtrTo("john"),
+		Members:     []getstream.ChannelMemberRequest{{UserID: "john"}, {UserID: "jane"}},
+		Custom:      map[string]any{"topic": "news"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, "messaging:general", state.Data.Channel.Cid)
+	require.Len(t, state.Data.Members, 2)
+
+	_, err = ch.Update(ctx, &getstream.UpdateChannelRequest{AddMembers: []getstream.ChannelMemberRequest{{UserID: "jack"}}, RemoveMembers: []string{"jane"}})
+	require.NoError(t, err)
+	require.Len(t, fake.Members("messaging:general"), 2)
+
+	sent, err := ch.SendMessage(ctx, &getstream.SendMessageRequest{Message: getstream.MessageRequest{
+		ID: getstream.PtrTo("msg-1"), Text: getstream.PtrTo("hello"), UserID: getstream.PtrTo("john"),
+	}})
+	require.NoError(t, err)
+	require.Equal(t, "john", sent.Data.Message.User.ID)
+	require.NotNil(t, sent.Data.Message.CreatedAt.Time)
+
+	_, err = ch.SendMessage(ctx, &getstream.SendMessageRequest{Message: getstream.MessageRequest{
+		ID: getstream.PtrTo("msg-1"), Text: getstream.PtrTo("again"), UserID: getstream.PtrTo("john"),
+	}})
+	require.Error(t, err, "duplicate message IDs are rejected")
+	_, err = ch.SendMessage(ctx, &getstream.SendMessageRequest{Message: getstream.MessageRequest{Text: getstream.PtrTo("anon")}})
+	require.Error(t, err, "server-side messages need a user")
+
+	for _, r := range []struct{ user, typ string }{{"john", "like"}, {"jack", "like"}, {"jack", "love"}} {
+		_, err = client.Chat().SendReaction(ctx, "msg-1", &getstream.SendReactionRequest{
+			Reaction: getstream.ReactionRequest{Type: r.typ, UserID: getstream.PtrTo(r.user)},
+		})
+		require.NoError(t, err)
+	}
+	msg, err := client.Chat().GetMessage(ctx, "msg-1", &getstream.GetMessageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"like": 2, "love": 1}, msg.Data.Message.ReactionCounts)
+	require.Equal(t, "messaging:general", msg.Data.Message.Channel.Cid)
+
+	_, err = client.Chat().DeleteReaction(ctx, "msg-1", "like", &getstream.DeleteReactionRequest{UserID: getstream.PtrTo("jack")})
+	require.NoError(t, err)
+	require.Len(t, fake.Reactions("msg-1"), 2)
+	stored, _ := fake.Message("msg-1")
+	require.Equal(t, 1, stored.ReactionCounts["like"])
+
+	channels, err := client.Chat().QueryChannels(ctx, &getstream.QueryChannelsRequest{
+		FilterConditions: map[string]any{"members": map[string]any{"$in": []string{"jack"}}, "topic": "news"},
+	})
+	require.NoError(t, err)
+	require.Len(t, channels.Data.Channels, 1)
+	require.Len(t, channels.Data.Channels[0].Messages, 1)
+
+	_, err = client.Chat().DeleteMessage(ctx, "msg-1", &getstream.DeleteMessageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "deleted", fake.Messages("messaging:general")[0].Type)
+
+	_, err = client.Chat().Channel("messaging", "missing").SendMessage(ctx, &getstream.SendMessageRequest{Message: getstream.MessageRequest{UserID: getstream.PtrTo("john")}})
+	var se *getstream.StreamError
+	require.True(t, errors.As(err, &se))
+	require.Equal(t, http.StatusNotFound, se.StatusCode)
+	require.Equal(t, 16, se.Code)
+}
+
+func TestServer_Feeds(t *testing.T) {
+	fake, client := newTestServer(t)
+	ctx := context.Background()
+	createUsers(t, client, "john", "jane")
+	feeds := client.Feeds()
+
+	for _, f := range []struct{ group, id, user string }{{"user", "john", "john"}, {"user", "jane", "jane"}, {"timeline", "jane", "jane"}} {
+		resp, err := feeds.GetOrCreateFeed(ctx, f.group, f.id, &getstream.GetOrCreateFeedRequest{UserID: getstream.PtrTo(f.user)})
+		require.NoError(t, err)
+		require.True(t, resp.Data.Created)
+	}
+	_, err := feeds.Follow(ctx, &getstream.FollowRequest{Source: "timeline:jane", Target: "user:john"})
+	require.NoError(t, err)
+	_, err = feeds.Follow(ctx, &getstream.FollowRequest{Source: "timeline:jane", Target: "user:john"})
+	require.Error(t, err, "duplicate follows are rejected")
+
+	added, err := feeds.AddActivity(ctx, &getstream.AddActivityRequest{
+		Type: "post", Feeds: []string{"user:john"}, Text: getstream.PtrTo("hi"), UserID: getstream.PtrTo("john"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "john", added.Data.Activity.User.ID)
+
+	_, err = feeds.AddActivity(ctx, &getstream.AddActivityRequest{Type: "post", Feeds: []string{"user:nobody"}, UserID: getstream.PtrTo("john")})
+	require.Error(t, err)
+
+	timeline, err := feeds.GetOrCreateFeed(ctx, "timeline", "jane", &getstream.GetOrCreateFeedRequest{UserID: getstream.PtrTo("jane")})
+	require.NoError(t, err)
+	require.False(t, timeline.Data.Created)
+	require.Len(t, timeline.Data.Activities, 1, "followed activities show up on the timeline")
+	require.Equal(t, 1, timeline.Data.Feed.FollowingCount)
+
+	follows, err := feeds.QueryFollows(ctx, &getstream.QueryFollowsRequest{Filter: map[string]any{"target_feed": "user:john"}})
+	require.NoError(t, err)
+	require.Len(t, follows.Data.Follows, 1)
+
+	found, err := feeds.QueryActivities(ctx, &getstream.QueryActivitiesRequest{Filter: map[string]any{"activity_type": "post", "user_id": "john"}})
+	require.NoError(t, err)
+	require.Len(t, found.Data.Activities, 1)
+
+	_, err = feeds.Unfollow(ctx, "timeline:jane", "user:john", &getstream.UnfollowRequest{})
+	require.NoError(t, err)
+	require.Empty(t, fake.Follows())
+	_, err = feeds.DeleteActivity(ctx, added.Data.Activity.ID, &getstream.DeleteActivityRequest{})
+	require.NoError(t, err)
+	_, err = feeds.GetActivity(ctx, added.Data.Activity.ID, &getstream.GetActivityRequest{})
+	require.Error(t, err)
+	require.Len(t, fake.Activities("user:john"), 1, "soft-deleted activities stay in the recorded state")
+}
+
+func TestServer_AuthFaultsAndRequests(t *testing.T) {
+	fake, client := newTestServer(t)
+	ctx := context.Background()
+
+	wrong, err := getstream.NewClient(fake.APIKey, "wrong-secret", getstream.WithBaseUrl(fake.URL))
+	require.NoError(t, err)
+	_, err = wrong.UpdateUsers(ctx, &getstream.UpdateUsersRequest{Users: map[string]getstream.UserRequest{"john": {ID: "john"}}})
+	var se *getstream.StreamError
+	require.True(t, errors.As(err, &se))
+	require.Equal(t, http.StatusUnauthorized, se.StatusCode)
+
+	fake.FailNext("POST", "/api/v2/users", Fault{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"1"}}})
+	_, err = client.UpdateUsers(ctx, &getstream.UpdateUsersRequest{Users: map[string]getstream.UserRequest{"john": {ID: "john"}}})
+	require.True(t, errors.Is(err, getstream.ErrRateLimited))
+	createUsers(t, client, "john")
+
+	_, err = client.Chat().QueryMembers(ctx, &getstream.QueryMembersRequest{Payload: &getstream.QueryMembersPayload{Type: "messaging", ID: getstream.PtrTo("none")}})
+	require.Error(t, err)
+	_, err = client.Moderation().GetConfig(ctx, "any", &getstream.GetConfigRequest{})
+	require.True(t, errors.As(err, &se))
+	require.Contains(t, se.Message, "no fake for GET")
+
+	reqs := fake.Requests()
+	require.Len(t, reqs, 5)
+	var body getstream.UpdateUsersRequest
+	require.NoError(t, json.Unmarshal(reqs[2].Body, &body))
+	require.Contains(t, body.Users, "john")
+	require.Equal(t, fake.APIKey, reqs[2].Query.Get("api_key"))
+
+	fake.Reset()
+	require.Empty(t, fake.Users())
+	require.Empty(t, fake.Requests())
+}
@@ -0,0 +1,308 @@
+package streamtest
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/GetStream/getstream-go/v5"
+)
+
+// state is the fake's in-memory data, guarded by Server.mu. Slices of IDs
+// keep insertion order, which is the default order of query results.
+type state struct {
+	users   map[string]*getstream.FullUserResponse
+	userIDs []string
+
+	channels    map[string]*channel
+	channelCIDs []string
+	messages    map[string]*message
+
+	feeds       map[string]*getstream.FeedResponse
+	feedIDs     []string
+	activities  map[string]*getstream.ActivityResponse
+	activityIDs []string
+	follows     []*getstream.FollowResponse
+}
+
+type channel struct {
+	resp       getstream.ChannelResponse
+	members    []*getstream.ChannelMemberResponse
+	messageIDs []string
+}
+
+type message struct {
+	resp      getstream.MessageResponse
+	reactions []getstream.ReactionResponse
+}
+
+func newState() state {
+	return state{
+		users:      map[string]*getstream.FullUserResponse{},
+		channels:   map[string]*channel{},
+		messages:   map[string]*message{},
+		feeds:      map[string]*getstream.FeedResponse{},
+		activities: map[string]*getstream.ActivityResponse{},
+	}
+}
+
+// User returns the stored user.
+func (s *Server) User(id string) (getstream.FullUserResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.users[id]
+	if !ok {
+		return getstream.FullUserResponse{}, false
+	}
+	return clone(*u), true
+}
+
+// Users returns all users in creation order.
+func (s *Server) Users() []getstream.FullUserResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]getstream.FullUserResponse, 0, len(s.userIDs))
+	for _, id := range s.userIDs {
+		out = append(out, clone(*s.users[id]))
+	}
+	return out
+}
+
+// Channel returns the channel with the given cid, e.g. "messaging:general".
+// Deleted channels are gone.
+func (s *Server) Channel(cid string) (getstream.ChannelResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch, ok := s.channels[cid]
+	if !ok {
+		return getstream.ChannelResponse{}, false
+	}
+	return clone(s.channelResponse(ch)), true
+}
+
+// Members returns the members of a channel in the order they joined.
+func (s *Server) Members(cid string) []getstream.ChannelMemberResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch, ok := s.channels[cid]
+	if !ok {
+		return nil
+	}
+	return clone(s.memberResponses(ch))
+}
+
+// Messages returns the messages of a channel, oldest first, including
+// replies and soft-deleted messages.
+func (s *Server) Messages(cid string) []getstream.MessageResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch, ok := s.channels[cid]
+	if !ok {
+		return nil
+	}
+	out := make([]getstream.MessageResponse, 0, len(ch.messageIDs))
+	for _, id := range ch.messageIDs {
+		out = append(out, s.messages[id].resp)
+	}
+	return clone(out)
+}
+
+// Message returns a message by ID.
+func (s *Server) Message(id string) (getstream.MessageResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.messages[id]
+	if !ok {
+		return getstream.MessageResponse{}, false
+	}
+	return clone(m.resp), true
+}
+
+// Reactions returns the reactions to a message, newest first.
+func (s *Server) Reactions(messageID string) []getstream.ReactionResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.messages[messageID]
+	if !ok {
+		return nil
+	}
+	return clone(newestFirst(m.reactions))
+}
+
+// Feed returns the feed with the given ID, e.g. "user:john".
+func (s *Server) Feed(fid string) (getstream.FeedResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.feeds[fid]
+	if !ok {
+		return getstream.FeedResponse{}, false
+	}
+	return clone(s.feedResponse(f)), true
+}
+
+// Activities returns the activities added to a feed, or to any feed if fid
+// is empty, oldest first. Soft-deleted activities are included.
+func (s *Server) Activities(fid string) []getstream.ActivityResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []getstream.ActivityResponse
+	for _, id := range s.activityIDs {
+		a := s.activities[id]
+		if fid == "" || contains(a.Feeds, fid) {
+			out = append(out, *a)
+		}
+	}
+	return clone(out)
+}
+
+// Activity returns an activity by ID.
+func (s *Server) Activity(id string) (getstream.ActivityResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.activities[id]
+	if !ok {
+		return getstream.ActivityResponse{}, false
+	}
+	return clone(*a), true
+}
+
+// Follows returns all follows, oldest first.
+func (s *Server) Follows() []getstream.FollowResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]getstream.FollowResponse, 0, len(s.follows))
+	for _, f := range s.follows {
+		out = append(out, s.followResponse(f))
+	}
+	return clone(out)
+}
+
+// stamp returns the current time as a Timestamp.
+func (s *Server) stamp() getstream.Timestamp {
+	t := s.now().UTC()
+	return getstream.Timestamp{Time: &t}
+}
+
+func (s *Server) stampPtr() *getstream.Timestamp {
+	ts := s.stamp()
+	return &ts
+}
+
+// clone deep-copies v so callers never alias the fake's state.
+func clone[T any](v T) T {
+	b, err := encodeJSON(v)
+	if err != nil {
+		panic("streamtest: clone: " + err.Error())
+	}
+	var out T
+	if err := json.Unmarshal(b, &out); err != nil {
+		panic("streamtest: clone: " + err.Error())
+	}
+	return out
+}
+
+// toMap returns v as a JSON object.
+func toMap(v any) map[string]any {
+	b, err := encodeJSON(v)
+	if err != nil {
+		panic("streamtest: toMap: " + err.Error())
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		panic("streamtest: toMap: " + err.Error())
+	}
+	return m
+}
+
+func mustJSON(v any) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic("streamtest: " + err.Error())
+	}
+	return b
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(list []string, s string) []string {
+	out := list[:0]
+	for _, v := range list {
+		if v != s {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func newestFirst(reactions []getstream.ReactionResponse) []getstream.ReactionResponse {
+	out := make([]getstream.ReactionResponse, 0, len(reactions))
+	for i := len(reactions) - 1; i >= 0; i-- {
+		out = append(out, reactions[i])
+	}
+	return out
+}
+
+func timeOf(ts getstream.Timestamp) time.Time {
+	if ts.Time == nil {
+		return time.Time{}
+	}
+	return *ts.Time
+}
+
+// page applies offset and limit (when > 0) to n items and returns the
+// bounds.
+func page(n int, offset, limit *int) (int, int) {
+	start, end := 0, n
+	if offset != nil && *offset > 0 {
+		start = *offset
+	}
+	if start > n {
+		start = n
+	}
+	if limit != nil && *limit > 0 && start+*limit < end {
+		end = start + *limit
+	}
+	return start, end
+}
+
+// sortDocs orders items by sort, comparing the items' filter documents.
+// Without sort the order is unchanged.
+func sortDocs[T any](items []T, docs []map[string]any, by []getstream.SortParamRequest) {
+	if len(by) == 0 {
+		return
+	}
+	idx := make([]int, len(items))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		for _, p := range by {
+			if p.Field == nil {
+				continue
+			}
+			c := compare(docs[idx[a]][*p.Field], docs[idx[b]][*p.Field])
+			if c == 0 {
+				continue
+			}
+			if p.Direction != nil && *p.Direction < 0 {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+	sortedItems := make([]T, len(items))
+	sortedDocs := make([]map[string]any, len(docs))
+	for i, j := range idx {
+		sortedItems[i], sortedDocs[i] = items[j], docs[j]
+	}
+	copy(items, sortedItems)
+	copy(docs, sortedDocs)
+}
@@ -0,0 +1,215 @@
+package streamtest
+
+import (
+	"github.com/GetStream/getstream-go/v5"
+)
+
+// userFields are the user fields that UpdateUsersPartial sets directly;
+// other keys go to custom data.
+var userFields = map[string]bool{
+	"name": true, "image": true, "role": true, "language": true, "teams": true,
+	"invisible": true, "teams_role": true, "privacy_settings": true,
+}
+
+func (s *Server) updateUsers(c *call) (any, *apiError) {
+	var req getstream.UpdateUsersRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if len(req.Users) == 0 {
+		return nil, errInput("users is a required field")
+	}
+	for key, u := range req.Users {
+		if u.ID == "" {
+			return nil, errInput("users[%s].id is a required field", key)
+		}
+	}
+	resp := getstream.UpdateUsersResponse{Users: map[string]getstream.FullUserResponse{}}
+	for _, u := range req.Users {
+		resp.Users[u.ID] = *s.upsertUser(u)
+	}
+	return resp, nil
+}
+
+// upsertUser replaces a user, keeping its creation time, or creates it.
+func (s *Server) upsertUser(u getstream.UserRequest) *getstream.FullUserResponse {
+	now := s.stamp()
+	stored, ok := s.users[u.ID]
+	if !ok {
+		stored = &getstream.FullUserResponse{ID: u.ID, CreatedAt: now}
+		s.users[u.ID] = stored
+		s.userIDs = append(s.userIDs, u.ID)
+	}
+	*stored = getstream.FullUserResponse{
+		ID:              u.ID,
+		CreatedAt:       stored.CreatedAt,
+		UpdatedAt:       now,
+		Role:            "user",
+		Name:            u.Name,
+		Image:           u.Image,
+		Teams:           u.Teams,
+		TeamsRole:       u.TeamsRole,
+		Custom:          u.Custom,
+		PrivacySettings: u.PrivacySettings,
+		BlockedUserIds:  []string{},
+		ChannelMutes:    []getstream.ChannelMute{},
+		Devices:         []getstream.DeviceResponse{},
+		Mutes:           []getstream.UserMuteResponse{},
+	}
+	if u.Role != nil {
+		stored.Role = *u.Role
+	}
+	if u.Language != nil {
+		stored.Language = *u.Language
+	}
+	if u.Invisible != nil {
+		stored.Invisible = *u.Invisible
+	}
+	if stored.Teams == nil {
+		stored.Teams = []string{}
+	}
+	if stored.Custom == nil {
+		stored.Custom = map[string]any{}
+	}
+	return stored
+}
+
+func (s *Server) updateUsersPartial(c *call) (any, *apiError) {
+	var req getstream.UpdateUsersPartialRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	// Validate everything first so a bad entry changes nothing.
+	for _, p := range req.Users {
+		if p.ID == "" {
+			return nil, errInput("users[].id is a required field")
+		}
+		if _, ok := s.users[p.ID]; !ok {
+			return nil, errInput("user %q does not exist", p.ID)
+		}
+		for k := range p.Set {
+			if k == "id" || k == "created_at" || k == "updated_at" {
+				return nil, errInput("field %q cannot be updated", k)
+			}
+		}
+	}
+	resp := getstream.UpdateUsersResponse{Users: map[string]getstream.FullUserResponse{}}
+	for _, p := range req.Users {
+		u := s.users[p.ID]
+		doc := toMap(u)
+		custom, _ := doc["custom"].(map[string]any)
+		if custom == nil {
+			custom = map[string]any{}
+		}
+		for k, v := range p.Set {
+			if userFields[k] {
+				doc[k] = v
+			} else {
+				custom[k] = v
+			}
+		}
+		for _, k := range p.Unset {
+			if userFields[k] {
+				delete(doc, k)
+			} else {
+				delete(custom, k)
+			}
+		}
+		doc["custom"] = custom
+		var updated getstream.FullUserResponse
+		if err := decodeJSON(mustJSON(doc), &updated); err != nil {
+			return nil, errInput("invalid value in set: %v", err)
+		}
+		updated.UpdatedAt = s.stamp()
+		if updated.Role == "" {
+			updated.Role = "user"
+		}
+		*u = updated
+		resp.Users[u.ID] = *u
+	}
+	return resp, nil
+}
+
+func (s *Server) queryUsers(c *call) (any, *apiError) {
+	var q getstream.QueryUsersPayload
+	if err := c.payload(&q); err != nil {
+		return nil, err
+	}
+	var users []getstream.FullUserResponse
+	var docs []map[string]any
+	for _, id := range s.userIDs {
+		u := s.users[id]
+		doc := userDoc(u)
+		ok, err := matches(doc, q.FilterConditions)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			users = append(users, *u)
+			docs = append(docs, doc)
+		}
+	}
+	sortDocs(users, docs, q.Sort)
+	start, end := page(len(users), q.Offset, q.Limit)
+	return getstream.QueryUsersResponse{Users: append([]getstream.FullUserResponse{}, users[start:end]...)}, nil
+}
+
+// userDoc is what user filters and sorts see: the user's fields with its
+// custom data at the top level.
+func userDoc(u *getstream.FullUserResponse) map[string]any {
+	doc := toMap(u)
+	for k, v := range u.Custom {
+		if _, ok := doc[k]; !ok {
+			doc[k] = v
+		}
+	}
+	return doc
+}
+
+// user looks up a user referenced by a request.
+func (s *Server) user(id string) (*getstream.FullUserResponse, *apiError) {
+	u, ok := s.users[id]
+	if !ok {
+		return nil, errInput("user %q does not exist", id)
+	}
+	return u, nil
+}
+
+// userResponse is the embedded form of a user in messages, members,
+// reactions and activities.
+func userResponse(u *getstream.FullUserResponse) getstream.UserResponse {
+	return getstream.UserResponse{
+		ID:             u.ID,
+		Banned:         u.Banned,
+		CreatedAt:      u.CreatedAt,
+		UpdatedAt:      u.UpdatedAt,
+		Invisible:      u.Invisible,
+		Language:       u.Language,
+		Online:         u.Online,
+		Role:           u.Role,
+		ShadowBanned:   u.ShadowBanned,
+		BlockedUserIds: u.BlockedUserIds,
+		Teams:          u.Teams,
+		Custom:         u.Custom,
+		Name:           u.Name,
+		Image:          u.Image,
+		DeactivatedAt:  u.DeactivatedAt,
+		DeletedAt:      u.DeletedAt,
+		LastActive:     u.LastActive,
+		TeamsRole:      u.TeamsRole,
+	}
+}
+
+// actingUser resolves the user_id or user.id a server-side request acts as.
+func (s *Server) actingUser(field string, userID *string, user *getstream.UserRequest) (*getstream.FullUserResponse, *apiError) {
+	id := ""
+	if userID != nil {
+		id = *userID
+	} else if user != nil {
+		id = user.ID
+	}
+	if id == "" {
+		return nil, errInput("either %s.user or %s.user_id must be provided when using server side auth", field, field)
+	}
+	return s.user(id)
+}
@@ -0,0 +1,248 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// registeredTokenClaims are the claims TokenClaims exposes as fields; every
+// other claim ends up in CustomClaims.
+var registeredTokenClaims = map[string]bool{
+	"user_id":      true,
+	"role":         true,
+	"channel_cids": true,
+	"call_cids":    true,
+	"iat":          true,
+	"exp":          true,
+}
+
+// TokenClaims are the claims of a verified user token. The embedded Claims
+// hold the same role, channel_cids, call_cids and custom claims that
+// CreateToken accepts via WithClaims.
+type TokenClaims struct {
+	// UserID is the user the token was issued to.
+	UserID string
+	Claims
+	// IssuedAt is the token's iat, or the zero time if it has none.
+	IssuedAt time.Time
+	// ExpiresAt is the token's exp, or the zero time if it never expires.
+	ExpiresAt time.Time
+	// Secret is the API secret the token was signed with (see
+	// WithSecondarySecrets).
+	Secret SecretMatch
+}
+
+// VerifyTokenOption configures Stream.VerifyToken.
+type VerifyTokenOption func(*verifyTokenConfig)
+
+type verifyTokenConfig struct {
+	leeway          time.Duration
+	revocationCheck bool
+}
+
+// WithTokenLeeway tolerates clock skew of up to d when checking exp.
+// Default 0.
+func WithTokenLeeway(d time.Duration) VerifyTokenOption {
+	return func(c *verifyTokenConfig) {
+		c.leeway = d
+	}
+}
+
+// WithTokenRevocationCheck also rejects tokens issued before the app's or
+// the user's RevokeTokensIssuedBefore, failing with ErrTokenRevoked. Tokens
+// without iat are rejected once a revocation date is set. The check costs a
+// GetApp and a QueryUsers call; WithResponseCache serves the former from
+// cache.
+func WithTokenRevocationCheck() VerifyTokenOption {
+	return func(c *verifyTokenConfig) {
+		c.revocationCheck = true
+	}
+}
+
+// ParseToken checks that token is a user JWT signed with HS256 and one of
+// the app's secrets and not expired, and returns its claims. It makes no API
+// calls.
+//
+// Failures are *StreamError matching ErrTokenInvalid and one of
+// ErrTokenMalformed, ErrTokenSignatureInvalid or ErrTokenExpired. A token
+// with a bad signature is never reported as expired.
+func (s *Stream) ParseToken(token string) (*TokenClaims, error) {
+	return s.parseToken(token, 0)
+}
+
+// VerifyToken is ParseToken with options, e.g. to tolerate clock skew or to
+// check the token against revocations (WithTokenRevocationCheck).
+//
+//	claims, err := client.VerifyToken(ctx, token, getstream.WithTokenRevocationCheck())
+//	if errors.Is(err, getstream.ErrTokenInvalid) {
+//		// 401
+//	}
+//	if claims.Role != "admin" {
+//		// 403
+//	}
+func (s *Stream) VerifyToken(ctx context.Context, token string, opts ...VerifyTokenOption) (*TokenClaims, error) {
+	cfg := &verifyTokenConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	claims, err := s.parseToken(token, cfg.leeway)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.revocationCheck {
+		if err := s.checkTokenRevocation(ctx, claims); err != nil {
+			return nil, err
+		}
+	}
+	return claims, nil
+}
+
+func (s *Stream) parseToken(token string, leeway time.Duration) (*TokenClaims, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithLeeway(leeway),
+	)
+	// Try each secret in turn: only a signature mismatch moves on to the
+	// next, so expiry is judged under the secret that signed the token.
+	var err error
+	for i, secret := range s.apiSecrets() {
+		mc := jwt.MapClaims{}
+		_, err = parser.ParseWithClaims(token, mc, func(*jwt.Token) (any, error) {
+			return secret, nil
+		})
+		if err == nil {
+			claims, err := newTokenClaims(mc)
+			if err != nil {
+				return nil, err
+			}
+			claims.Secret = SecretMatch(i)
+			return claims, nil
+		}
+		if !errors.Is(err, jwt.ErrTokenSignatureInvalid) {
+			break
+		}
+	}
+	return nil, classifyTokenError(err)
+}
+
+// classifyTokenError maps a jwt parse failure to the token sentinels.
+func classifyTokenError(err error) *StreamError {
+	sentinel, msg := ErrTokenInvalid, "stream: invalid token: "
+	switch {
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		sentinel, msg = ErrTokenMalformed, "stream: malformed token: "
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid), errors.Is(err, jwt.ErrTokenUnverifiable):
+		sentinel, msg = ErrTokenSignatureInvalid, "stream: token signature invalid: "
+	case errors.Is(err, jwt.ErrTokenExpired):
+		sentinel, msg = ErrTokenExpired, "stream: token expired: "
+	}
+	return tokenError(sentinel, msg+err.Error(), err)
+}
+
+func tokenError(sentinel error, msg string, cause error) *StreamError {
+	if cause == nil {
+		cause = errors.New(msg)
+	}
+	return &StreamError{
+		sentinel: sentinel,
+		Message:  msg,
+		cause:    stackWrap(cause, "token verification"),
+	}
+}
+
+func newTokenClaims(mc jwt.MapClaims) (*TokenClaims, error) {
+	userID, _ := mc["user_id"].(string)
+	if userID == "" {
+		return nil, tokenError(ErrTokenMalformed, "stream: malformed token: missing user_id claim", nil)
+	}
+	tc := &TokenClaims{UserID: userID}
+	tc.Role, _ = mc["role"].(string)
+	tc.ChannelCIDs = tokenStringSlice(mc["channel_cids"])
+	tc.CallCIDs = tokenStringSlice(mc["call_cids"])
+	if iat, err := mc.GetIssuedAt(); err == nil && iat != nil {
+		tc.IssuedAt = iat.Time
+	}
+	if exp, err := mc.GetExpirationTime(); err == nil && exp != nil {
+		tc.ExpiresAt = exp.Time
+	}
+	for k, v := range mc {
+		if registeredTokenClaims[k] {
+			continue
+		}
+		if tc.CustomClaims == nil {
+			tc.CustomClaims = map[string]interface{}{}
+		}
+		tc.CustomClaims[k] = v
+	}
+	return tc, nil
+}
+
+func tokenStringSlice(v any) []string {
+	items, _ := v.([]any)
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// checkTokenRevocation fails with ErrTokenRevoked when the token predates the
+// app-wide or the user's revocation date.
+func (s *Stream) checkTokenRevocation(ctx context.Context, claims *TokenClaims) error {
+	app, err := s.GetApp(ctx, &GetAppRequest{})
+	if err != nil {
+		return err
+	}
+	if err := tokenRevokedBy(claims, app.Data.App.RevokeTokensIssuedBefore, "app"); err != nil {
+		return err
+	}
+	userRevoked, err := s.userTokensRevokedBefore(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+	return tokenRevokedBy(claims, &Timestamp{Time: &userRevoked}, "user")
+}
+
+// userTokensRevokedBefore returns the user's RevokeTokensIssuedBefore, or the
+// zero time if none is set or the user does not exist.
+func (s *Stream) userTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	res, err := s.QueryUsers(ctx, &QueryUsersRequest{Payload: &QueryUsersPayload{
+		FilterConditions:        map[string]any{"id": userID},
+		IncludeDeactivatedUsers: PtrTo(true),
+		Limit:                   PtrTo(1),
+	}})
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, u := range res.Data.Users {
+		if u.ID == userID && u.RevokeTokensIssuedBefore != nil && u.RevokeTokensIssuedBefore.Time != nil {
+			return *u.RevokeTokensIssuedBefore.Time, nil
+		}
+	}
+	return time.Time{}, nil
+}
+
+func tokenRevokedBy(claims *TokenClaims, revokedBefore *Timestamp, scope string) error {
+	if revokedBefore == nil || revokedBefore.Time == nil || revokedBefore.Time.IsZero() {
+		return nil
+	}
+	// iat has second precision, so a token minted in the same second as the
+	// revocation stays valid.
+	if !claims.IssuedAt.IsZero() && !claims.IssuedAt.Before(revokedBefore.Time.Truncate(time.Second)) {
+		return nil
+	}
+	msg := fmt.Sprintf("stream: token revoked: issued before the %s's revoke_tokens_issued_before (%s)", scope, revokedBefore.Time.UTC().Format(time.RFC3339))
+	if claims.IssuedAt.IsZero() {
+		msg = fmt.Sprintf("stream: token revoked: no iat and the %s revokes tokens issued before %s", scope, revokedBefore.Time.UTC().Format(time.RFC3339))
+	}
+	return tokenError(ErrTokenRevoked, msg, nil)
+}
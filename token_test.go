@@ -0,0 +1,159 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func newTokenTestClient(t *testing.T, opts ...ClientOption) *Stream {
+	t.Helper()
+	c, err := NewClient("key", "secret", opts...)
+	require.NoError(t, err)
+	return c
+}
+
+func signTestToken(t *testing.T, method jwt.SigningMethod, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+func TestParseToken_RoundTrip(t *testing.T) {
+	c := newTokenTestClient(t)
+	token, err := c.CreateToken("john",
+		WithExpiration(time.Hour),
+		WithClaims(Claims{
+			Role:         "admin",
+			ChannelCIDs:  []string{"messaging:general"},
+			CallCIDs:     []string{"default:standup"},
+			CustomClaims: map[string]interface{}{"tenant": "acme"},
+		}),
+	)
+	require.NoError(t, err)
+
+	claims, err := c.ParseToken(token)
+	require.NoError(t, err)
+	require.Equal(t, "john", claims.UserID)
+	require.Equal(t, "admin", claims.Role)
+	require.Equal(t, []string{"messaging:general"}, claims.ChannelCIDs)
+	require.Equal(t, []string{"default:standup"}, claims.CallCIDs)
+	require.Equal(t, map[string]interface{}{"tenant": "acme"}, claims.CustomClaims)
+	require.WithinDuration(t, time.Now(), claims.IssuedAt, 2*time.Second)
+	require.WithinDuration(t, time.Now().Add(time.Hour), claims.ExpiresAt, 2*time.Second)
+}
+
+func TestParseToken_Errors(t *testing.T) {
+	c := newTokenTestClient(t)
+	past := time.Now().Add(-time.Hour).Unix()
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"garbage", "not-a-jwt", ErrTokenMalformed},
+		{"missing user_id", signTestToken(t, jwt.SigningMethodHS256, "secret", jwt.MapClaims{"iat": past}), ErrTokenMalformed},
+		{"other secret", signTestToken(t, jwt.SigningMethodHS256, "other", jwt.MapClaims{"user_id": "john"}), ErrTokenSignatureInvalid},
+		{"other secret and expired", signTestToken(t, jwt.SigningMethodHS256, "other", jwt.MapClaims{"user_id": "john", "exp": past}), ErrTokenSignatureInvalid},
+		{"other algorithm", signTestToken(t, jwt.SigningMethodHS512, "secret", jwt.MapClaims{"user_id": "john"}), ErrTokenSignatureInvalid},
+		{"expired", signTestToken(t, jwt.SigningMethodHS256, "secret", jwt.MapClaims{"user_id": "john", "exp": past}), ErrTokenExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.ParseToken(tt.token)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, tt.want), "want %v, got %v", tt.want, err)
+			require.True(t, errors.Is(err, ErrTokenInvalid))
+			var se *StreamError
+			require.True(t, errors.As(err, &se))
+		})
+	}
+}
+
+func TestVerifyToken_Leeway(t *testing.T) {
+	c := newTokenTestClient(t)
+	token := signTestToken(t, jwt.SigningMethodHS256, "secret", jwt.MapClaims{"user_id": "john", "exp": time.Now().Add(-5 * time.Second).Unix()})
+
+	_, err := c.VerifyToken(context.Background(), token)
+	require.True(t, errors.Is(err, ErrTokenExpired))
+	_, err = c.VerifyToken(context.Background(), token, WithTokenLeeway(time.Minute))
+	require.NoError(t, err)
+}
+
+// revocationClient serves GetApp and QueryUsers with the given revocation
+// dates (zero for none).
+type revocationClient struct {
+	app, user time.Time
+	calls     []string
+}
+
+func (r *revocationClient) Do(req *http.Request) (*http.Response, error) {
+	r.calls = append(r.calls, req.URL.Path)
+	revoked := func(ts time.Time) string {
+		if ts.IsZero() {
+			return ""
+		}
+		return fmt.Sprintf(`,"revoke_tokens_issued_before":%d`, ts.UnixNano())
+	}
+	var body string
+	switch req.URL.Path {
+	case "/api/v2/app":
+		body = `{"app":{"name":"a"` + revoked(r.app) + `}}`
+	case "/api/v2/users":
+		body = `{"users":[{"id":"john"` + revoked(r.user) + `}]}`
+	default:
+		return &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func TestVerifyToken_RevocationCheck(t *testing.T) {
+	issued := time.Now().Add(-time.Hour).Truncate(time.Second)
+	token := signTestToken(t, jwt.SigningMethodHS256, "secret", jwt.MapClaims{"user_id": "john", "iat": issued.Unix()})
+	noIat := signTestToken(t, jwt.SigningMethodHS256, "secret", jwt.MapClaims{"user_id": "john"})
+
+	tests := []struct {
+		name      string
+		app, user time.Time
+		token     string
+		revoked   bool
+	}{
+		{"no revocation", time.Time{}, time.Time{}, token, false},
+		{"app revoked later", issued.Add(time.Minute), time.Time{}, token, true},
+		{"user revoked later", time.Time{}, issued.Add(time.Minute), token, true},
+		{"revoked earlier", issued.Add(-time.Minute), issued.Add(-time.Minute), token, false},
+		{"revoked within the same second", issued.Add(500 * time.Millisecond), time.Time{}, token, false},
+		{"no iat", time.Time{}, issued, noIat, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &revocationClient{app: tt.app, user: tt.user}
+			c := newTokenTestClient(t, WithHTTPClient(fake))
+
+			_, err := c.VerifyToken(context.Background(), tt.token, WithTokenRevocationCheck())
+			if tt.revoked {
+				require.True(t, errors.Is(err, ErrTokenRevoked), "want ErrTokenRevoked, got %v", err)
+				require.True(t, errors.Is(err, ErrTokenInvalid))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// Without the option no API call is made.
+	fake := &revocationClient{app: time.Now()}
+	c := newTokenTestClient(t, WithHTTPClient(fake))
+	_, err := c.VerifyToken(context.Background(), token)
+	require.NoError(t, err)
+	require.Empty(t, fake.calls)
+}
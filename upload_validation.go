@@ -0,0 +1,252 @@
+package getstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultUploadConfigRefresh = 5 * time.Minute
+
+// UploadValidationConfig is the opt-in client-side check of uploads against
+// the app's file and image upload config (size limit, allowed and blocked
+// extensions and MIME types, as returned by GetApp). Disabled by default.
+// Rejected uploads fail with ErrUploadRejected before any bytes are sent. If
+// the config can't be fetched, uploads are let through until the next refresh.
+type UploadValidationConfig struct {
+	// Enabled turns validation on. Default false.
+	Enabled bool
+	// RefreshInterval is how long the fetched upload config is reused
+	// before GetApp is called again. Default 5m.
+	RefreshInterval time.Duration
+}
+
+// WithUploadValidation enables the opt-in client-side upload validation.
+func WithUploadValidation(cfg UploadValidationConfig) ClientOption {
+	return func(c *Client) {
+		if !cfg.Enabled {
+			c.uploadValidator = nil
+			return
+		}
+		if cfg.RefreshInterval <= 0 {
+			cfg.RefreshInterval = defaultUploadConfigRefresh
+		}
+		c.uploadValidator = &uploadValidator{cfg: cfg, now: time.Now}
+	}
+}
+
+// uploadValidator caches the app's upload config between refreshes.
+type uploadValidator struct {
+	cfg UploadValidationConfig
+	now func() time.Time
+
+	mu        sync.Mutex
+	fetchedAt time.Time
+	file      FileUploadConfig
+	image     FileUploadConfig
+	// fetching is closed when the GetApp call in flight, if any, is done.
+	fetching chan struct{}
+}
+
+// config returns the file or image upload config, fetching it with GetApp
+// when the cached copy is missing or stale. Concurrent uploads share one
+// fetch, made without holding the lock. If it fails, validation fails open:
+// the previous config (none, on the first fetch) is used until the next
+// refresh, and the server still enforces the limits.
+func (v *uploadValidator) config(ctx context.Context, c *Client, image bool) (FileUploadConfig, error) {
+	for {
+		v.mu.Lock()
+		if !v.fetchedAt.IsZero() && v.now().Sub(v.fetchedAt) < v.cfg.RefreshInterval {
+			cfg := v.file
+			if image {
+				cfg = v.image
+			}
+			v.mu.Unlock()
+			return cfg, nil
+		}
+		if wait := v.fetching; wait != nil {
+			v.mu.Unlock()
+			select {
+			case <-wait:
+				continue
+			case <-ctx.Done():
+				return FileUploadConfig{}, wrapTransportError(ctx.Err())
+			}
+		}
+		done := make(chan struct{})
+		v.fetching = done
+		v.mu.Unlock()
+
+		// The upload's per-call options (e.g. an acting-user token) and
+		// request ID are not meant for the app lookup.
+		fetchCtx := ContextWithRequestID(context.WithValue(ctx, requestOptionsKey{}, requestOptions{}), "")
+		res, err := c.GetApp(fetchCtx, &GetAppRequest{})
+
+		v.mu.Lock()
+		switch {
+		case err == nil:
+			v.file, v.image = res.Data.App.FileUploadConfig, res.Data.App.ImageUploadConfig
+			v.fetchedAt = v.now()
+		case ctx.Err() != nil:
+			// The caller gave up; the next upload fetches again.
+		default:
+			c.logEvent(ctx, LogLevelWarn, "upload.validation.config_unavailable",
+				LogField{"error.message", safeErrorMessage(err)})
+			v.fetchedAt = v.now()
+		}
+		v.fetching = nil
+		close(done)
+		v.mu.Unlock()
+		if ctx.Err() != nil {
+			return FileUploadConfig{}, wrapTransportError(ctx.Err())
+		}
+	}
+}
+
+// uploadFacts describes an upload for validation. size is -1 when unknown.
+type uploadFacts struct {
+	fileName    string
+	contentType string
+	size        int64
+	image       bool
+}
+
+// validateUpload checks data against the app's upload config when data is an
+// upload request and validation is enabled.
+func (c *Client) validateUpload(ctx context.Context, data any) error {
+	if c.uploadValidator == nil {
+		return nil
+	}
+	facts, ok, err := describeUpload(data)
+	if err != nil || !ok {
+		return err
+	}
+	cfg, err := c.uploadValidator.config(ctx, c, facts.image)
+	if err != nil {
+		return err
+	}
+	if reason := checkUpload(cfg, facts); reason != "" {
+		msg := fmt.Sprintf("stream: upload of %q rejected: %s", filepath.Base(facts.fileName), reason)
+		return &StreamError{
+			sentinel: ErrUploadRejected,
+			Message:  msg,
+			cause:    stackWrap(errors.New(msg), "upload validation"),
+		}
+	}
+	return nil
+}
+
+// describeUpload gathers the name, type and size of an upload request. The
+// content type of a path upload or seekable stream is sniffed from its first
+// bytes; a non-seekable stream is typed by its extension alone, since its
+// content can only be read once.
+func describeUpload(data any) (uploadFacts, bool, error) {
+	var path string
+	facts := uploadFacts{size: -1}
+	switch req := data.(type) {
+	case *UploadFileRequest:
+		path = derefString(req.File)
+	case *UploadChannelFileRequest:
+		path = derefString(req.File)
+	case *UploadImageRequest:
+		path, facts.image = derefString(req.File), true
+	case *UploadChannelImageRequest:
+		path, facts.image = derefString(req.File), true
+	default:
+		u, ok := asStreamUpload(data)
+		if !ok {
+			return facts, false, nil
+		}
+		src := u.source
+		facts.fileName, facts.contentType, facts.image = src.FileName, src.ContentType, u.image
+		if src.Size > 0 {
+			facts.size = src.Size
+		}
+		if facts.contentType == "" {
+			var head []byte
+			if _, seekable := src.Reader.(io.Seeker); seekable {
+				h, err := sniffUploadSource(src)
+				if err != nil {
+					return facts, false, err
+				}
+				head = h
+			}
+			facts.contentType = detectUploadContentType(src.FileName, head)
+		}
+		return facts, true, nil
+	}
+	if path == "" {
+		// createMultipartRequest reports the missing file.
+		return facts, false, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return facts, false, stackWrap(err, "failed to open file")
+	}
+	defer f.Close()
+	if info, err := f.Stat(); err == nil {
+		facts.size = info.Size()
+	}
+	head, err := readUploadHead(f)
+	if err != nil {
+		return facts, false, err
+	}
+	facts.fileName, facts.contentType = path, detectUploadContentType(path, head)
+	return facts, true, nil
+}
+
+// checkUpload returns why cfg rejects the upload, or "" if it is allowed.
+func checkUpload(cfg FileUploadConfig, facts uploadFacts) string {
+	if cfg.SizeLimit > 0 && facts.size > int64(cfg.SizeLimit) {
+		return fmt.Sprintf("size %d bytes exceeds the limit of %d bytes", facts.size, cfg.SizeLimit)
+	}
+	ext := strings.TrimPrefix(strings.ToLower(filepath.Ext(facts.fileName)), ".")
+	if len(cfg.AllowedFileExtensions) > 0 && !matchesExtension(cfg.AllowedFileExtensions, ext) {
+		return fmt.Sprintf("extension %q is not allowed", ext)
+	}
+	if matchesExtension(cfg.BlockedFileExtensions, ext) {
+		return fmt.Sprintf("extension %q is blocked", ext)
+	}
+	mimeType := strings.ToLower(strings.TrimSpace(strings.Split(facts.contentType, ";")[0]))
+	if len(cfg.AllowedMimeTypes) > 0 && !matchesMimeType(cfg.AllowedMimeTypes, mimeType) {
+		return fmt.Sprintf("content type %q is not allowed", mimeType)
+	}
+	if matchesMimeType(cfg.BlockedMimeTypes, mimeType) {
+		return fmt.Sprintf("content type %q is blocked", mimeType)
+	}
+	return ""
+}
+
+func matchesExtension(list []string, ext string) bool {
+	for _, e := range list {
+		if strings.TrimPrefix(strings.ToLower(e), ".") == ext {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesMimeType matches exact types and "type/*" wildcards.
+func matchesMimeType(list []string, mimeType string) bool {
+	for _, m := range list {
+		m = strings.ToLower(m)
+		if m == mimeType || (strings.HasSuffix(m, "/*") && strings.HasPrefix(mimeType, strings.TrimSuffix(m, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,93 @@
+package getstream
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// appConfigClient serves GetApp with a fixed upload config and hands every
+// other request to uploads.
+type appConfigClient struct {
+	app           string
+	appStatus     int
+	appCalls      int
+	appRequestIDs []string
+	uploads       multipartReadingClient
+}
+
+func (a *appConfigClient) Do(r *http.Request) (*http.Response, error) {
+	if r.URL.Path == "/api/v2/app" {
+		a.appCalls++
+		a.appRequestIDs = append(a.appRequestIDs, r.Header.Get(RequestIDHeader))
+		if a.appStatus != 0 {
+			return canned(a.appStatus, a.app, nil)()
+		}
+		return canned(200, a.app, nil)()
+	}
+	return a.uploads.Do(r)
+}
+
+const uploadConfigApp = `{"app":{
+	"file_upload_config":{"size_limit":1000,"blocked_file_extensions":[".exe"],"blocked_mime_types":["application/x-msdownload"]},
+	"image_upload_config":{"size_limit":0,"allowed_mime_types":["image/*"]}
+}}`
+
+func TestUploadValidation(t *testing.T) {
+	fake := &appConfigClient{app: uploadConfigApp}
+	c := newTestClient(t, fake, WithUploadValidation(UploadValidationConfig{Enabled: true}))
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	big := filepath.Join(dir, "big.txt")
+	require.NoError(t, os.WriteFile(big, bytes.Repeat([]byte("x"), 2000), 0o600))
+
+	_, err := c.UploadFile(ctx, &UploadFileRequest{File: PtrTo(big)})
+	require.True(t, errors.Is(err, ErrUploadRejected))
+	require.ErrorContains(t, err, "exceeds the limit of 1000 bytes")
+
+	_, err = c.UploadFileStream(ctx, &UploadFileStreamRequest{Source: UploadSource{Reader: strings.NewReader("MZ"), FileName: "setup.EXE"}})
+	require.True(t, errors.Is(err, ErrUploadRejected))
+	require.ErrorContains(t, err, `extension "exe" is blocked`)
+
+	_, err = c.UploadImageStream(ctx, &UploadImageStreamRequest{Source: UploadSource{Reader: strings.NewReader("just text"), FileName: "fake.txt"}})
+	require.True(t, errors.Is(err, ErrUploadRejected))
+	require.ErrorContains(t, err, `content type "text/plain" is not allowed`)
+	require.Zero(t, fake.uploads.calls, "rejected uploads must not be sent")
+
+	_, err = c.UploadImageStream(ctx, &UploadImageStreamRequest{Source: UploadSource{Reader: bytes.NewReader(pngHeader), FileName: "real.png"}})
+	require.NoError(t, err)
+	require.Equal(t, string(pngHeader), string(fake.uploads.parts[0].content), "validation must not consume the source")
+
+	require.Equal(t, 1, fake.appCalls, "the upload config is fetched once and reused")
+}
+
+func TestUploadValidationDisabledByDefault(t *testing.T) {
+	fake := &appConfigClient{app: uploadConfigApp}
+	c := newTestClient(t, fake)
+
+	_, err := c.UploadFileStream(context.Background(), &UploadFileStreamRequest{Source: UploadSource{Reader: strings.NewReader("MZ"), FileName: "setup.exe"}})
+	require.NoError(t, err)
+	require.Zero(t, fake.appCalls)
+}
+
+func TestUploadValidationFailsOpen(t *testing.T) {
+	fake := &appConfigClient{app: `{"code":-1,"message":"boom"}`, appStatus: http.StatusInternalServerError}
+	c := newTestClient(t, fake, WithUploadValidation(UploadValidationConfig{Enabled: true}))
+	ctx := ContextWithRequestID(context.Background(), "upload-1")
+
+	for i := 0; i < 2; i++ {
+		_, err := c.UploadFileStream(ctx, &UploadFileStreamRequest{Source: UploadSource{Reader: strings.NewReader("MZ"), FileName: "setup.exe"}})
+		require.NoError(t, err, "an unavailable upload config must not block uploads")
+	}
+	require.Equal(t, 2, fake.uploads.calls)
+	require.Equal(t, 1, fake.appCalls, "the failed fetch is not repeated before the refresh interval")
+	require.NotEqual(t, "upload-1", fake.appRequestIDs[0], "the app lookup gets its own request ID")
+}
@@ -0,0 +1,442 @@
+package getstream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// UploadSource is the content of a streamed upload. The body is streamed to
+// the server as it is read and never buffered whole in memory.
+type UploadSource struct {
+	// Reader supplies the file content, from its current offset. If it
+	// also implements io.Seeker it is rewound to that offset before every
+	// attempt, so the upload can be retried and replayed by HTTP/2
+	// (GetBody); otherwise it is sent at most once.
+	Reader io.Reader
+	// FileName is the name reported to the server.
+	FileName string
+	// ContentType is the MIME type of the content. Default: detected from
+	// the first bytes of the content and the FileName extension.
+	ContentType string
+	// Size is the exact content length in bytes, if known. It lets the
+	// request carry a Content-Length instead of being sent chunked.
+	Size int64
+
+	// mu guards the fields below: GetBody starts a body writer from the
+	// transport's goroutine.
+	mu sync.Mutex
+	// writing is closed when the latest body writer stops using Reader, so
+	// a replay never rewinds it underneath an abandoned attempt.
+	writing chan struct{}
+	// start is the offset of a seekable Reader at the first attempt, the
+	// offset every attempt rewinds to.
+	start    int64
+	startSet bool
+}
+
+// UploadFileStreamRequest is UploadFileRequest with the content read from an
+// UploadSource instead of a file path.
+type UploadFileStreamRequest struct {
+	Source UploadSource
+	User   *OnlyUserID
+}
+
+// UploadImageStreamRequest is UploadImageRequest with the content read from
+// an UploadSource instead of a file path.
+type UploadImageStreamRequest struct {
+	Source      UploadSource
+	UploadSizes []ImageSize
+	User        *OnlyUserID
+}
+
+// UploadChannelFileStreamRequest is UploadChannelFileRequest with the content
+// read from an UploadSource instead of a file path.
+type UploadChannelFileStreamRequest struct {
+	Source UploadSource
+	User   *OnlyUserID
+}
+
+// UploadChannelImageStreamRequest is UploadChannelImageRequest with the
+// content read from an UploadSource instead of a file path.
+type UploadChannelImageStreamRequest struct {
+	Source      UploadSource
+	UploadSizes []ImageSize
+	User        *OnlyUserID
+}
+
+// UploadFileStream uploads a file streamed from request.Source.
+func (c *Client) UploadFileStream(ctx context.Context, request *UploadFileStreamRequest, opts ...RequestOption) (*StreamResponse[FileUploadResponse], error) {
+	var result FileUploadResponse
+	res, err := MakeRequest[UploadFileStreamRequest, FileUploadResponse](c, ctx, "POST", "/api/v2/uploads/file", nil, request, &result, nil, opts...)
+	return res, err
+}
+
+// UploadImageStream uploads an image streamed from request.Source.
+func (c *Client) UploadImageStream(ctx context.Context, request *UploadImageStreamRequest, opts ...RequestOption) (*StreamResponse[ImageUploadResponse], error) {
+	var result ImageUploadResponse
+	res, err := MakeRequest[UploadImageStreamRequest, ImageUploadResponse](c, ctx, "POST", "/api/v2/uploads/image", nil, request, &result, nil, opts...)
+	return res, err
+}
+
+// UploadChannelFileStream uploads a channel file streamed from request.Source.
+func (c *ChatClient) UploadChannelFileStream(ctx context.Context, _type string, id string, request *UploadChannelFileStreamRequest, opts ...RequestOption) (*StreamResponse[UploadChannelFileResponse], error) {
+	var result UploadChannelFileResponse
+	pathParams := map[string]string{
+		"type": _type,
+		"id":   id,
+	}
+	res, err := MakeRequest[UploadChannelFileStreamRequest, UploadChannelFileResponse](c.client, ctx, "POST", "/api/v2/chat/channels/{type}/{id}/file", nil, request, &result, pathParams, opts...)
+	return res, err
+}
+
+// UploadChannelImageStream uploads a channel image streamed from
+// request.Source.
+func (c *ChatClient) UploadChannelImageStream(ctx context.Context, _type string, id string, request *UploadChannelImageStreamRequest, opts ...RequestOption) (*StreamResponse[UploadChannelResponse], error) {
+	var result UploadChannelResponse
+	pathParams := map[string]string{
+		"type": _type,
+		"id":   id,
+	}
+	res, err := MakeRequest[UploadChannelImageStreamRequest, UploadChannelResponse](c.client, ctx, "POST", "/api/v2/chat/channels/{type}/{id}/image", nil, request, &result, pathParams, opts...)
+	return res, err
+}
+
+// streamUpload is the common shape of the *StreamRequest upload types.
+type streamUpload struct {
+	source      *UploadSource
+	uploadSizes []ImageSize
+	user        *OnlyUserID
+	image       bool
+
+	contentType string
+	// head holds the bytes read from a non-seekable source to detect its
+	// content type; they are sent ahead of the rest of the source.
+	head []byte
+}
+
+// asStreamUpload returns the upload described by data, if it is one of the
+// streamed upload request types.
+func asStreamUpload(data any) (streamUpload, bool) {
+	switch req := data.(type) {
+	case *UploadFileStreamRequest:
+		return streamUpload{source: &req.Source, user: req.User}, true
+	case *UploadImageStreamRequest:
+		return streamUpload{source: &req.Source, uploadSizes: req.UploadSizes, user: req.User, image: true}, true
+	case *UploadChannelFileStreamRequest:
+		return streamUpload{source: &req.Source, user: req.User}, true
+	case *UploadChannelImageStreamRequest:
+		return streamUpload{source: &req.Source, uploadSizes: req.UploadSizes, user: req.User, image: true}, true
+	}
+	return streamUpload{}, false
+}
+
+// isRewindableUpload reports whether a write's body can be sent again. Only
+// streamed uploads from a non-seekable reader can't.
+func isRewindableUpload(data any) bool {
+	u, ok := asStreamUpload(data)
+	if !ok {
+		return true
+	}
+	_, seekable := u.source.Reader.(io.Seeker)
+	return seekable
+}
+
+// createStreamingMultipartRequest sets r's body to a multipart form that is
+// produced through an io.Pipe while the transport reads it.
+func (c *Client) createStreamingMultipartRequest(r *http.Request, u streamUpload) (*http.Request, error) {
+	src := u.source
+	if src.Reader == nil {
+		return nil, fmt.Errorf("upload source reader must be provided")
+	}
+	if src.FileName == "" {
+		return nil, fmt.Errorf("upload source file name must be provided")
+	}
+	if err := markUploadStart(src); err != nil {
+		return nil, err
+	}
+	u.contentType = src.ContentType
+	if u.contentType == "" {
+		head, err := sniffUploadSource(src)
+		if err != nil {
+			return nil, err
+		}
+		if _, seekable := src.Reader.(io.Seeker); !seekable {
+			u.head = head
+		}
+		u.contentType = detectUploadContentType(src.FileName, head)
+	}
+
+	// All attempts must produce identical bytes, so fix the boundary once.
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+	if src.Size > 0 {
+		overhead, err := u.writeForm(&countingWriter{}, boundary, strings.NewReader(""))
+		if err != nil {
+			return nil, err
+		}
+		r.ContentLength = overhead + src.Size
+	}
+
+	r.Body = u.pipe(boundary)
+	if _, seekable := src.Reader.(io.Seeker); seekable {
+		r.GetBody = func() (io.ReadCloser, error) {
+			return u.pipe(boundary), nil
+		}
+	}
+	r.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+
+	c.logger.Debug("Created streaming multipart request with file: %s", src.FileName)
+	return r, nil
+}
+
+// pipe starts writing the form into an io.Pipe and returns its read end. The
+// writer goroutine waits for the previous attempt's writer, rewinds a
+// seekable source, and exits when the form is written or the read end is
+// closed.
+func (u streamUpload) pipe(boundary string) io.ReadCloser {
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	u.source.mu.Lock()
+	prev := u.source.writing
+	u.source.writing = done
+	u.source.mu.Unlock()
+	go func() {
+		defer close(done)
+		if prev != nil {
+			<-prev
+		}
+		if err := rewindUpload(u.source); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		content := u.source.Reader
+		if u.head != nil {
+			content = io.MultiReader(bytes.NewReader(u.head), content)
+		}
+		_, err := u.writeForm(pw, boundary, content)
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+// writeForm writes the multipart form with content as the file part and
+// returns the number of bytes written.
+func (u streamUpload) writeForm(w io.Writer, boundary string, content io.Reader) (int64, error) {
+	cw := &countingWriter{w: w}
+	writer := multipart.NewWriter(cw)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return cw.n, stackWrap(err, "failed to set multipart boundary")
+	}
+
+	if len(u.uploadSizes) > 0 {
+		uploadSizesJSON, err := json.Marshal(u.uploadSizes)
+		if err != nil {
+			return cw.n, stackWrap(err, "failed to marshal upload_sizes")
+		}
+		if err := writer.WriteField("upload_sizes", string(uploadSizesJSON)); err != nil {
+			return cw.n, stackWrap(err, "failed to write upload_sizes field")
+		}
+	}
+	if u.user != nil {
+		userJSON, err := json.Marshal(u.user)
+		if err != nil {
+			return cw.n, stackWrap(err, "failed to marshal user")
+		}
+		if err := writer.WriteField("user", string(userJSON)); err != nil {
+			return cw.n, stackWrap(err, "failed to write user field")
+		}
+	}
+
+	fileWriter, err := createFilePart(writer, u.source.FileName, u.contentType)
+	if err != nil {
+		return cw.n, err
+	}
+	if _, err := io.Copy(fileWriter, content); err != nil {
+		return cw.n, stackWrap(err, "failed to copy file content")
+	}
+	if err := writer.Close(); err != nil {
+		return cw.n, stackWrap(err, "failed to close multipart writer")
+	}
+	return cw.n, nil
+}
+
+// createFilePart starts the "file" part of a multipart form with an explicit
+// Content-Type (multipart.Writer.CreateFormFile always uses
+// application/octet-stream).
+func createFilePart(writer *multipart.Writer, fileName, contentType string) (io.Writer, error) {
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(fileName)))
+	h.Set("Content-Type", contentType)
+	w, err := writer.CreatePart(h)
+	if err != nil {
+		return nil, stackWrap(err, "failed to create form file")
+	}
+	return w, nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// sniffLen is the number of leading bytes http.DetectContentType considers.
+const sniffLen = 512
+
+// sniffUploadSource reads the leading bytes of src for content-type
+// detection, from the start of a seekable source. The previous attempt's
+// writer must be done with the source first.
+func sniffUploadSource(src *UploadSource) ([]byte, error) {
+	src.mu.Lock()
+	writing := src.writing
+	src.mu.Unlock()
+	if writing != nil {
+		<-writing
+	}
+	if err := markUploadStart(src); err != nil {
+		return nil, err
+	}
+	if err := rewindUpload(src); err != nil {
+		return nil, err
+	}
+	return readUploadHead(src.Reader)
+}
+
+// readUploadHead reads up to sniffLen leading bytes of r.
+func readUploadHead(r io.Reader) ([]byte, error) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, stackWrap(err, "failed to read upload source")
+	}
+	return head[:n], nil
+}
+
+// detectUploadContentType picks the MIME type of an upload: the type sniffed
+// from its first bytes when that is specific (images, PDFs, archives, ...),
+// otherwise the type registered for the file extension, falling back to the
+// generic sniffed type.
+func detectUploadContentType(fileName string, head []byte) string {
+	sniffed := http.DetectContentType(head)
+	if sniffed != "application/octet-stream" && !strings.HasPrefix(sniffed, "text/plain") {
+		return sniffed
+	}
+	if byExt := mime.TypeByExtension(strings.ToLower(filepath.Ext(fileName))); byExt != "" {
+		return byExt
+	}
+	return sniffed
+}
+
+// UploadProgressFunc receives the progress of an upload: the request body
+// bytes handed to the transport so far, and the total body size, or -1 when
+// it is not known in advance. It is called from the goroutine sending the
+// request.
+type UploadProgressFunc func(sent, total int64)
+
+type uploadProgressKey struct{}
+
+// ContextWithUploadProgress returns a copy of ctx that makes the upload made
+// with it (UploadFile, UploadChannelImage, UploadFileStream, ...) report its
+// progress to fn. A retried upload starts again from zero.
+func ContextWithUploadProgress(ctx context.Context, fn UploadProgressFunc) context.Context {
+	return context.WithValue(ctx, uploadProgressKey{}, fn)
+}
+
+// withUploadProgress wraps r's body (and GetBody) to report progress to the
+// callback in r's context, if any.
+func withUploadProgress(r *http.Request, err error) (*http.Request, error) {
+	if err != nil || r.Body == nil {
+		return r, err
+	}
+	fn, _ := r.Context().Value(uploadProgressKey{}).(UploadProgressFunc)
+	if fn == nil {
+		return r, nil
+	}
+	total := r.ContentLength
+	if total <= 0 {
+		total = -1
+	}
+	r.Body = &progressReader{ReadCloser: r.Body, fn: fn, total: total}
+	if getBody := r.GetBody; getBody != nil {
+		r.GetBody = func() (io.ReadCloser, error) {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			return &progressReader{ReadCloser: body, fn: fn, total: total}, nil
+		}
+	}
+	return r, nil
+}
+
+type progressReader struct {
+	io.ReadCloser
+	fn    UploadProgressFunc
+	sent  int64
+	total int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.ReadCloser.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.fn(p.sent, p.total)
+	}
+	return n, err
+}
+
+// markUploadStart records the current offset of a seekable source as the
+// offset to rewind to, unless an earlier attempt already has.
+func markUploadStart(src *UploadSource) error {
+	seeker, ok := src.Reader.(io.Seeker)
+	if !ok {
+		return nil
+	}
+	src.mu.Lock()
+	defer src.mu.Unlock()
+	if src.startSet {
+		return nil
+	}
+	start, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return stackWrap(err, "failed to read upload source offset")
+	}
+	src.start, src.startSet = start, true
+	return nil
+}
+
+// rewindUpload seeks a seekable source back to the offset it started at.
+func rewindUpload(src *UploadSource) error {
+	seeker, ok := src.Reader.(io.Seeker)
+	if !ok {
+		return nil
+	}
+	src.mu.Lock()
+	start := src.start
+	src.mu.Unlock()
+	if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+		return stackWrap(err, "failed to rewind upload source")
+	}
+	return nil
+}
+
+// countingWriter counts the bytes written through it; a nil w discards them.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.w == nil {
+		c.n += int64(len(p))
+		return len(p), nil
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
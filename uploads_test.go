@@ -0,0 +1,232 @@
+package getstream
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type uploadedPart struct {
+	fileName, contentType string
+	content               []byte
+	fields                map[string]string
+}
+
+// multipartReadingClient reads and parses every request's multipart body,
+// failing the first failFirst requests with a connection reset after
+// reading them.
+type multipartReadingClient struct {
+	failFirst      int
+	calls          int
+	parts          []uploadedPart
+	contentLengths []int64
+}
+
+func (m *multipartReadingClient) Do(r *http.Request) (*http.Response, error) {
+	m.calls++
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if r.ContentLength > 0 && int64(len(raw)) != r.ContentLength {
+		return nil, errors.New("content length mismatch")
+	}
+	m.contentLengths = append(m.contentLengths, r.ContentLength)
+	got := uploadedPart{fields: map[string]string{}}
+	mr := multipart.NewReader(bytes.NewReader(raw), params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		b, _ := io.ReadAll(p)
+		if p.FormName() == "file" {
+			got.fileName, got.contentType, got.content = p.FileName(), p.Header.Get("Content-Type"), b
+		} else {
+			got.fields[p.FormName()] = string(b)
+		}
+	}
+	m.parts = append(m.parts, got)
+	if m.calls <= m.failFirst {
+		return nil, syscall.ECONNRESET
+	}
+	return canned(200, `{"file":"https://cdn.example.com/f"}`, nil)()
+}
+
+func TestUploadFileStream(t *testing.T) {
+	fake := &multipartReadingClient{}
+	c := newTestClient(t, fake)
+
+	content := "hello streamed world"
+	res, err := c.UploadFileStream(context.Background(), &UploadFileStreamRequest{
+		Source: UploadSource{Reader: strings.NewReader(content), FileName: `re"port.txt`, ContentType: "text/plain", Size: int64(len(content))},
+		User:   &OnlyUserID{ID: "u1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://cdn.example.com/f", *res.Data.File)
+
+	require.Len(t, fake.parts, 1)
+	p := fake.parts[0]
+	require.Equal(t, content, string(p.content))
+	require.Equal(t, `re"port.txt`, p.fileName)
+	require.Equal(t, "text/plain", p.contentType)
+	require.JSONEq(t, `{"id":"u1"}`, p.fields["user"])
+	require.Positive(t, fake.contentLengths[0], "a known size yields a Content-Length")
+}
+
+func TestUploadChannelImageStream_UnknownSizeIsChunked(t *testing.T) {
+	fake := &multipartReadingClient{}
+	c := newTestClient(t, fake)
+
+	_, err := c.Chat().UploadChannelImageStream(context.Background(), "messaging", "general", &UploadChannelImageStreamRequest{
+		Source:      UploadSource{Reader: io.MultiReader(strings.NewReader("png"), strings.NewReader("data")), FileName: "a.png"},
+		UploadSizes: []ImageSize{{Width: PtrTo(10)}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "pngdata", string(fake.parts[0].content))
+	require.Equal(t, "image/png", fake.parts[0].contentType, "typed by extension")
+	require.Contains(t, fake.parts[0].fields["upload_sizes"], `"width":10`)
+	require.Zero(t, fake.contentLengths[0])
+}
+
+func TestUploadStream_SeekerRewindsOnRetry(t *testing.T) {
+	fake := &multipartReadingClient{failFirst: 1}
+	c := newTestClient(t, fake, WithRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond, IdempotencyKeys: true, IdempotencyKeyOperations: []string{"POST /api/v2/uploads/file"}}))
+
+	_, err := c.UploadFileStream(context.Background(), &UploadFileStreamRequest{
+		Source: UploadSource{Reader: bytes.NewReader([]byte("seekable")), FileName: "f.bin"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, fake.calls)
+	require.Equal(t, "seekable", string(fake.parts[1].content), "the retry must resend the whole content")
+}
+
+func TestUploadStream_RewindsToStartingOffset(t *testing.T) {
+	fake := &multipartReadingClient{failFirst: 1}
+	c := newTestClient(t, fake)
+
+	r := bytes.NewReader([]byte("header|payload"))
+	_, err := r.Seek(int64(len("header|")), io.SeekStart)
+	require.NoError(t, err)
+	_, err = c.UploadFileStream(context.Background(), &UploadFileStreamRequest{
+		Source: UploadSource{Reader: r, FileName: "f.bin"},
+	}, RequestRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond, IdempotencyKeys: true, IdempotencyKeyOperations: []string{"POST /api/v2/uploads/file"}}))
+	require.NoError(t, err)
+	require.Equal(t, 2, fake.calls, "per-call options apply to streamed uploads")
+	require.Equal(t, "payload", string(fake.parts[0].content))
+	require.Equal(t, "payload", string(fake.parts[1].content), "the retry must resend from the starting offset")
+}
+
+func TestUploadStream_NonSeekableNotRetried(t *testing.T) {
+	fake := &multipartReadingClient{failFirst: 1}
+	c := newTestClient(t, fake, WithRetry(RetryConfig{Enabled: true, MaxBackoff: time.Millisecond, IdempotencyKeys: true, IdempotencyKeyOperations: []string{"POST /api/v2/uploads/file"}}))
+
+	_, err := c.UploadFileStream(context.Background(), &UploadFileStreamRequest{
+		Source: UploadSource{Reader: io.MultiReader(strings.NewReader("once")), FileName: "f.bin"},
+	})
+	require.True(t, errors.Is(err, ErrTransport))
+	require.Equal(t, 1, fake.calls)
+}
+
+func TestUploadStream_GetBodyReplays(t *testing.T) {
+	client, _ := newClient("k", "s", WithBaseUrl("https://api.example.com"))
+	req, err := newRequest(client, context.Background(), client.BaseUrl(), http.MethodPost, "/upload", nil,
+		&UploadFileStreamRequest{Source: UploadSource{Reader: strings.NewReader("replay me"), FileName: "f.txt", Size: 9}}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, req.GetBody)
+
+	first, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(first)), req.ContentLength)
+	rc, err := req.GetBody()
+	require.NoError(t, err)
+	second, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+}
+
+func TestUploadStream_Validation(t *testing.T) {
+	c := newTestClient(t, &multipartReadingClient{})
+	_, err := c.UploadFileStream(context.Background(), &UploadFileStreamRequest{Source: UploadSource{FileName: "f"}})
+	require.ErrorContains(t, err, "reader must be provided")
+	_, err = c.UploadFileStream(context.Background(), &UploadFileStreamRequest{Source: UploadSource{Reader: strings.NewReader("x")}})
+	require.ErrorContains(t, err, "file name must be provided")
+}
+
+// pngHeader is enough of a PNG for http.DetectContentType.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestUploadContentTypeDetection(t *testing.T) {
+	dir := t.TempDir()
+	pngPath := filepath.Join(dir, "photo.bin")
+	require.NoError(t, os.WriteFile(pngPath, pngHeader, 0o600))
+	jsonPath := filepath.Join(dir, "data.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"a":1}`), 0o600))
+
+	fake := &multipartReadingClient{}
+	c := newTestClient(t, fake)
+
+	_, err := c.UploadImage(context.Background(), &UploadImageRequest{File: PtrTo(pngPath)})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", fake.parts[0].contentType, "content wins over a generic extension")
+
+	_, err = c.UploadFile(context.Background(), &UploadFileRequest{File: PtrTo(jsonPath)})
+	require.NoError(t, err)
+	require.Equal(t, "application/json", fake.parts[1].contentType, "extension wins over generic text")
+
+	_, err = c.UploadFileStream(context.Background(), &UploadFileStreamRequest{
+		Source: UploadSource{Reader: io.MultiReader(bytes.NewReader(pngHeader), strings.NewReader("rest")), FileName: "x"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", fake.parts[2].contentType)
+	require.Equal(t, string(pngHeader)+"rest", string(fake.parts[2].content), "sniffed bytes of a non-seekable source must still be sent")
+}
+
+func TestUploadProgress(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "f.txt")
+	require.NoError(t, os.WriteFile(path, bytes.Repeat([]byte("x"), 100_000), 0o600))
+
+	fake := &multipartReadingClient{}
+	c := newTestClient(t, fake)
+
+	var sent, total int64
+	calls := 0
+	ctx := ContextWithUploadProgress(context.Background(), func(s, tot int64) {
+		require.GreaterOrEqual(t, s, sent)
+		sent, total = s, tot
+		calls++
+	})
+	_, err := c.UploadFile(ctx, &UploadFileRequest{File: PtrTo(path)})
+	require.NoError(t, err)
+	require.Greater(t, calls, 1)
+	require.Equal(t, fake.contentLengths[0], total)
+	require.Equal(t, total, sent)
+
+	sent = 0
+	_, err = c.Chat().UploadChannelImageStream(ctx, "messaging", "general", &UploadChannelImageStreamRequest{
+		Source: UploadSource{Reader: io.MultiReader(bytes.NewReader(pngHeader)), FileName: "a.png"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), total, "unknown size reports total -1")
+	require.Positive(t, sent)
+}